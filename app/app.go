@@ -18,6 +18,8 @@ import (
 
 	acc "github.com/lino-network/lino/x/account"
 	accmodel "github.com/lino-network/lino/x/account/model"
+	"github.com/lino-network/lino/x/appdata"
+	appdatamodel "github.com/lino-network/lino/x/appdata/model"
 	developer "github.com/lino-network/lino/x/developer"
 	devmodel "github.com/lino-network/lino/x/developer/model"
 	globalmodel "github.com/lino-network/lino/x/global/model"
@@ -56,6 +58,7 @@ const (
 	validatorStateFile  = "validator"
 	reputationStateFile = "reputation"
 	voterStateFile      = "voter"
+	appDataStateFile    = "appdata"
 )
 
 // default home directories for expected binaries
@@ -82,6 +85,7 @@ type LinoBlockchain struct {
 	CapKeyParamStore      *sdk.KVStoreKey
 	CapKeyProposalStore   *sdk.KVStoreKey
 	CapKeyReputationStore *sdk.KVStoreKey
+	CapKeyAppDataStore    *sdk.KVStoreKey
 
 	// manager for different KVStore
 	accountManager    acc.AccountManager
@@ -93,12 +97,16 @@ type LinoBlockchain struct {
 	developerManager  developer.DeveloperManager
 	proposalManager   proposal.ProposalManager
 	reputationManager rep.ReputationManager
+	appDataManager    appdata.AppDataManager
 
 	// global param
 	paramHolder param.ParamHolder
 
 	// start from previous exported state
 	importRequired bool
+
+	// state migrations of named upgrades this binary knows about
+	upgradeHandlers map[string]UpgradeHandler
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -122,6 +130,8 @@ func NewLinoBlockchain(
 		CapKeyParamStore:      sdk.NewKVStoreKey(types.ParamKVStoreKey),
 		CapKeyProposalStore:   sdk.NewKVStoreKey(types.ProposalKVStoreKey),
 		CapKeyReputationStore: sdk.NewKVStoreKey(types.ReputationKVStoreKey),
+		CapKeyAppDataStore:    sdk.NewKVStoreKey(types.AppDataKVStoreKey),
+		upgradeHandlers:       make(map[string]UpgradeHandler),
 	}
 	lb.paramHolder = param.NewParamHolder(lb.CapKeyParamStore)
 	lb.accountManager = acc.NewAccountManager(lb.CapKeyAccountStore, lb.paramHolder)
@@ -135,6 +145,7 @@ func NewLinoBlockchain(
 	lb.infraManager = infra.NewInfraManager(lb.CapKeyInfraStore, lb.paramHolder)
 	lb.developerManager = developer.NewDeveloperManager(lb.CapKeyDeveloperStore, lb.paramHolder)
 	lb.proposalManager = proposal.NewProposalManager(lb.CapKeyProposalStore, lb.paramHolder)
+	lb.appDataManager = appdata.NewAppDataManager(lb.CapKeyAppDataStore, lb.paramHolder)
 
 	lb.Router().
 		AddRoute(acc.RouterKey, acc.NewHandler(lb.accountManager, &lb.globalManager)).
@@ -145,8 +156,10 @@ func NewLinoBlockchain(
 		AddRoute(developer.RouterKey, developer.NewHandler(
 			lb.developerManager, lb.accountManager, &lb.globalManager)).
 		AddRoute(proposal.RouterKey, proposal.NewHandler(
-			lb.accountManager, lb.proposalManager, lb.postManager, &lb.globalManager, lb.voteManager)).
+			lb.accountManager, lb.proposalManager, lb.postManager, lb.developerManager,
+			&lb.globalManager, lb.voteManager)).
 		AddRoute(infra.RouterKey, infra.NewHandler(lb.infraManager)).
+		AddRoute(appdata.RouterKey, appdata.NewHandler(lb.appDataManager, lb.developerManager)).
 		AddRoute(val.RouterKey, val.NewHandler(
 			lb.accountManager, lb.valManager, lb.voteManager, &lb.globalManager))
 
@@ -157,22 +170,23 @@ func NewLinoBlockchain(
 		AddRoute(developer.QuerierRoute, developer.NewQuerier(lb.developerManager)).
 		AddRoute(proposal.QuerierRoute, proposal.NewQuerier(lb.proposalManager)).
 		AddRoute(infra.QuerierRoute, infra.NewQuerier(lb.infraManager)).
+		AddRoute(appdata.QuerierRoute, appdata.NewQuerier(lb.appDataManager)).
 		AddRoute(val.QuerierRoute, val.NewQuerier(lb.valManager)).
-		AddRoute(global.QuerierRoute, global.NewQuerier(lb.globalManager)).
+		AddRoute(global.QuerierRoute, global.NewQuerier(lb.globalManager, lb.voteManager)).
 		AddRoute(param.QuerierRoute, param.NewQuerier(lb.paramHolder)).
 		AddRoute(rep.QuerierRoute, rep.NewQuerier(lb.reputationManager))
 
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
-	lb.SetAnteHandler(auth.NewAnteHandler(lb.accountManager, lb.globalManager, lb.postManager))
+	lb.SetAnteHandler(auth.NewAnteHandler(lb.accountManager, lb.globalManager, lb.postManager, lb.developerManager))
 	// TODO(Cosmos): mounting multiple stores is broken
 	// https://github.com/cosmos/cosmos-sdk/issues/532
 
 	lb.MountStores(
 		lb.CapKeyMainStore, lb.CapKeyAccountStore, lb.CapKeyPostStore, lb.CapKeyValStore,
 		lb.CapKeyVoteStore, lb.CapKeyInfraStore, lb.CapKeyDeveloperStore, lb.CapKeyGlobalStore,
-		lb.CapKeyParamStore, lb.CapKeyProposalStore, lb.CapKeyReputationStore)
+		lb.CapKeyParamStore, lb.CapKeyProposalStore, lb.CapKeyReputationStore, lb.CapKeyAppDataStore)
 	if err := lb.LoadLatestVersion(lb.CapKeyMainStore); err != nil {
 		cmn.Exit(err.Error())
 	}
@@ -217,6 +231,7 @@ func MakeCodec() *wire.Codec {
 	post.RegisterWire(cdc)
 	developer.RegisterWire(cdc)
 	infra.RegisterWire(cdc)
+	appdata.RegisterWire(cdc)
 	vote.RegisterWire(cdc)
 	val.RegisterWire(cdc)
 	proposal.RegisterWire(cdc)
@@ -230,9 +245,11 @@ func MakeCodec() *wire.Codec {
 func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(post.RewardEvent{}, "lino/eventReward", nil)
+	cdc.RegisterConcrete(post.ExpirePostDraftEvent{}, "lino/eventEpd", nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
+	cdc.RegisterConcrete(proposal.ExpirePendingProposalEvent{}, "lino/eventEppe", nil)
 }
 
 // SetImportRequired - set whether import is required in initchainer.
@@ -424,6 +441,8 @@ func (lb *LinoBlockchain) toAppInfra(
 
 // init process for a block, execute time events and fire incompetent validators
 func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	lb.applyUpgrade(ctx)
+
 	chainStartTime, err := lb.globalManager.GetChainStartTime(ctx)
 	if err != nil {
 		panic(err)
@@ -447,10 +466,15 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 	}
 
 	global.BeginBlocker(ctx, req, &lb.globalManager)
-	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager)
+	actualPenalty, releasedBackings := val.BeginBlocker(ctx, req, lb.valManager)
 
-	// add coins back to inflation pool
-	if err := lb.globalManager.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
+	// slashed coins go to community pool
+	if err := lb.globalManager.AddToCommunityPool(ctx, actualPenalty); err != nil {
+		panic(err)
+	}
+	// backings of fired validators are returned to backers
+	if err := val.ReturnBackings(
+		ctx, lb.valManager, &lb.globalManager, lb.accountManager, releasedBackings); err != nil {
 		panic(err)
 	}
 
@@ -492,10 +516,20 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 			if err := e.Execute(ctx, lb.accountManager); err != nil {
 				panic(err)
 			}
+		case post.ExpirePostDraftEvent:
+			if err := e.Execute(ctx, lb.postManager); err != nil {
+				panic(err)
+			}
 		case proposal.DecideProposalEvent:
 			if err := e.Execute(
 				ctx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
-				lb.postManager, &lb.globalManager); err != nil {
+				lb.postManager, lb.developerManager, &lb.globalManager); err != nil {
+				panic(err)
+			}
+		case proposal.ExpirePendingProposalEvent:
+			if err := e.Execute(
+				ctx, lb.accountManager, lb.proposalManager, lb.developerManager,
+				&lb.globalManager); err != nil {
 				panic(err)
 			}
 		case param.ChangeParamEvent:
@@ -512,6 +546,9 @@ func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock)
 	// XXX(yumin): reputation updates, will not change any tendermint.
 	rep.EndBlocker(ctx, req, lb.reputationManager)
 
+	// open content censorship proposals before decide events are committed.
+	proposal.EndBlocker(ctx, req, lb.proposalManager, lb.postManager, &lb.globalManager)
+
 	global.EndBlocker(ctx, req, &lb.globalManager)
 	// update validator set.
 	validatorUpdates, err := lb.valManager.GetValidatorUpdates(ctx)
@@ -589,8 +626,17 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 		// though only differs in round?
 		ratPerValidator = coin.ToDec().Quo(sdk.NewDec(int64(len(lst.OncallValidators) - i)))
 		coinPerValidator := types.DecToCoin(ratPerValidator)
+		// share inflation with backers of validator after commission
+		validatorInflation, rewards, err := lb.valManager.DistributeInflation(ctx, validator, coinPerValidator)
+		if err != nil {
+			panic(err)
+		}
 		lb.accountManager.AddSavingCoin(
-			ctx, validator, coinPerValidator, "", "", types.ValidatorInflation)
+			ctx, validator, validatorInflation, "", "", types.ValidatorInflation)
+		for _, reward := range rewards {
+			lb.accountManager.AddSavingCoin(
+				ctx, reward.Backer, reward.Amount, validator, "", types.BackingInflation)
+		}
 		coin = coin.Minus(coinPerValidator)
 	}
 }
@@ -644,20 +690,38 @@ func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
 
 	totalDistributedInflation := types.NewCoinFromInt64(0)
 	for idx, developer := range lst.AllDevelopers {
-		if idx == (len(lst.AllDevelopers) - 1) {
-			lb.accountManager.AddSavingCoin(
-				ctx, developer, inflation.Minus(totalDistributedInflation), "", "", types.DeveloperInflation)
-			break
-		}
 		percentage, err := lb.developerManager.GetConsumptionWeight(ctx, developer)
 		if err != nil {
 			panic(err)
 		}
 		myShareRat := inflation.ToDec().Mul(percentage)
 		myShareCoin := types.DecToCoin(myShareRat)
+		// last developer gets the rest of inflation
+		if idx == (len(lst.AllDevelopers) - 1) {
+			myShareCoin = inflation.Minus(totalDistributedInflation)
+		}
 		totalDistributedInflation = totalDistributedInflation.Plus(myShareCoin)
+		// pay out reward pool funded last month to users donated through app,
+		// then fund reward pool with share of this month inflation
+		payouts, err := lb.developerManager.DistributeRewardPool(ctx, developer)
+		if err != nil {
+			panic(err)
+		}
+		for _, payout := range payouts {
+			lb.accountManager.AddSavingCoin(
+				ctx, payout.Username, payout.Amount, developer, "", types.RewardPoolPayout)
+		}
+		poolShareCoin, err := lb.developerManager.FundRewardPool(ctx, developer, myShareCoin)
+		if err != nil {
+			panic(err)
+		}
 		lb.accountManager.AddSavingCoin(
-			ctx, developer, myShareCoin, "", "", types.DeveloperInflation)
+			ctx, developer, myShareCoin.Minus(poolShareCoin), "", "", types.DeveloperInflation)
+		// archive statement before consumption is cleared
+		if err := lb.developerManager.ArchiveMonthlyStatement(
+			ctx, developer, percentage, myShareCoin); err != nil {
+			panic(err)
+		}
 	}
 
 	if err := lb.developerManager.ClearConsumption(ctx); err != nil {
@@ -728,6 +792,9 @@ func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessag
 	exportToFile(voterStateFile, func(ctx sdk.Context) interface{} {
 		return lb.voteManager.Export(ctx).ToIR()
 	})
+	exportToFile(appDataStateFile, func(ctx sdk.Context) interface{} {
+		return lb.appDataManager.Export(ctx).ToIR()
+	})
 	lb.reputationManager.ExportToFile(ctx, exportPath+"reputation")
 
 	genesisState := GenesisState{}
@@ -792,6 +859,11 @@ func (lb *LinoBlockchain) ImportFromFiles(ctx sdk.Context) {
 			check(err)
 			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.voteManager.Import(ctx, t)
+		case *appdatamodel.AppDataTablesIR:
+			err = lb.cdc.UnmarshalJSON(bytes, t)
+			check(err)
+			fmt.Printf("%s state parsed: %T\n", filename, t)
+			lb.appDataManager.Import(ctx, t)
 		default:
 			panic(fmt.Sprintf("Unknown import type: %T", t))
 		}
@@ -805,5 +877,6 @@ func (lb *LinoBlockchain) ImportFromFiles(ctx sdk.Context) {
 	importFromFile(infraStateFile, &inframodel.InfraTablesIR{})
 	importFromFile(validatorStateFile, &valmodel.ValidatorTablesIR{})
 	importFromFile(voterStateFile, &votemodel.VoterTablesIR{})
+	importFromFile(appDataStateFile, &appdatamodel.AppDataTablesIR{})
 	lb.reputationManager.ImportFromFile(ctx, DefaultNodeHome+"/"+prevStateFolder+reputationStateFile)
 }
@@ -189,6 +189,7 @@ func TestGenesisFromConfig(t *testing.T) {
 			ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 			DeveloperAllocation:      types.NewDecFromRat(10, 100),
 			ValidatorAllocation:      types.NewDecFromRat(5, 100),
+			CommunityPoolAllocation:  sdk.ZeroDec(),
 		},
 		param.InfraInternalAllocationParam{
 			StorageAllocation: types.NewDecFromRat(50, 100),
@@ -200,6 +201,11 @@ func TestGenesisFromConfig(t *testing.T) {
 			VoterCoinReturnTimes:           int64(7),
 			DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 			DelegatorCoinReturnTimes:       int64(7),
+			RedelegationIntervalSec:        int64(7 * 24 * 3600),
+			MaxCommissionRate:              types.NewDecFromRat(20, 100),
+			MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+			ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+			MaxConviction:                  int64(6),
 		},
 		param.ProposalParam{
 			ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -216,11 +222,35 @@ func TestGenesisFromConfig(t *testing.T) {
 			ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 			ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 			ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+			ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+			ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+			ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+			TextProposalDecideSec:                int64(7 * 24 * 3600),
+			TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+			TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+			TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+			CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+			CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+			CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+			CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+			CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+			ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+			DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+			DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+			DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+			DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+			CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+			CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+			CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+			CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 		},
 		param.DeveloperParam{
 			DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
 			DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 			DeveloperCoinReturnTimes:       int64(7),
+			DeveloperMaxDailySponsorship:   int64(100),
+			DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 		},
 		param.ValidatorParam{
 			ValidatorMinWithdraw:           types.NewCoinFromInt64(1 * types.Decimals),
@@ -334,6 +364,46 @@ func TestDistributeInflationToValidators(t *testing.T) {
 	}
 }
 
+func TestDistributeInflationToValidatorBackers(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	remainValidatorPool := types.DecToCoin(
+		genesisTotalCoin.ToDec().Mul(
+			growthRate.Mul(validatorAllocation)))
+	param, _ := lb.paramHolder.GetValidatorParam(ctx)
+	expectBaseBalance := coinPerValidator.Minus(
+		param.ValidatorMinCommittingDeposit.Plus(param.ValidatorMinVotingDeposit))
+
+	// validator1 backs validator0 with the same amount as validator0's deposit
+	validator0 := types.AccountKey("validator0")
+	validator1 := types.AccountKey("validator1")
+	rate := types.NewDecFromRat(1, 100)
+	err := lb.valManager.SetCommissionRate(ctx, validator0, rate)
+	assert.Nil(t, err)
+	err = lb.valManager.Back(ctx, validator0, validator1, param.ValidatorMinCommittingDeposit)
+	assert.Nil(t, err)
+
+	lb.globalManager.DistributeHourlyInflation(ctx)
+	lb.distributeInflationToValidator(ctx)
+
+	inflationForValidator :=
+		types.DecToCoin(remainValidatorPool.ToDec().Mul(
+			types.NewDecFromRat(1, types.HoursPerYear)))
+	inflation0 := types.DecToCoin(inflationForValidator.ToDec().Quo(sdk.NewDec(21)))
+	inflation1 := types.DecToCoin(
+		inflationForValidator.Minus(inflation0).ToDec().Quo(sdk.NewDec(20)))
+	share := types.DecToCoin(inflation0.ToDec().Quo(sdk.NewDec(2)))
+	reward := share.Minus(types.DecToCoin(share.ToDec().Mul(rate)))
+
+	saving, err := lb.accountManager.GetSavingFromBank(ctx, validator0)
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(expectBaseBalance.Plus(inflation0).Minus(reward)))
+	saving, err = lb.accountManager.GetSavingFromBank(ctx, validator1)
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(expectBaseBalance.Plus(inflation1).Plus(reward)))
+}
+
 func TestFireByzantineValidators(t *testing.T) {
 	lb := newLinoBlockchain(t, 21)
 
@@ -673,10 +743,83 @@ func TestDistributeInflationToDevelopers(t *testing.T) {
 			developer, err := devStorage.GetDeveloper(ctx, types.AccountKey("dev"+strconv.Itoa(i)))
 			assert.Nil(t, err)
 			assert.True(t, developer.AppConsumption.IsZero())
+
+			// consumption and inflation of this month are archived
+			statements, err := lb.developerManager.GetMonthlyStatements(
+				ctx, types.AccountKey("dev"+strconv.Itoa(i)))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(statements))
+			assert.True(t, statements[0].Consumption.IsEqual(cs.consumptionList[i]))
+			assert.True(t, statements[0].Inflation.IsEqual(inflation))
 		}
 	}
 }
 
+func TestDistributeDeveloperRewardPool(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	globalStore := globalModel.NewGlobalStorage(lb.CapKeyGlobalStore)
+	developer := types.AccountKey("dev0")
+	donors := []types.AccountKey{"donor1", "donor2"}
+	for _, username := range append([]types.AccountKey{developer}, donors...) {
+		err := lb.accountManager.CreateAccount(
+			ctx, "", username,
+			secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+			secp256k1.GenPrivKey().PubKey(), types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+	}
+	err := lb.developerManager.RegisterDeveloper(
+		ctx, developer, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	err = lb.developerManager.SetRewardPoolShare(ctx, developer, types.NewDecFromRat(1, 4))
+	assert.Nil(t, err)
+
+	// first month funds reward pool with a quarter of developer inflation
+	err = globalStore.SetInflationPool(ctx, &globalModel.InflationPool{
+		DeveloperInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
+	})
+	assert.Nil(t, err)
+	lb.distributeInflationToDeveloper(ctx)
+	saving, err := lb.accountManager.GetSavingFromBank(ctx, developer)
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(types.NewCoinFromInt64(750*types.Decimals)))
+	pool, err := lb.developerManager.GetRewardPool(ctx, developer)
+	assert.Nil(t, err)
+	assert.True(t, pool.Balance.IsEqual(types.NewCoinFromInt64(250*types.Decimals)))
+
+	// donations made through app during second month
+	err = lb.developerManager.RecordUserDonation(ctx, developer, donors[0], types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	err = lb.developerManager.RecordUserDonation(ctx, developer, donors[1], types.NewCoinFromInt64(30))
+	assert.Nil(t, err)
+	payout, err := lb.developerManager.GetPendingPayout(ctx, developer, donors[0])
+	assert.Nil(t, err)
+	assert.True(t, payout.IsEqual(types.NewCoinFromInt64(6250000)))
+
+	// second month pays out reward pool pro rata and funds it again
+	err = globalStore.SetInflationPool(ctx, &globalModel.InflationPool{
+		DeveloperInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
+	})
+	assert.Nil(t, err)
+	lb.distributeInflationToDeveloper(ctx)
+	saving, err = lb.accountManager.GetSavingFromBank(ctx, developer)
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(types.NewCoinFromInt64(1500*types.Decimals)))
+	saving, err = lb.accountManager.GetSavingFromBank(ctx, donors[0])
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(types.NewCoinFromInt64(6250000)))
+	saving, err = lb.accountManager.GetSavingFromBank(ctx, donors[1])
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(types.NewCoinFromInt64(18750000)))
+	pool, err = lb.developerManager.GetRewardPool(ctx, developer)
+	assert.Nil(t, err)
+	assert.True(t, pool.Balance.IsEqual(types.NewCoinFromInt64(250*types.Decimals)))
+	assert.True(t, pool.TotalDonation.IsZero())
+	payout, err = lb.developerManager.GetPendingPayout(ctx, developer, donors[0])
+	assert.Nil(t, err)
+	assert.True(t, payout.IsZero())
+}
+
 func TestHourlyEvent(t *testing.T) {
 	lb := newLinoBlockchain(t, 21)
 	gs := globalModel.NewGlobalStorage(lb.CapKeyGlobalStore)
@@ -856,3 +999,30 @@ func TestGlobalTime(t *testing.T) {
 		assert.Equal(t, cs.expectLastBlockTime, lastBlockTime)
 	}
 }
+
+func TestApplyUpgrade(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 99})
+
+	// nothing happens without pending upgrade plan
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+
+	err := lb.globalManager.ScheduleUpgrade(ctx, types.ProposalKey("1"), "upgrade2", 100, "link")
+	assert.Nil(t, err)
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+	assert.True(t, lb.globalManager.DoesUpgradePlanExist(ctx))
+
+	// node halts at upgrade height if binary doesn't know the upgrade
+	ctx = ctx.WithBlockHeight(100)
+	assert.Panics(t, func() { lb.applyUpgrade(ctx) })
+	assert.True(t, lb.globalManager.DoesUpgradePlanExist(ctx))
+
+	migrated := false
+	lb.SetUpgradeHandler("upgrade2", func(ctx sdk.Context, lb *LinoBlockchain) sdk.Error {
+		migrated = true
+		return nil
+	})
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+	assert.True(t, migrated)
+	assert.False(t, lb.globalManager.DoesUpgradePlanExist(ctx))
+}
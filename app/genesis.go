@@ -131,6 +131,7 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 				DeveloperAllocation:      types.NewDecFromRat(10, 100),
 				ValidatorAllocation:      types.NewDecFromRat(5, 100),
+				CommunityPoolAllocation:  sdk.ZeroDec(),
 			},
 			param.InfraInternalAllocationParam{
 				StorageAllocation: types.NewDecFromRat(50, 100),
@@ -142,6 +143,11 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				VoterCoinReturnTimes:           int64(7),
 				DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DelegatorCoinReturnTimes:       int64(7),
+				RedelegationIntervalSec:        int64(7 * 24 * 3600),
+				MaxCommissionRate:              types.NewDecFromRat(20, 100),
+				MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+				ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+				MaxConviction:                  int64(6),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -158,11 +164,35 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 				ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 				ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+				ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+				ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+				ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+				TextProposalDecideSec:                int64(7 * 24 * 3600),
+				TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+				TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+				TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+				CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+				CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+				CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+				CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+				CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+				ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+				DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+				DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+				DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+				DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+				CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+				CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+				CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+				CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 			},
 			param.DeveloperParam{
 				DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
 				DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DeveloperCoinReturnTimes:       int64(7),
+				DeveloperMaxDailySponsorship:   int64(100),
+				DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 			},
 			param.ValidatorParam{
 				ValidatorMinWithdraw:           types.NewCoinFromInt64(1 * types.Decimals),
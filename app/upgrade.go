@@ -0,0 +1,43 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler - state migration of a named upgrade, run in BeginBlock at the upgrade height
+type UpgradeHandler func(ctx sdk.Context, lb *LinoBlockchain) sdk.Error
+
+// SetUpgradeHandler - register state migration for upgrade name,
+// binary supporting an upgrade must register it before the upgrade height
+func (lb *LinoBlockchain) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	lb.upgradeHandlers[name] = handler
+}
+
+// applyUpgrade - apply pending upgrade plan once its height is reached. Node halts
+// if the binary doesn't have handler for the upgrade, so operator can switch to new binary.
+func (lb *LinoBlockchain) applyUpgrade(ctx sdk.Context) {
+	if !lb.globalManager.DoesUpgradePlanExist(ctx) {
+		return
+	}
+	plan, err := lb.globalManager.GetUpgradePlan(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if ctx.BlockHeight() < plan.Height {
+		return
+	}
+
+	handler, ok := lb.upgradeHandlers[plan.Name]
+	if !ok {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Link)
+		ctx.Logger().Error(msg)
+		panic(msg)
+	}
+	if err := handler(ctx, lb); err != nil {
+		panic(err)
+	}
+	lb.globalManager.ClearUpgradePlan(ctx)
+	ctx.Logger().Info(fmt.Sprintf("applied upgrade %q at height %d", plan.Name, ctx.BlockHeight()))
+}
@@ -13,6 +13,8 @@ const (
 	FlagFee       = "fee"
 	FlagPrivKey   = "priv-key"
 	FlagPubKey    = "pub-key"
+	FlagPage      = "page"
+	FlagLimit     = "limit"
 
 	// Account
 	FlagIsFollow = "is-follow"
@@ -35,11 +37,20 @@ const (
 	FlagSeconds     = "seconds"
 	FlagPermission  = "permission"
 	FlagGrantAmount = "grant-amount"
+	FlagAdmin       = "admin"
+	FlagRoles       = "roles"
+	FlagShareRatio  = "share-ratio"
 
 	// Infra
 	FlagProvider = "provider"
 	FlagUsage    = "usage"
 
+	// App data
+	FlagApp    = "app"
+	FlagKey    = "key"
+	FlagValue  = "value"
+	FlagPrefix = "prefix"
+
 	// Post
 	FlagDonator                 = "donator"
 	FlagLikeUser                = "likeUser"
@@ -48,6 +59,7 @@ const (
 	FlagPostID                  = "post-ID"
 	FlagTitle                   = "title"
 	FlagContent                 = "content"
+	FlagContentFile             = "content-file"
 	FlagParentAuthor            = "parent-author"
 	FlagParentPostID            = "parent-post-ID"
 	FlagSourceAuthor            = "source-author"
@@ -56,9 +68,20 @@ const (
 
 	// Vote
 	FlagVoter      = "voter"
+	FlagSrcVoter   = "src-voter"
+	FlagDstVoter   = "dst-voter"
 	FlagProposalID = "proposal-id"
 	FlagResult     = "result"
 	FlagLink       = "link"
+	FlagReason     = "reason"
+	FlagConviction = "conviction"
+
+	// Validator
+	FlagValidator      = "validator"
+	FlagCommissionRate = "commission-rate"
+
+	// Param
+	FlagBestContentIndexN = "best-content-index-n"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -14,7 +14,9 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	acccmd "github.com/lino-network/lino/x/account/commands"
+	appdatacmd "github.com/lino-network/lino/x/appdata/commands"
 	developercmd "github.com/lino-network/lino/x/developer/commands"
+	globalcmd "github.com/lino-network/lino/x/global/commands"
 	infracmd "github.com/lino-network/lino/x/infra/commands"
 	postcmd "github.com/lino-network/lino/x/post/commands"
 	proposalcmd "github.com/lino-network/lino/x/proposal/commands"
@@ -87,6 +89,10 @@ func main() {
 		client.PostCommands(
 			postcmd.PostTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.ChunkedPostTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.UpdatePostTxCmd(cdc),
@@ -115,6 +121,18 @@ func main() {
 		client.PostCommands(
 			validatorcmd.RevokeTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.SetCommissionTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.BackTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UnbackTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			delegationcmd.DelegateTxCmd(cdc),
@@ -123,10 +141,18 @@ func main() {
 		client.PostCommands(
 			delegationcmd.WithdrawDelegateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.RedelegateTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			delegatecmd.GetDelegationCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			delegatecmd.GetDelegateesCmd(types.VoteKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -152,12 +178,23 @@ func main() {
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			proposalcmd.VoteProposalTxCmd(cdc),
+			proposalcmd.DepositProposalTxCmd(cdc),
+			proposalcmd.CancelProposalTxCmd(cdc),
+			proposalcmd.AppealCensorshipTxCmd(cdc),
+			proposalcmd.ChangeCoinDayParamTxCmd(cdc),
+			proposalcmd.ChangeReputationParamTxCmd(cdc),
 		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			votecmd.GetVoteCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			votecmd.GetVotesCmd(types.VoteKVStoreKey, cdc),
+			votecmd.GetVoteHistoryCmd(types.VoteKVStoreKey, cdc),
+			proposalcmd.GetProposalCmd(types.ProposalKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -187,6 +224,32 @@ func main() {
 		client.PostCommands(
 			developercmd.DeveloperUpdateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.SponsorRegisterTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.GrantFeeTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.RevokeFeeGrantTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.SetDeveloperAdminTxCmd(cdc),
+			developercmd.RemoveDeveloperAdminTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.SetRewardPoolShareTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			appdatacmd.SetAppDataTxCmd(cdc),
+			appdatacmd.DeleteAppDataTxCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -200,6 +263,12 @@ func main() {
 		client.GetCommands(
 			acccmd.GetAccountsCmd(types.AccountKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			globalcmd.GetCoinReturnsCmd(types.GlobalKVStoreKey, cdc),
+			globalcmd.GetCommunityPoolCmd(types.GlobalKVStoreKey, cdc),
+			globalcmd.GetUpgradePlanCmd(types.GlobalKVStoreKey, cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			postcmd.GetPostCmd(types.PostKVStoreKey, cdc),
@@ -226,6 +295,21 @@ func main() {
 		client.GetCommands(
 			developercmd.GetDevelopersCmd(types.DeveloperKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			developercmd.GetFeeGrantsCmd(types.DeveloperKVStoreKey, cdc),
+			developercmd.GetMonthlyStatementsCmd(types.DeveloperKVStoreKey, cdc),
+			developercmd.GetDeveloperAdminsCmd(types.DeveloperKVStoreKey, cdc),
+			developercmd.GetRewardPoolCmd(types.DeveloperKVStoreKey, cdc),
+			developercmd.GetPendingPayoutCmd(types.DeveloperKVStoreKey, cdc),
+		)...)
+
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			appdatacmd.GetAppDataCmd(types.AppDataKVStoreKey, cdc),
+			appdatacmd.GetAppDataListCmd(types.AppDataKVStoreKey, cdc),
+			appdatacmd.GetStorageUsageCmd(types.AppDataKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -235,6 +319,14 @@ func main() {
 		client.GetCommands(
 			validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetCommissionCmd(types.ValidatorKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetBackingsCmd(types.ValidatorKVStoreKey, cdc),
+		)...)
 
 	// add proxy, version and key info
 	linocliCmd.AddCommand(
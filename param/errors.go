@@ -202,3 +202,8 @@ func ErrFailedToMarshalReputationParam(err error) sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeParamQueryFailed, fmt.Sprintf("query paramter store failed"))
 }
+
+// ErrInvalidParamValue - error when parameter value is out of its legal range.
+func ErrInvalidParamValue(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidParamValue, fmt.Sprintf("invalid parameter value: %s", reason))
+}
@@ -4,14 +4,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ChangeParamEvent - change parameter event
+// ChangeParamEvent - change parameter event, bundled params are applied atomically
 type ChangeParamEvent struct {
-	Param Parameter `json:"param"`
+	Param  Parameter   `json:"param"`
+	Params []Parameter `json:"params"`
 }
 
 // Execute - execute change parameter event
 func (cpe ChangeParamEvent) Execute(ctx sdk.Context, ph ParamHolder) sdk.Error {
-	parameter := cpe.Param
+	if len(cpe.Params) == 0 {
+		return ph.setParam(ctx, cpe.Param)
+	}
+	// all bundled params are checked before any of them is applied
+	for _, parameter := range cpe.Params {
+		if err := parameter.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, parameter := range cpe.Params {
+		if err := ph.setParam(ctx, parameter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ph ParamHolder) setParam(ctx sdk.Context, parameter Parameter) sdk.Error {
 	switch parameter := parameter.(type) {
 	case GlobalAllocationParam:
 		return ph.setGlobalAllocationParam(ctx, &parameter)
@@ -25,12 +43,16 @@ func (cpe ChangeParamEvent) Execute(ctx sdk.Context, ph ParamHolder) sdk.Error {
 		return ph.setDeveloperParam(ctx, &parameter)
 	case ValidatorParam:
 		return ph.setValidatorParam(ctx, &parameter)
+	case CoinDayParam:
+		return ph.setCoinDayParam(ctx, &parameter)
 	case BandwidthParam:
 		return ph.setBandwidthParam(ctx, &parameter)
 	case AccountParam:
 		return ph.setAccountParam(ctx, &parameter)
 	case PostParam:
 		return ph.setPostParam(ctx, &parameter)
+	case ReputationParam:
+		return ph.setReputationParam(ctx, &parameter)
 	default:
 		return ErrInvalidaParameter()
 	}
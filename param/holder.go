@@ -52,6 +52,7 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
 	if err := ph.setGlobalAllocationParam(ctx, globalAllocationParam); err != nil {
 		return err
@@ -78,6 +79,8 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
 		DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DeveloperCoinReturnTimes:       int64(7),
+		DeveloperMaxDailySponsorship:   int64(100),
+		DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 	}
 	if err := ph.setDeveloperParam(ctx, developerParam); err != nil {
 		return err
@@ -105,6 +108,11 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
 	}
 	if err := ph.setVoteParam(ctx, voteParam); err != nil {
 		return err
@@ -126,6 +134,28 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+		ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+		TextProposalDecideSec:                int64(7 * 24 * 3600),
+		TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+		TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+		TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+		CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+		CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+		CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+		CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+		CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+		ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+		DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+		DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+		DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+		DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+		CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+		CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+		CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+		CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 	}
 	if err := ph.setProposalParam(ctx, proposalParam); err != nil {
 		return err
@@ -35,6 +35,7 @@ func TestGlobalAllocationParam(t *testing.T) {
 		InfraAllocation:          types.NewDecFromRat(1, 100),
 		DeveloperAllocation:      types.NewDecFromRat(1, 100),
 		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
 	err := ph.setGlobalAllocationParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -66,6 +67,8 @@ func TestDeveloperParam(t *testing.T) {
 		DeveloperMinDeposit:            types.NewCoinFromInt64(100000 * types.Decimals),
 		DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DeveloperCoinReturnTimes:       int64(7),
+		DeveloperMaxDailySponsorship:   int64(100),
+		DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 	}
 	err := ph.setDeveloperParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -107,6 +110,11 @@ func TestVoteParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
 	}
 	err := ph.setVoteParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -135,6 +143,28 @@ func TestProposalParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+		ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+		TextProposalDecideSec:                int64(7 * 24 * 3600),
+		TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+		TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+		TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+		CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+		CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+		CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+		CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+		CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+		ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+		DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+		DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+		DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+		DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+		CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+		CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+		CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+		CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 	}
 	err := ph.setProposalParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -203,6 +233,7 @@ func TestInitParam(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
@@ -214,6 +245,8 @@ func TestInitParam(t *testing.T) {
 		DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
 		DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DeveloperCoinReturnTimes:       int64(7),
+		DeveloperMaxDailySponsorship:   int64(100),
+		DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 	}
 
 	validatorParam := ValidatorParam{
@@ -235,6 +268,11 @@ func TestInitParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -252,6 +290,28 @@ func TestInitParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+		ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+		TextProposalDecideSec:                int64(7 * 24 * 3600),
+		TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+		TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+		TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+		CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+		CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+		CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+		CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+		CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+		ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+		DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+		DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+		DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+		DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+		CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+		CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+		CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+		CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -287,6 +347,7 @@ func TestInitParamFromConfig(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
@@ -298,6 +359,8 @@ func TestInitParamFromConfig(t *testing.T) {
 		DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
 		DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DeveloperCoinReturnTimes:       int64(7),
+		DeveloperMaxDailySponsorship:   int64(100),
+		DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 	}
 
 	validatorParam := ValidatorParam{
@@ -319,6 +382,11 @@ func TestInitParamFromConfig(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -336,6 +404,28 @@ func TestInitParamFromConfig(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+		ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+		TextProposalDecideSec:                int64(7 * 24 * 3600),
+		TextProposalMinDeposit:               types.NewCoinFromInt64(100000 * types.Decimals),
+		TextProposalPassRatio:                types.NewDecFromRat(50, 100),
+		TextProposalPassVotes:                types.NewCoinFromInt64(1000000 * types.Decimals),
+		CensorshipAppealWindowSec:            int64(7 * 24 * 3600),
+		CensorshipAppealDecideSec:            int64(7 * 24 * 3600),
+		CensorshipAppealMinDeposit:           types.NewCoinFromInt64(1000 * types.Decimals),
+		CensorshipAppealPassRatio:            types.NewDecFromRat(70, 100),
+		CensorshipAppealPassVotes:            types.NewCoinFromInt64(10000 * types.Decimals),
+		ProposalDepositPeriodSec:             int64(7 * 24 * 3600),
+		DeveloperPunishDecideSec:             int64(7 * 24 * 3600),
+		DeveloperPunishMinDeposit:            types.NewCoinFromInt64(10000 * types.Decimals),
+		DeveloperPunishPassRatio:             types.NewDecFromRat(60, 100),
+		DeveloperPunishPassVotes:             types.NewCoinFromInt64(500000 * types.Decimals),
+		CommunitySpendDecideSec:              int64(7 * 24 * 3600),
+		CommunitySpendMinDeposit:             types.NewCoinFromInt64(100000 * types.Decimals),
+		CommunitySpendPassRatio:              types.NewDecFromRat(70, 100),
+		CommunitySpendPassVotes:              types.NewCoinFromInt64(5000000 * types.Decimals),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -467,3 +557,139 @@ func TestUpdateGlobalGrowthRate(t *testing.T) {
 		assert.Equal(t, globalParam.GlobalGrowthRate, tc.expectGrowthRate)
 	}
 }
+
+func TestChangeParamEvent(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	err := ph.InitParam(ctx)
+	assert.Nil(t, err)
+
+	coinDayParam := CoinDayParam{
+		SecondsToRecoverCoinDay: int64(3 * 24 * 3600),
+	}
+	err = ChangeParamEvent{Param: coinDayParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+	coinDayPtr, err := ph.GetCoinDayParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, coinDayParam, *coinDayPtr)
+
+	reputationParam := ReputationParam{
+		BestContentIndexN: 100,
+	}
+	err = ChangeParamEvent{Param: reputationParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+	reputationPtr, err := ph.GetReputationParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, reputationParam, *reputationPtr)
+}
+
+func TestChangeParamsEvent(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	err := ph.InitParam(ctx)
+	assert.Nil(t, err)
+
+	coinDayParam := CoinDayParam{
+		SecondsToRecoverCoinDay: int64(3 * 24 * 3600),
+	}
+	reputationParam := ReputationParam{
+		BestContentIndexN: 100,
+	}
+
+	// none of the bundled params is applied if one of them is invalid
+	err = ChangeParamEvent{
+		Params: []Parameter{coinDayParam, ReputationParam{BestContentIndexN: 0}},
+	}.Execute(ctx, ph)
+	assert.Equal(t, ErrInvalidParamValue("best content index n"), err)
+	coinDayPtr, err := ph.GetCoinDayParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7*24*3600), coinDayPtr.SecondsToRecoverCoinDay)
+
+	err = ChangeParamEvent{
+		Params: []Parameter{coinDayParam, reputationParam},
+	}.Execute(ctx, ph)
+	assert.Nil(t, err)
+	coinDayPtr, err = ph.GetCoinDayParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, coinDayParam, *coinDayPtr)
+	reputationPtr, err := ph.GetReputationParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, reputationParam, *reputationPtr)
+}
+
+func TestParamValidate(t *testing.T) {
+	allocation := GlobalAllocationParam{
+		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
+		InfraAllocation:          types.NewDecFromRat(20, 100),
+		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
+		DeveloperAllocation:      types.NewDecFromRat(10, 100),
+		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		CommunityPoolAllocation:  types.NewDecFromRat(10, 100),
+	}
+	tooHighGrowthRate := allocation
+	tooHighGrowthRate.GlobalGrowthRate = types.NewDecFromRat(1, 10)
+	unbalancedAllocation := allocation
+	unbalancedAllocation.ValidatorAllocation = types.NewDecFromRat(10, 100)
+	voteParam := VoteParam{
+		MinStakeIn:                     types.NewCoinFromInt64(1000 * types.Decimals),
+		VoterCoinReturnIntervalSec:     int64(7 * 24 * 3600),
+		VoterCoinReturnTimes:           int64(7),
+		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
+	}
+	zeroReturnInterval := voteParam
+	zeroReturnInterval.VoterCoinReturnIntervalSec = 0
+
+	testCases := []struct {
+		testName  string
+		parameter Parameter
+		expectErr sdk.Error
+	}{
+		{
+			testName:  "valid global allocation",
+			parameter: allocation,
+			expectErr: nil,
+		},
+		{
+			testName:  "growth rate exceeds ceiling",
+			parameter: tooHighGrowthRate,
+			expectErr: ErrInvalidParamValue("global growth rate"),
+		},
+		{
+			testName:  "allocation doesn't sum to one",
+			parameter: unbalancedAllocation,
+			expectErr: ErrInvalidParamValue("allocation shares don't sum to one"),
+		},
+		{
+			testName:  "valid vote param",
+			parameter: voteParam,
+			expectErr: nil,
+		},
+		{
+			testName:  "zero coin return interval",
+			parameter: zeroReturnInterval,
+			expectErr: ErrInvalidParamValue("coin return interval or times"),
+		},
+		{
+			testName:  "negative post interval",
+			parameter: PostParam{PostIntervalSec: -1},
+			expectErr: ErrInvalidParamValue("post interval"),
+		},
+		{
+			testName:  "zero best content index n",
+			parameter: ReputationParam{},
+			expectErr: ErrInvalidParamValue("best content index n"),
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.parameter.Validate()
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+	}
+}
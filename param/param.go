@@ -6,20 +6,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Parameter - parameter in Lino Blockchain
-type Parameter interface{}
+// Parameter - parameter in Lino Blockchain, validated before proposed to change
+type Parameter interface {
+	Validate() sdk.Error
+}
 
 // GlobalAllocationParam - global allocation parameters
 // InfraAllocation - percentage for all infra related allocation
 // ContentCreatorAllocation - percentage for all content creator related allocation
 // DeveloperAllocation - percentage of inflation for developers
 // ValidatorAllocation - percentage of inflation for validators
+// CommunityPoolAllocation - percentage of inflation set aside to community pool before other allocations
 type GlobalAllocationParam struct {
 	GlobalGrowthRate         sdk.Dec `json:"global_growth_rate"`
 	InfraAllocation          sdk.Dec `json:"infra_allocation"`
 	ContentCreatorAllocation sdk.Dec `json:"content_creator_allocation"`
 	DeveloperAllocation      sdk.Dec `json:"developer_allocation"`
 	ValidatorAllocation      sdk.Dec `json:"validator_allocation"`
+	CommunityPoolAllocation  sdk.Dec `json:"community_pool_allocation"`
 }
 
 // InfraInternalAllocationParam - infra internal allocation parameters
@@ -36,12 +40,22 @@ type InfraInternalAllocationParam struct {
 // VoterCoinReturnTimes - when withdraw or revoke, the deposit return to voter by return event
 // DelegatorCoinReturnIntervalSec - when withdraw or revoke, the deposit return to delegator by return event
 // DelegatorCoinReturnTimes - when withdraw or revoke, the deposit return to delegator by return event
+// RedelegationIntervalSec - minimum seconds between two redelegations of a delegator
+// MaxCommissionRate - maximum commission rate voter can take from delegation interest
+// MaxCommissionChangeRate - maximum commission rate change of a voter per day
+// ConvictionLockPeriodSec - lock period of conviction 1 after proposal ends, doubled by each conviction level
+// MaxConviction - maximum conviction level, vote of conviction n counts n+1 times voting power
 type VoteParam struct {
 	MinStakeIn                     types.Coin `json:"min_stake_in"`
 	VoterCoinReturnIntervalSec     int64      `json:"voter_coin_return_interval_second"`
 	VoterCoinReturnTimes           int64      `json:"voter_coin_return_times"`
 	DelegatorCoinReturnIntervalSec int64      `json:"delegator_coin_return_interval_second"`
 	DelegatorCoinReturnTimes       int64      `json:"delegator_coin_return_times"`
+	RedelegationIntervalSec        int64      `json:"redelegation_interval_second"`
+	MaxCommissionRate              sdk.Dec    `json:"max_commission_rate"`
+	MaxCommissionChangeRate        sdk.Dec    `json:"max_commission_change_rate"`
+	ConvictionLockPeriodSec        int64      `json:"conviction_lock_period_second"`
+	MaxConviction                  int64      `json:"max_conviction"`
 }
 
 // ProposalParam - proposal parameters
@@ -58,30 +72,76 @@ type VoteParam struct {
 // ProtocolUpgradeMinDeposit - minimum deposit to propose protocol upgrade proposal
 // ProtocolUpgradePassRatio - upvote and downvote ratio for protocol upgrade proposal
 // ProtocolUpgradePassVotes - minimum voting power required to pass protocol upgrade proposal
+// ContentCensorshipEscalationRatio - report and upvote coin day ratio to open content censorship proposal automatically, zero to disable
+// ContentCensorshipEscalationMinReport - minimum report coin day required to open content censorship proposal automatically
+// ContentCensorshipEscalationPoolRate - percentage of content creator inflation added to censorship escalation pool
+// TextProposalDecideSec - seconds after text proposal created till expired
+// TextProposalMinDeposit - minimum deposit to propose text proposal
+// TextProposalPassRatio - upvote and downvote ratio for text proposal
+// TextProposalPassVotes - minimum voting power required to pass text proposal
+// CensorshipAppealWindowSec - seconds after content censorship passed till author can't appeal
+// CensorshipAppealDecideSec - seconds after censorship appeal proposal created till expired
+// CensorshipAppealMinDeposit - deposit to appeal, refunded only if appeal passed
+// CensorshipAppealPassRatio - upvote and downvote ratio for censorship appeal proposal
+// CensorshipAppealPassVotes - minimum voting power required to pass censorship appeal proposal
+// ProposalDepositPeriodSec - seconds pending proposal can raise minimum deposit before refunded
+// CommunitySpendDecideSec - seconds after community pool spend proposal created till expired
+// CommunitySpendMinDeposit - minimum deposit to propose community pool spend proposal
+// CommunitySpendPassRatio - upvote and downvote ratio for community pool spend proposal
+// CommunitySpendPassVotes - minimum voting power required to pass community pool spend proposal
+// DeveloperPunishDecideSec - seconds after developer punish proposal created till expired
+// DeveloperPunishMinDeposit - minimum deposit to propose developer punish proposal
+// DeveloperPunishPassRatio - upvote and downvote ratio for developer punish proposal
+// DeveloperPunishPassVotes - minimum voting power required to pass developer punish proposal
 type ProposalParam struct {
-	ContentCensorshipDecideSec  int64      `json:"content_censorship_decide_second"`
-	ContentCensorshipMinDeposit types.Coin `json:"content_censorship_min_deposit"`
-	ContentCensorshipPassRatio  sdk.Dec    `json:"content_censorship_pass_ratio"`
-	ContentCensorshipPassVotes  types.Coin `json:"content_censorship_pass_votes"`
-	ChangeParamDecideSec        int64      `json:"change_param_decide_second"`
-	ChangeParamExecutionSec     int64      `json:"change_param_execution_second"`
-	ChangeParamMinDeposit       types.Coin `json:"change_param_min_deposit"`
-	ChangeParamPassRatio        sdk.Dec    `json:"change_param_pass_ratio"`
-	ChangeParamPassVotes        types.Coin `json:"change_param_pass_votes"`
-	ProtocolUpgradeDecideSec    int64      `json:"protocol_upgrade_decide_second"`
-	ProtocolUpgradeMinDeposit   types.Coin `json:"protocol_upgrade_min_deposit"`
-	ProtocolUpgradePassRatio    sdk.Dec    `json:"protocol_upgrade_pass_ratio"`
-	ProtocolUpgradePassVotes    types.Coin `json:"protocol_upgrade_pass_votes"`
+	ContentCensorshipDecideSec           int64      `json:"content_censorship_decide_second"`
+	ContentCensorshipMinDeposit          types.Coin `json:"content_censorship_min_deposit"`
+	ContentCensorshipPassRatio           sdk.Dec    `json:"content_censorship_pass_ratio"`
+	ContentCensorshipPassVotes           types.Coin `json:"content_censorship_pass_votes"`
+	ChangeParamDecideSec                 int64      `json:"change_param_decide_second"`
+	ChangeParamExecutionSec              int64      `json:"change_param_execution_second"`
+	ChangeParamMinDeposit                types.Coin `json:"change_param_min_deposit"`
+	ChangeParamPassRatio                 sdk.Dec    `json:"change_param_pass_ratio"`
+	ChangeParamPassVotes                 types.Coin `json:"change_param_pass_votes"`
+	ProtocolUpgradeDecideSec             int64      `json:"protocol_upgrade_decide_second"`
+	ProtocolUpgradeMinDeposit            types.Coin `json:"protocol_upgrade_min_deposit"`
+	ProtocolUpgradePassRatio             sdk.Dec    `json:"protocol_upgrade_pass_ratio"`
+	ProtocolUpgradePassVotes             types.Coin `json:"protocol_upgrade_pass_votes"`
+	ContentCensorshipEscalationRatio     sdk.Dec    `json:"content_censorship_escalation_ratio"`
+	ContentCensorshipEscalationMinReport types.Coin `json:"content_censorship_escalation_min_report"`
+	ContentCensorshipEscalationPoolRate  sdk.Dec    `json:"content_censorship_escalation_pool_rate"`
+	TextProposalDecideSec                int64      `json:"text_proposal_decide_second"`
+	TextProposalMinDeposit               types.Coin `json:"text_proposal_min_deposit"`
+	TextProposalPassRatio                sdk.Dec    `json:"text_proposal_pass_ratio"`
+	TextProposalPassVotes                types.Coin `json:"text_proposal_pass_votes"`
+	CensorshipAppealWindowSec            int64      `json:"censorship_appeal_window_second"`
+	CensorshipAppealDecideSec            int64      `json:"censorship_appeal_decide_second"`
+	CensorshipAppealMinDeposit           types.Coin `json:"censorship_appeal_min_deposit"`
+	CensorshipAppealPassRatio            sdk.Dec    `json:"censorship_appeal_pass_ratio"`
+	CensorshipAppealPassVotes            types.Coin `json:"censorship_appeal_pass_votes"`
+	ProposalDepositPeriodSec             int64      `json:"proposal_deposit_period_second"`
+	DeveloperPunishDecideSec             int64      `json:"developer_punish_decide_second"`
+	DeveloperPunishMinDeposit            types.Coin `json:"developer_punish_min_deposit"`
+	DeveloperPunishPassRatio             sdk.Dec    `json:"developer_punish_pass_ratio"`
+	DeveloperPunishPassVotes             types.Coin `json:"developer_punish_pass_votes"`
+	CommunitySpendDecideSec              int64      `json:"community_spend_decide_second"`
+	CommunitySpendMinDeposit             types.Coin `json:"community_spend_min_deposit"`
+	CommunitySpendPassRatio              sdk.Dec    `json:"community_spend_pass_ratio"`
+	CommunitySpendPassVotes              types.Coin `json:"community_spend_pass_votes"`
 }
 
 // DeveloperParam - developer parameters
 // DeveloperMinDeposit - minimum deposit to become a developer
 // DeveloperCoinReturnIntervalSec - when withdraw or revoke, coin return to developer by coin return event
 // DeveloperCoinReturnTimes - when withdraw or revoke, coin return to developer by coin return event
+// DeveloperMaxDailySponsorship - maximum number of registrations a developer can sponsor per day
+// DeveloperPunishCooldownSec - after revoked by punish proposal, account can't register as developer in this period
 type DeveloperParam struct {
 	DeveloperMinDeposit            types.Coin `json:"developer_min_deposit"`
 	DeveloperCoinReturnIntervalSec int64      `json:"developer_coin_return_interval_second"`
 	DeveloperCoinReturnTimes       int64      `json:"developer_coin_return_times"`
+	DeveloperMaxDailySponsorship   int64      `json:"developer_max_daily_sponsorship"`
+	DeveloperPunishCooldownSec     int64      `json:"developer_punish_cooldown_second"`
 }
 
 // ValidatorParam - validator parameters
@@ -0,0 +1,191 @@
+package param
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+// isRate - rate should be in [0, 1]
+func isRate(rate sdk.Dec) bool {
+	return !rate.LT(sdk.ZeroDec()) && !rate.GT(sdk.OneDec())
+}
+
+// Validate - allocation shares must sum to one and growth rate can't exceed inflation ceiling
+func (p GlobalAllocationParam) Validate() sdk.Error {
+	if p.GlobalGrowthRate.LT(sdk.ZeroDec()) || p.GlobalGrowthRate.GT(AnnualInflationCeiling) {
+		return ErrInvalidParamValue("global growth rate")
+	}
+	if !isRate(p.InfraAllocation) || !isRate(p.ContentCreatorAllocation) ||
+		!isRate(p.DeveloperAllocation) || !isRate(p.ValidatorAllocation) {
+		return ErrInvalidParamValue("allocation share")
+	}
+	if !p.InfraAllocation.Add(p.ContentCreatorAllocation).
+		Add(p.DeveloperAllocation).Add(p.ValidatorAllocation).Equal(sdk.OneDec()) {
+		return ErrInvalidParamValue("allocation shares don't sum to one")
+	}
+	if !isRate(p.CommunityPoolAllocation) {
+		return ErrInvalidParamValue("community pool allocation")
+	}
+	return nil
+}
+
+// Validate - storage and CDN allocation must sum to one
+func (p InfraInternalAllocationParam) Validate() sdk.Error {
+	if !isRate(p.StorageAllocation) || !isRate(p.CDNAllocation) ||
+		!p.StorageAllocation.Add(p.CDNAllocation).Equal(sdk.OneDec()) {
+		return ErrInvalidParamValue("infra internal allocation")
+	}
+	return nil
+}
+
+// Validate - return intervals and times must be positive
+func (p VoteParam) Validate() sdk.Error {
+	if !p.MinStakeIn.IsPositive() {
+		return ErrInvalidParamValue("min stake in")
+	}
+	if p.VoterCoinReturnIntervalSec <= 0 || p.VoterCoinReturnTimes <= 0 ||
+		p.DelegatorCoinReturnIntervalSec <= 0 || p.DelegatorCoinReturnTimes <= 0 {
+		return ErrInvalidParamValue("coin return interval or times")
+	}
+	if p.RedelegationIntervalSec < 0 {
+		return ErrInvalidParamValue("redelegation interval")
+	}
+	if !isRate(p.MaxCommissionRate) || !isRate(p.MaxCommissionChangeRate) {
+		return ErrInvalidParamValue("commission rate")
+	}
+	if p.ConvictionLockPeriodSec <= 0 || p.MaxConviction < 0 || p.MaxConviction > types.MaxVoteConviction {
+		return ErrInvalidParamValue("conviction")
+	}
+	return nil
+}
+
+// Validate - decide seconds, deposits and pass votes must be positive, ratios in (0, 1]
+func (p ProposalParam) Validate() sdk.Error {
+	if p.ContentCensorshipDecideSec <= 0 || p.ChangeParamDecideSec <= 0 ||
+		p.ChangeParamExecutionSec <= 0 || p.ProtocolUpgradeDecideSec <= 0 ||
+		p.TextProposalDecideSec <= 0 || p.DeveloperPunishDecideSec <= 0 ||
+		p.CommunitySpendDecideSec <= 0 {
+		return ErrInvalidParamValue("proposal decide or execution second")
+	}
+	if !p.ContentCensorshipMinDeposit.IsPositive() || !p.ChangeParamMinDeposit.IsPositive() ||
+		!p.ProtocolUpgradeMinDeposit.IsPositive() || !p.TextProposalMinDeposit.IsPositive() ||
+		!p.DeveloperPunishMinDeposit.IsPositive() || !p.CommunitySpendMinDeposit.IsPositive() {
+		return ErrInvalidParamValue("proposal min deposit")
+	}
+	if !p.ContentCensorshipPassVotes.IsPositive() || !p.ChangeParamPassVotes.IsPositive() ||
+		!p.ProtocolUpgradePassVotes.IsPositive() || !p.TextProposalPassVotes.IsPositive() ||
+		!p.DeveloperPunishPassVotes.IsPositive() || !p.CommunitySpendPassVotes.IsPositive() {
+		return ErrInvalidParamValue("proposal pass votes")
+	}
+	for _, ratio := range []sdk.Dec{
+		p.ContentCensorshipPassRatio, p.ChangeParamPassRatio,
+		p.ProtocolUpgradePassRatio, p.TextProposalPassRatio, p.DeveloperPunishPassRatio,
+		p.CommunitySpendPassRatio} {
+		if !ratio.GT(sdk.ZeroDec()) || ratio.GT(sdk.OneDec()) {
+			return ErrInvalidParamValue("proposal pass ratio")
+		}
+	}
+	if p.ContentCensorshipEscalationRatio.LT(sdk.ZeroDec()) ||
+		!p.ContentCensorshipEscalationMinReport.IsNotNegative() ||
+		!isRate(p.ContentCensorshipEscalationPoolRate) {
+		return ErrInvalidParamValue("content censorship escalation")
+	}
+	// appeal is never easier to pass or cheaper than the censorship itself
+	if p.CensorshipAppealWindowSec <= 0 || p.CensorshipAppealDecideSec <= 0 ||
+		!p.CensorshipAppealMinDeposit.IsGTE(p.ContentCensorshipMinDeposit) ||
+		p.CensorshipAppealPassRatio.LT(p.ContentCensorshipPassRatio) ||
+		p.CensorshipAppealPassRatio.GT(sdk.OneDec()) ||
+		!p.CensorshipAppealPassVotes.IsPositive() {
+		return ErrInvalidParamValue("censorship appeal")
+	}
+	if p.ProposalDepositPeriodSec <= 0 {
+		return ErrInvalidParamValue("proposal deposit period")
+	}
+	return nil
+}
+
+// Validate - min deposit, return interval and times must be positive
+func (p DeveloperParam) Validate() sdk.Error {
+	if !p.DeveloperMinDeposit.IsPositive() {
+		return ErrInvalidParamValue("developer min deposit")
+	}
+	if p.DeveloperCoinReturnIntervalSec <= 0 || p.DeveloperCoinReturnTimes <= 0 {
+		return ErrInvalidParamValue("developer coin return interval or times")
+	}
+	if p.DeveloperMaxDailySponsorship < 0 {
+		return ErrInvalidParamValue("developer max daily sponsorship")
+	}
+	if p.DeveloperPunishCooldownSec < 0 {
+		return ErrInvalidParamValue("developer punish cooldown")
+	}
+	return nil
+}
+
+// Validate - deposits, penalties, return interval and list size must be positive
+func (p ValidatorParam) Validate() sdk.Error {
+	if !p.ValidatorMinWithdraw.IsPositive() || !p.ValidatorMinVotingDeposit.IsPositive() ||
+		!p.ValidatorMinCommittingDeposit.IsPositive() {
+		return ErrInvalidParamValue("validator min withdraw or deposit")
+	}
+	if !p.PenaltyMissVote.IsPositive() || !p.PenaltyMissCommit.IsPositive() ||
+		!p.PenaltyByzantine.IsPositive() {
+		return ErrInvalidParamValue("validator penalty")
+	}
+	if p.ValidatorCoinReturnIntervalSec <= 0 || p.ValidatorCoinReturnTimes <= 0 {
+		return ErrInvalidParamValue("validator coin return interval or times")
+	}
+	if p.ValidatorListSize <= 0 || p.AbsentCommitLimitation <= 0 {
+		return ErrInvalidParamValue("validator list size or absent commit limitation")
+	}
+	return nil
+}
+
+// Validate - coin day recover second must be positive
+func (p CoinDayParam) Validate() sdk.Error {
+	if p.SecondsToRecoverCoinDay <= 0 {
+		return ErrInvalidParamValue("seconds to recover coin day")
+	}
+	return nil
+}
+
+// Validate - bandwidth recover second must be positive
+func (p BandwidthParam) Validate() sdk.Error {
+	if p.SecondsToRecoverBandwidth <= 0 {
+		return ErrInvalidParamValue("seconds to recover bandwidth")
+	}
+	if !p.CapacityUsagePerTransaction.IsNotNegative() || !p.VirtualCoin.IsNotNegative() {
+		return ErrInvalidParamValue("bandwidth capacity")
+	}
+	return nil
+}
+
+// Validate - balances and fee can't be negative, frozen money limit must be positive
+func (p AccountParam) Validate() sdk.Error {
+	if !p.MinimumBalance.IsNotNegative() || !p.RegisterFee.IsNotNegative() ||
+		!p.FirstDepositFullCoinDayLimit.IsNotNegative() {
+		return ErrInvalidParamValue("account balance or fee")
+	}
+	if p.MaxNumFrozenMoney <= 0 {
+		return ErrInvalidParamValue("max num frozen money")
+	}
+	return nil
+}
+
+// Validate - intervals and max report reputation can't be negative
+func (p PostParam) Validate() sdk.Error {
+	if p.ReportOrUpvoteIntervalSec < 0 || p.PostIntervalSec < 0 {
+		return ErrInvalidParamValue("post interval")
+	}
+	if !p.MaxReportReputation.IsNotNegative() {
+		return ErrInvalidParamValue("max report reputation")
+	}
+	return nil
+}
+
+// Validate - best content index n must be positive
+func (p ReputationParam) Validate() sdk.Error {
+	if p.BestContentIndexN <= 0 {
+		return ErrInvalidParamValue("best content index n")
+	}
+	return nil
+}
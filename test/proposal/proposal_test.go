@@ -60,6 +60,7 @@ func TestForceValidatorVote(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(1, 100),
 		DeveloperAllocation:      types.NewDecFromRat(1, 100),
 		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+		CommunityPoolAllocation:  types.NewDecFromRat(0, 100),
 	}
 
 	changeAllocationMsg := proposal.NewChangeGlobalAllocationParamMsg(accountName, desc, "")
@@ -71,7 +72,7 @@ func TestForceValidatorVote(t *testing.T) {
 
 	test.SimulateOneBlock(lb, baseTime)
 	// let validator 1 vote and validator 2 not vote.
-	voteProposalMsg := proposal.NewVoteProposalMsg(accountName, int64(1), true)
+	voteProposalMsg := proposal.NewVoteProposalMsg(accountName, int64(1), true, 0)
 	test.SignCheckDeliver(t, lb, voteProposalMsg, 3, true, accountTransactionPriv, baseTime)
 
 	test.SimulateOneBlock(lb, baseTime+test.ProposalDecideSec+1)
@@ -16,6 +16,7 @@ const (
 	ParamKVStoreKey      = "param"
 	ProposalKVStoreKey   = "proposal"
 	ReputationKVStoreKey = "reputation"
+	AppDataKVStoreKey    = "appdata"
 
 	// Different permission level for msg
 	UnknownPermission                = Permission(0)
@@ -35,6 +36,15 @@ const (
 	ChangeParam       = ProposalType(0)
 	ContentCensorship = ProposalType(1)
 	ProtocolUpgrade   = ProposalType(2)
+	TextProposal      = ProposalType(3)
+	CommunitySpend    = ProposalType(4)
+	CensorshipAppeal  = ProposalType(5)
+	DeveloperPunish   = ProposalType(6)
+
+	// Different roles of developer admin
+	MetadataAdmin = AdminRole(0)
+	FinanceAdmin  = AdminRole(1)
+	GrantsAdmin   = AdminRole(2)
 
 	// Different donation types
 	DirectDeposit = DonationType(0)
@@ -55,6 +65,9 @@ const (
 	ProposalReturnCoin   = TransferDetailType(11)
 	GenesisCoin          = TransferDetailType(12)
 	ClaimInterest        = TransferDetailType(13)
+	CommunityPoolSpendIn = TransferDetailType(14)
+	RewardPoolPayout     = TransferDetailType(15)
+	BackingInflation     = TransferDetailType(16)
 
 	// Different possible outcomes
 	TransferOut      = TransferDetailType(20)
@@ -65,6 +78,7 @@ const (
 	DeveloperDeposit = TransferDetailType(25)
 	InfraDeposit     = TransferDetailType(26)
 	ProposalDeposit  = TransferDetailType(27)
+	ValidatorBacking = TransferDetailType(28)
 
 	// punishment type
 	UnknownPunish      = PunishType(0)
@@ -94,9 +108,42 @@ const (
 	// MaxPostContentLength - maximum length of post content
 	MaxPostContentLength = 1000
 
+	// MaxPostChunkLength - maximum length of one content chunk of long-form post
+	MaxPostChunkLength = 10000
+
+	// MaxPostChunks - maximum number of content chunks of long-form post
+	MaxPostChunks = 100
+
+	// PostDraftTimeoutSec - long-form post draft and its chunks are removed if not completed in time
+	PostDraftTimeoutSec = 7 * 24 * 3600
+
 	// MaxGranPermValiditySec - maximum validity period, 10 years
 	MaxGranPermValiditySec = 10 * 3600 * 24 * 365
 
+	// CommissionChangeIntervalSec - minimum seconds between two commission rate changes of a voter
+	CommissionChangeIntervalSec = 24 * 3600
+
+	// SponsorshipIntervalSec - interval of developer daily sponsorship cap
+	SponsorshipIntervalSec = 24 * 3600
+
+	// MaxAppDataKeyLength - maximum length of key in app data namespace
+	MaxAppDataKeyLength = 128
+
+	// MaxAppDataValueLength - maximum length of value in app data namespace
+	MaxAppDataValueLength = 4096
+
+	// AppDataBytesPerTransaction - app data write costs one transaction per this many bytes
+	AppDataBytesPerTransaction = 512
+
+	// AppDataCoinPerByte - developer gets one byte app data quota per this much deposit coin
+	AppDataCoinPerByte = 1 * Decimals
+
+	// VoteWeightBase - weights of a split vote on yes, no and abstain sum up to this base
+	VoteWeightBase = 10000
+
+	// MaxVoteConviction - upper bound of conviction level, lock period doubles with each level
+	MaxVoteConviction = 6
+
 	// KeySeparator - separate different key component
 	KeySeparator = "/"
 
@@ -139,6 +186,18 @@ const (
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
+	// MaximumLengthOfTextProposalTitle - maximum length of text proposal title
+	MaximumLengthOfTextProposalTitle = 100
+
+	// MaximumLengthOfTextProposalDescription - maximum length of text proposal description
+	MaximumLengthOfTextProposalDescription = 5000
+
+	// MaximumLengthOfUpgradeName - maximum length of upgrade name in protocol upgrade proposal
+	MaximumLengthOfUpgradeName = 64
+
+	// CensorshipEscalationReason - reason of content censorship proposal opened by report escalation
+	CensorshipEscalationReason = "reports exceed censorship escalation threshold"
+
 	// InitAccountWithFullCoinDayMemo - init account with full coin day memo
 	InitAccountWithFullCoinDayMemo = "open account deposit"
 
@@ -147,6 +147,23 @@ const (
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
 	CodePostQueryFailed                      sdk.CodeType = 441
+	CodeFailedToMarshalEscalationQueue       sdk.CodeType = 442
+	CodeFailedToUnmarshalEscalationQueue     sdk.CodeType = 443
+	CodePostDraftNotFound                    sdk.CodeType = 444
+	CodeFailedToMarshalPostDraft             sdk.CodeType = 445
+	CodeFailedToUnmarshalPostDraft           sdk.CodeType = 446
+	CodePostChunkNotFound                    sdk.CodeType = 447
+	CodeFailedToMarshalPostChunk             sdk.CodeType = 448
+	CodeFailedToUnmarshalPostChunk           sdk.CodeType = 449
+	CodeInvalidPostContentHash               sdk.CodeType = 450
+	CodeInvalidNumOfPostChunks               sdk.CodeType = 451
+	CodePostChunkExceedMaxLength             sdk.CodeType = 452
+	CodeInvalidPostChunkIndex                sdk.CodeType = 453
+	CodePostContentHashMismatch              sdk.CodeType = 454
+	CodePostDraftAlreadyExist                sdk.CodeType = 455
+	CodeCensoredPostNotFound                 sdk.CodeType = 456
+	CodeFailedToMarshalCensoredPost          sdk.CodeType = 457
+	CodeFailedToUnmarshalCensoredPost        sdk.CodeType = 458
 
 	// Lino validator errors reserve 500 ~ 599
 	CodeValidatorNotFound              sdk.CodeType = 500
@@ -158,6 +175,13 @@ const (
 	CodeUnbalancedAccount              sdk.CodeType = 506
 	CodeValidatorPubKeyAlreadyExist    sdk.CodeType = 507
 	CodeValidatorQueryFailed           sdk.CodeType = 508
+	CodeFailedToMarshalValCommission   sdk.CodeType = 509
+	CodeFailedToUnmarshalValCommission sdk.CodeType = 510
+	CodeBackingNotFound                sdk.CodeType = 511
+	CodeFailedToMarshalBacking         sdk.CodeType = 512
+	CodeFailedToUnmarshalBacking       sdk.CodeType = 513
+	CodeInsufficientBacking            sdk.CodeType = 514
+	CodeBackSelf                       sdk.CodeType = 515
 
 	// Lino global errors reserve 600 ~ 699
 	CodeInfraInflationCoinConversion           sdk.CodeType = 600
@@ -188,6 +212,18 @@ const (
 	CodePastDayIsNegative                      sdk.CodeType = 625
 	CodeFailedToParseEventCacheList            sdk.CodeType = 626
 	CodeGlobalQueryFailed                      sdk.CodeType = 627
+	CodeCensorshipEscalationPoolNotEnough      sdk.CodeType = 628
+	CodeFailedToMarshalCoinReturn              sdk.CodeType = 629
+	CodeFailedToUnmarshalCoinReturn            sdk.CodeType = 630
+	CodeCommunityPoolNotEnough                 sdk.CodeType = 631
+	CodeFailedToMarshalCommunityPool           sdk.CodeType = 632
+	CodeFailedToUnmarshalCommunityPool         sdk.CodeType = 633
+	CodeFailedToMarshalCommunityPoolSpend      sdk.CodeType = 634
+	CodeFailedToUnmarshalCommunityPoolSpend    sdk.CodeType = 635
+	CodeUpgradePlanNotFound                    sdk.CodeType = 636
+	CodeFailedToMarshalUpgradePlan             sdk.CodeType = 637
+	CodeFailedToUnmarshalUpgradePlan           sdk.CodeType = 638
+	CodeUpgradePlanAlreadyExist                sdk.CodeType = 639
 
 	// Vote errors reserve 700 ~ 799
 	CodeVoterNotFound                  sdk.CodeType = 700
@@ -205,6 +241,23 @@ const (
 	CodeValidatorCannotRevoke          sdk.CodeType = 712
 	CodeVoteAlreadyExist               sdk.CodeType = 713
 	CodeVoteQueryFailed                sdk.CodeType = 714
+	CodeRedelegateTooOften             sdk.CodeType = 715
+	CodeRedelegateToSameVoter          sdk.CodeType = 716
+	CodeInvalidCommissionRate          sdk.CodeType = 717
+	CodeCommissionRateTooHigh          sdk.CodeType = 718
+	CodeCommissionChangeTooOften       sdk.CodeType = 719
+	CodeCommissionChangeTooMuch        sdk.CodeType = 720
+	CodeVoterCommissionNotFound        sdk.CodeType = 721
+	CodeFailedToMarshalCommission      sdk.CodeType = 722
+	CodeFailedToUnmarshalCommission    sdk.CodeType = 723
+	CodeDelegationInterestNotFound     sdk.CodeType = 724
+	CodeFailedToMarshalInterest        sdk.CodeType = 725
+	CodeFailedToUnmarshalInterest      sdk.CodeType = 726
+	CodeInvalidVoteWeight              sdk.CodeType = 727
+	CodeInvalidConviction              sdk.CodeType = 728
+	CodeFailedToMarshalConviction      sdk.CodeType = 729
+	CodeFailedToUnmarshalConviction    sdk.CodeType = 730
+	CodeRedelegateDuringVoting         sdk.CodeType = 731
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -232,6 +285,30 @@ const (
 	CodeInvalidAppMetadata             sdk.CodeType = 912
 	CodeInvalidGrantPermission         sdk.CodeType = 913
 	CodeDeveloperQueryFailed           sdk.CodeType = 914
+	CodeSponsorshipLimitExceeded       sdk.CodeType = 915
+	CodeInsufficientSponsorDeposit     sdk.CodeType = 916
+	CodeFeeGrantNotFound               sdk.CodeType = 917
+	CodeFailedToMarshalFeeGrant        sdk.CodeType = 918
+	CodeFailedToUnmarshalFeeGrant      sdk.CodeType = 919
+	CodeInvalidFeeGrantLimit           sdk.CodeType = 920
+	CodeFeeGrantNotEnough              sdk.CodeType = 921
+	CodeFailedToMarshalStatement       sdk.CodeType = 922
+	CodeFailedToUnmarshalStatement     sdk.CodeType = 923
+	CodeFailedToMarshalCooldown        sdk.CodeType = 924
+	CodeFailedToUnmarshalCooldown      sdk.CodeType = 925
+	CodeDeveloperInPunishCooldown      sdk.CodeType = 926
+	CodeFailedToMarshalAdmin           sdk.CodeType = 927
+	CodeFailedToUnmarshalAdmin         sdk.CodeType = 928
+	CodeDeveloperAdminNotFound         sdk.CodeType = 929
+	CodeInvalidAdminRole               sdk.CodeType = 930
+	CodeAdminNotAuthorized             sdk.CodeType = 931
+	CodeRewardPoolNotFound             sdk.CodeType = 932
+	CodeFailedToMarshalRewardPool      sdk.CodeType = 933
+	CodeFailedToUnmarshalRewardPool    sdk.CodeType = 934
+	CodeFailedToMarshalUserDonation    sdk.CodeType = 935
+	CodeFailedToUnmarshalUserDonation  sdk.CodeType = 936
+	CodeInvalidRewardPoolShare         sdk.CodeType = 937
+	CodeDeveloperUnderPunishment       sdk.CodeType = 938
 
 	// Param errors reserve 1000 ~ 1099
 	CodeParamHolderGenesisError                       sdk.CodeType = 1000
@@ -273,6 +350,7 @@ const (
 	CodeFailedToUnmarshalReputationParam              sdk.CodeType = 1036
 	CodeReputationParamNotFound                       sdk.CodeType = 1037
 	CodeParamQueryFailed                              sdk.CodeType = 1038
+	CodeInvalidParamValue                             sdk.CodeType = 1039
 
 	// Proposal errors reserve 1100 ~ 1199
 	CodeOngoingProposalNotFound         sdk.CodeType = 1100
@@ -294,7 +372,37 @@ const (
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
 	CodeProposalQueryFailed             sdk.CodeType = 1118
+	CodeEscalationDepositNotFound       sdk.CodeType = 1119
+	CodeFailedToMarshalEscalation       sdk.CodeType = 1120
+	CodeFailedToUnmarshalEscalation     sdk.CodeType = 1121
+	CodeIllegalVoteWeight               sdk.CodeType = 1122
+	CodeInvalidProposalTitle            sdk.CodeType = 1123
+	CodeProposalDescriptionTooLong      sdk.CodeType = 1124
+	CodeNotPendingProposal              sdk.CodeType = 1125
+	CodeNotProposalCreator              sdk.CodeType = 1126
+	CodeProposalFundingNotFound         sdk.CodeType = 1127
+	CodeFailedToMarshalFunding          sdk.CodeType = 1128
+	CodeFailedToUnmarshalFunding        sdk.CodeType = 1129
+	CodeInvalidUpgradePlan              sdk.CodeType = 1130
+	CodeInvalidParamBundle              sdk.CodeType = 1131
+	CodeCensorshipNotAppealable         sdk.CodeType = 1132
+	CodeAppealWindowClosed              sdk.CodeType = 1133
+	CodeNotCensoredPostAuthor           sdk.CodeType = 1134
+	CodeIllegalConviction               sdk.CodeType = 1135
+	CodeIllegalSlashRatio               sdk.CodeType = 1136
+	CodeUpgradePlanPending              sdk.CodeType = 1137
 
 	// reputation errors reserve 1200 ~ 1299
 	CodeReputationQueryFailed sdk.CodeType = 1200
+
+	// app data errors reserve 1300 ~ 1399
+	CodeAppDataNotFound               sdk.CodeType = 1300
+	CodeFailedToMarshalAppData        sdk.CodeType = 1301
+	CodeFailedToUnmarshalAppData      sdk.CodeType = 1302
+	CodeFailedToMarshalStorageUsage   sdk.CodeType = 1303
+	CodeFailedToUnmarshalStorageUsage sdk.CodeType = 1304
+	CodeInvalidAppDataKey             sdk.CodeType = 1305
+	CodeAppDataValueTooLong           sdk.CodeType = 1306
+	CodeStorageQuotaExceeded          sdk.CodeType = 1307
+	CodeAppDataQueryFailed            sdk.CodeType = 1308
 )
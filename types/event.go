@@ -7,3 +7,11 @@ type Event interface{}
 type TimeEventList struct {
 	Events []Event `json:"events"`
 }
+
+// CoinReturnEvent - event which returns coin to a user in the future,
+// indexed by username when registered to the time event list
+type CoinReturnEvent interface {
+	GetUsername() AccountKey
+	GetAmount() Coin
+	GetReturnType() TransferDetailType
+}
@@ -13,6 +13,21 @@ type ReturnCoinEvent struct {
 	ReturnType types.TransferDetailType `json:"return_type"`
 }
 
+// GetUsername - get user who receives the returned coin
+func (event ReturnCoinEvent) GetUsername() types.AccountKey {
+	return event.Username
+}
+
+// GetAmount - get amount of coin to return
+func (event ReturnCoinEvent) GetAmount() types.Coin {
+	return event.Amount
+}
+
+// GetReturnType - get transfer detail type of the coin return
+func (event ReturnCoinEvent) GetReturnType() types.TransferDetailType {
+	return event.ReturnType
+}
+
 // Execute - execute coin return events
 func (event ReturnCoinEvent) Execute(ctx sdk.Context, am AccountManager) sdk.Error {
 	if !am.DoesAccountExist(ctx, event.Username) {
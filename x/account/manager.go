@@ -381,6 +381,27 @@ func (accManager AccountManager) GetAppKey(
 	return accountInfo.AppKey, nil
 }
 
+// GetSponsor - get app which sponsored the registration of user, empty if not sponsored
+func (accManager AccountManager) GetSponsor(
+	ctx sdk.Context, username types.AccountKey) (types.AccountKey, sdk.Error) {
+	accountInfo, err := accManager.storage.GetInfo(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	return accountInfo.SponsoredBy, nil
+}
+
+// SetSponsor - record app which sponsored the registration of user
+func (accManager AccountManager) SetSponsor(
+	ctx sdk.Context, username types.AccountKey, app types.AccountKey) sdk.Error {
+	accountInfo, err := accManager.storage.GetInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	accountInfo.SponsoredBy = app
+	return accManager.storage.SetInfo(ctx, username, accountInfo)
+}
+
 // GetSavingFromBank - get user balance
 func (accManager AccountManager) GetSavingFromBank(
 	ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
@@ -16,6 +16,7 @@ type AccountInfo struct {
 	ResetKey       crypto.PubKey    `json:"reset_key"`
 	TransactionKey crypto.PubKey    `json:"transaction_key"`
 	AppKey         crypto.PubKey    `json:"app_key"`
+	SponsoredBy    types.AccountKey `json:"sponsored_by"`
 }
 
 // AccountBank - user balance
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/x/appdata"
+)
+
+// SetAppDataTxCmd - app writes value under key of its namespace
+func SetAppDataTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-app-data",
+		Short: "app writes value under key of its namespace",
+		RunE:  sendSetAppDataTx(cdc),
+	}
+	cmd.Flags().String(client.FlagApp, "", "app who owns the namespace")
+	cmd.Flags().String(client.FlagKey, "", "key to write")
+	cmd.Flags().String(client.FlagValue, "", "value to write")
+	return cmd
+}
+
+// send set app data transaction to the blockchain
+func sendSetAppDataTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		app := viper.GetString(client.FlagApp)
+		key := viper.GetString(client.FlagKey)
+		value := viper.GetString(client.FlagValue)
+
+		msg := appdata.NewSetAppDataMsg(app, key, value)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// DeleteAppDataTxCmd - app deletes key from its namespace
+func DeleteAppDataTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-app-data",
+		Short: "app deletes key from its namespace",
+		RunE:  sendDeleteAppDataTx(cdc),
+	}
+	cmd.Flags().String(client.FlagApp, "", "app who owns the namespace")
+	cmd.Flags().String(client.FlagKey, "", "key to delete")
+	return cmd
+}
+
+// send delete app data transaction to the blockchain
+func sendDeleteAppDataTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		app := viper.GetString(client.FlagApp)
+		key := viper.GetString(client.FlagKey)
+
+		msg := appdata.NewDeleteAppDataMsg(app, key)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/appdata/model"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+)
+
+// GetAppDataCmd - returns value under key of app namespace
+func GetAppDataCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "app-data",
+		Short: "Query app data by app and key",
+		RunE:  cmdr.getAppDataCmd,
+	}
+}
+
+// GetAppDataListCmd - returns all app data whose key starts with prefix
+func GetAppDataListCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "app-data-list",
+		Short: "Query app data of app with key prefix",
+		RunE:  cmdr.getAppDataListCmd,
+	}
+	cmd.Flags().String(client.FlagPrefix, "", "key prefix, omit to list all keys")
+	return cmd
+}
+
+// GetStorageUsageCmd - returns storage used by app namespace
+func GetStorageUsageCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "app-storage-usage",
+		Short: "Query storage used by app",
+		RunE:  cmdr.getStorageUsageCmd,
+	}
+}
+
+type commander struct {
+	storeName string
+	cdc       *wire.Codec
+}
+
+func (c commander) getAppDataCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an app name and a key")
+	}
+
+	res, err := ctx.Query(model.GetAppDataKey(types.AccountKey(args[0]), args[1]), c.storeName)
+	if err != nil {
+		return err
+	}
+	data := new(model.AppData)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, data); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getAppDataListCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an app name")
+	}
+
+	prefix := model.GetAppDataKey(types.AccountKey(args[0]), viper.GetString(client.FlagPrefix))
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	dataList := []model.AppData{}
+	for _, kv := range resKVs {
+		var data model.AppData
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(kv.Value, &data); err != nil {
+			return err
+		}
+		dataList = append(dataList, data)
+	}
+
+	output, err := json.MarshalIndent(dataList, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getStorageUsageCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an app name")
+	}
+
+	res, err := ctx.Query(model.GetStorageUsageKey(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	usage := new(model.StorageUsage)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, usage); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(usage, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
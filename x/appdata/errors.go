@@ -0,0 +1,38 @@
+package appdata
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+// ErrInvalidUsername - error if username is invalid
+func ErrInvalidUsername() sdk.Error {
+	return types.NewError(types.CodeInvalidUsername, fmt.Sprintf("invalid Username"))
+}
+
+// ErrDeveloperNotFound - error if developer is not found
+func ErrDeveloperNotFound(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeDeveloperNotFound, fmt.Sprintf("developer %v is not found", app))
+}
+
+// ErrInvalidAppDataKey - error if app data key is empty or too long
+func ErrInvalidAppDataKey() sdk.Error {
+	return types.NewError(types.CodeInvalidAppDataKey, fmt.Sprintf("invalid app data key"))
+}
+
+// ErrAppDataValueTooLong - error if app data value is too long
+func ErrAppDataValueTooLong() sdk.Error {
+	return types.NewError(types.CodeAppDataValueTooLong, fmt.Sprintf("app data value is too long"))
+}
+
+// ErrStorageQuotaExceeded - error if app storage usage exceeds quota
+func ErrStorageQuotaExceeded(app types.AccountKey, usedBytes, quota int64) sdk.Error {
+	return types.NewError(types.CodeStorageQuotaExceeded, fmt.Sprintf("app %v storage %v bytes exceeds quota %v bytes", app, usedBytes, quota))
+}
+
+// ErrQueryFailed - error when query app data store failed
+func ErrQueryFailed() sdk.Error {
+	return types.NewError(types.CodeAppDataQueryFailed, fmt.Sprintf("query app data store failed"))
+}
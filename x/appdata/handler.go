@@ -0,0 +1,48 @@
+package appdata
+
+import (
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dev "github.com/lino-network/lino/x/developer"
+)
+
+// NewHandler - Handle all "appdata" type messages.
+func NewHandler(adm AppDataManager, dm dev.DeveloperManager) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case SetAppDataMsg:
+			return handleSetAppDataMsg(ctx, adm, dm, msg)
+		case DeleteAppDataMsg:
+			return handleDeleteAppDataMsg(ctx, adm, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized appdata msg type: %v", reflect.TypeOf(msg).Name())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleSetAppDataMsg(
+	ctx sdk.Context, adm AppDataManager, dm dev.DeveloperManager, msg SetAppDataMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.App) {
+		return ErrDeveloperNotFound(msg.App).Result()
+	}
+	quota, err := dm.GetStorageQuota(ctx, msg.App)
+	if err != nil {
+		return err.Result()
+	}
+	if err := adm.SetAppData(ctx, msg.App, msg.Key, msg.Value, quota); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// revoked developer can still delete its data to clean up the namespace.
+func handleDeleteAppDataMsg(ctx sdk.Context, adm AppDataManager, msg DeleteAppDataMsg) sdk.Result {
+	if err := adm.DeleteAppData(ctx, msg.App, msg.Key); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
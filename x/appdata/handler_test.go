@@ -0,0 +1,86 @@
+package appdata
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/appdata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleSetAppDataMsg(t *testing.T) {
+	ctx, adm, dm := setupTest(t, 0)
+	handler := NewHandler(adm, dm)
+
+	app := types.AccountKey("app")
+	devParam, _ := adm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, app, devParam.DeveloperMinDeposit, "", "", "")
+	quota, _ := dm.GetStorageQuota(ctx, app)
+	largeValue := strings.Repeat("v", types.MaxAppDataValueLength)
+
+	testCases := []struct {
+		testName     string
+		msg          SetAppDataMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "developer doesn't exist",
+			msg:          NewSetAppDataMsg("user1", "key", "value"),
+			expectResult: ErrDeveloperNotFound("user1").Result(),
+		},
+		{
+			testName:     "set app data",
+			msg:          NewSetAppDataMsg(string(app), "key", "value"),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "overwrite app data",
+			msg:          NewSetAppDataMsg(string(app), "key", largeValue),
+			expectResult: sdk.Result{},
+		},
+	}
+	for _, tc := range testCases {
+		res := handler(ctx, tc.msg)
+		assert.Equal(t, tc.expectResult, res, "%s", tc.testName)
+	}
+	data, _ := adm.GetAppData(ctx, app, "key")
+	assert.Equal(t, largeValue, data.Value)
+
+	// fill up the quota
+	usage, _ := adm.GetStorageUsage(ctx, app)
+	for i := 0; ; i++ {
+		key := fmt.Sprintf("key%d", i)
+		res := handler(ctx, NewSetAppDataMsg(string(app), key, largeValue))
+		if usage.UsedBytes+int64(len(key)+len(largeValue)) > quota {
+			assert.Equal(t, types.CodeStorageQuotaExceeded, res.Code)
+			break
+		}
+		assert.Equal(t, sdk.Result{}, res)
+		usage, _ = adm.GetStorageUsage(ctx, app)
+	}
+	assert.True(t, usage.UsedBytes <= quota)
+}
+
+func TestHandleDeleteAppDataMsg(t *testing.T) {
+	ctx, adm, dm := setupTest(t, 0)
+	handler := NewHandler(adm, dm)
+
+	app := types.AccountKey("app")
+	devParam, _ := adm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, app, devParam.DeveloperMinDeposit, "", "", "")
+
+	res := handler(ctx, NewSetAppDataMsg(string(app), "key", "value"))
+	assert.Equal(t, sdk.Result{}, res)
+
+	// revoked developer can still delete its data
+	dm.WithdrawAll(ctx, app)
+	res = handler(ctx, NewDeleteAppDataMsg(string(app), "key"))
+	assert.Equal(t, sdk.Result{}, res)
+	assert.False(t, adm.DoesAppDataExist(ctx, app, "key"))
+
+	res = handler(ctx, NewDeleteAppDataMsg(string(app), "key"))
+	assert.Equal(t, model.ErrAppDataNotFound().Result(), res)
+}
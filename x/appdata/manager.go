@@ -0,0 +1,102 @@
+package appdata
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/appdata/model"
+)
+
+// AppDataManager - app data manager
+type AppDataManager struct {
+	storage     model.AppDataStorage
+	paramHolder param.ParamHolder
+}
+
+// NewAppDataManager - create new app data manager
+func NewAppDataManager(key sdk.StoreKey, holder param.ParamHolder) AppDataManager {
+	return AppDataManager{
+		storage:     model.NewAppDataStorage(key),
+		paramHolder: holder,
+	}
+}
+
+// DoesAppDataExist - check if key exists in app namespace
+func (adm AppDataManager) DoesAppDataExist(ctx sdk.Context, app types.AccountKey, key string) bool {
+	return adm.storage.DoesAppDataExist(ctx, app, key)
+}
+
+// SetAppData - write value under key of app namespace, total size can't exceed quota
+func (adm AppDataManager) SetAppData(
+	ctx sdk.Context, app types.AccountKey, key, value string, quota int64) sdk.Error {
+	usage, err := adm.storage.GetStorageUsage(ctx, app)
+	if err != nil {
+		return err
+	}
+	data := &model.AppData{
+		Key:       key,
+		Value:     value,
+		UpdatedAt: ctx.BlockHeader().Time.Unix(),
+	}
+	usedBytes := usage.UsedBytes + data.Size()
+	numOfKeys := usage.NumOfKeys + 1
+	if prev, err := adm.storage.GetAppData(ctx, app, key); err == nil {
+		usedBytes -= prev.Size()
+		numOfKeys--
+	}
+	if usedBytes > quota {
+		return ErrStorageQuotaExceeded(app, usedBytes, quota)
+	}
+	if err := adm.storage.SetAppData(ctx, app, data); err != nil {
+		return err
+	}
+	usage.UsedBytes = usedBytes
+	usage.NumOfKeys = numOfKeys
+	return adm.storage.SetStorageUsage(ctx, app, usage)
+}
+
+// DeleteAppData - delete key from app namespace and free its storage
+func (adm AppDataManager) DeleteAppData(ctx sdk.Context, app types.AccountKey, key string) sdk.Error {
+	data, err := adm.storage.GetAppData(ctx, app, key)
+	if err != nil {
+		return err
+	}
+	usage, err := adm.storage.GetStorageUsage(ctx, app)
+	if err != nil {
+		return err
+	}
+	if err := adm.storage.DeleteAppData(ctx, app, key); err != nil {
+		return err
+	}
+	usage.UsedBytes -= data.Size()
+	usage.NumOfKeys--
+	return adm.storage.SetStorageUsage(ctx, app, usage)
+}
+
+// GetAppData - get value under key of app namespace
+func (adm AppDataManager) GetAppData(
+	ctx sdk.Context, app types.AccountKey, key string) (*model.AppData, sdk.Error) {
+	return adm.storage.GetAppData(ctx, app, key)
+}
+
+// GetAppDataWithPrefix - get all app data whose key starts with prefix
+func (adm AppDataManager) GetAppDataWithPrefix(
+	ctx sdk.Context, app types.AccountKey, prefix string) ([]model.AppData, sdk.Error) {
+	return adm.storage.GetAppDataWithPrefix(ctx, app, prefix)
+}
+
+// GetStorageUsage - get storage used by app namespace
+func (adm AppDataManager) GetStorageUsage(
+	ctx sdk.Context, app types.AccountKey) (*model.StorageUsage, sdk.Error) {
+	return adm.storage.GetStorageUsage(ctx, app)
+}
+
+// Export state of app data.
+func (adm AppDataManager) Export(ctx sdk.Context) *model.AppDataTables {
+	return adm.storage.Export(ctx)
+}
+
+// Import state of app data.
+func (adm AppDataManager) Import(ctx sdk.Context, tb *model.AppDataTablesIR) {
+	adm.storage.Import(ctx, tb)
+}
@@ -0,0 +1,54 @@
+package appdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/appdata/model"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestSetAndDeleteAppData(t *testing.T) {
+	ctx, adm, _ := setupTest(t, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+	app := types.AccountKey("app")
+
+	// new key
+	err := adm.SetAppData(ctx, app, "k1", "value", 100)
+	assert.Nil(t, err)
+	data, err := adm.GetAppData(ctx, app, "k1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.AppData{Key: "k1", Value: "value", UpdatedAt: 100}, *data)
+	usage, _ := adm.GetStorageUsage(ctx, app)
+	assert.Equal(t, model.StorageUsage{UsedBytes: 7, NumOfKeys: 1}, *usage)
+
+	// overwrite only charges the difference
+	err = adm.SetAppData(ctx, app, "k1", "v", 100)
+	assert.Nil(t, err)
+	usage, _ = adm.GetStorageUsage(ctx, app)
+	assert.Equal(t, model.StorageUsage{UsedBytes: 3, NumOfKeys: 1}, *usage)
+
+	// exceeds quota, nothing written
+	err = adm.SetAppData(ctx, app, "k2", "value", 7)
+	assert.Equal(t, ErrStorageQuotaExceeded(app, 10, 7), err)
+	assert.False(t, adm.DoesAppDataExist(ctx, app, "k2"))
+	usage, _ = adm.GetStorageUsage(ctx, app)
+	assert.Equal(t, model.StorageUsage{UsedBytes: 3, NumOfKeys: 1}, *usage)
+
+	err = adm.SetAppData(ctx, app, "k2", "value", 10)
+	assert.Nil(t, err)
+	usage, _ = adm.GetStorageUsage(ctx, app)
+	assert.Equal(t, model.StorageUsage{UsedBytes: 10, NumOfKeys: 2}, *usage)
+
+	// delete frees storage
+	err = adm.DeleteAppData(ctx, app, "k1")
+	assert.Nil(t, err)
+	assert.False(t, adm.DoesAppDataExist(ctx, app, "k1"))
+	usage, _ = adm.GetStorageUsage(ctx, app)
+	assert.Equal(t, model.StorageUsage{UsedBytes: 7, NumOfKeys: 1}, *usage)
+
+	err = adm.DeleteAppData(ctx, app, "k1")
+	assert.Equal(t, model.ErrAppDataNotFound().Code(), err.Code())
+}
@@ -0,0 +1,19 @@
+package model
+
+// AppData - value written by app under key of its namespace
+type AppData struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// Size - bytes counted against storage quota of app
+func (data AppData) Size() int64 {
+	return int64(len(data.Key) + len(data.Value))
+}
+
+// StorageUsage - storage used by app namespace
+type StorageUsage struct {
+	UsedBytes int64 `json:"used_bytes"`
+	NumOfKeys int64 `json:"num_of_keys"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+// ErrAppDataNotFound - error if app data is not found
+func ErrAppDataNotFound() sdk.Error {
+	return types.NewError(types.CodeAppDataNotFound, fmt.Sprintf("app data is not found"))
+}
+
+// ErrFailedToMarshalAppData - error if marshal app data failed
+func ErrFailedToMarshalAppData(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalAppData, fmt.Sprintf("failed to marshal app data: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalAppData - error if unmarshal app data failed
+func ErrFailedToUnmarshalAppData(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalAppData, fmt.Sprintf("failed to unmarshal app data: %s", err.Error()))
+}
+
+// ErrFailedToMarshalStorageUsage - error if marshal storage usage failed
+func ErrFailedToMarshalStorageUsage(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalStorageUsage, fmt.Sprintf("failed to marshal storage usage: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalStorageUsage - error if unmarshal storage usage failed
+func ErrFailedToUnmarshalStorageUsage(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalStorageUsage, fmt.Sprintf("failed to unmarshal storage usage: %s", err.Error()))
+}
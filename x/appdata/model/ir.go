@@ -0,0 +1,4 @@
+package model
+
+// AppDataTablesIR - same
+type AppDataTablesIR = AppDataTables
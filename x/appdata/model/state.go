@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// AppDataRow - app data, pk: (App, Data.Key)
+type AppDataRow struct {
+	App  types.AccountKey `json:"app"`
+	Data AppData          `json:"data"`
+}
+
+// StorageUsageRow - storage usage of app, pk: App
+type StorageUsageRow struct {
+	App   types.AccountKey `json:"app"`
+	Usage StorageUsage     `json:"usage"`
+}
+
+// AppDataTables app data storage state
+type AppDataTables struct {
+	AppData       []AppDataRow      `json:"app_data"`
+	StorageUsages []StorageUsageRow `json:"storage_usages"`
+}
+
+// ToIR - same
+func (a AppDataTables) ToIR() AppDataTablesIR {
+	return a
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"strings"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+var (
+	appDataSubstore      = []byte{0x00}
+	storageUsageSubstore = []byte{0x01}
+)
+
+// AppDataStorage - app data storage
+type AppDataStorage struct {
+	key sdk.StoreKey
+	cdc *wire.Codec
+}
+
+// NewAppDataStorage - create a new app data storage
+func NewAppDataStorage(key sdk.StoreKey) AppDataStorage {
+	cdc := wire.New()
+	wire.RegisterCrypto(cdc)
+	return AppDataStorage{
+		key: key,
+		cdc: cdc,
+	}
+}
+
+// DoesAppDataExist - check if key exists in app namespace
+func (as AppDataStorage) DoesAppDataExist(ctx sdk.Context, app types.AccountKey, key string) bool {
+	store := ctx.KVStore(as.key)
+	return store.Has(GetAppDataKey(app, key))
+}
+
+// GetAppData - get app data from KVStore
+func (as AppDataStorage) GetAppData(
+	ctx sdk.Context, app types.AccountKey, key string) (*AppData, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	dataByte := store.Get(GetAppDataKey(app, key))
+	if dataByte == nil {
+		return nil, ErrAppDataNotFound()
+	}
+	data := new(AppData)
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(dataByte, data); err != nil {
+		return nil, ErrFailedToUnmarshalAppData(err)
+	}
+	return data, nil
+}
+
+// GetAppDataWithPrefix - get all app data whose key starts with prefix, ordered by key
+func (as AppDataStorage) GetAppDataWithPrefix(
+	ctx sdk.Context, app types.AccountKey, prefix string) ([]AppData, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	dataList := []AppData{}
+	iter := sdk.KVStorePrefixIterator(store, GetAppDataKey(app, prefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		data := new(AppData)
+		if err := as.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), data); err != nil {
+			return nil, ErrFailedToUnmarshalAppData(err)
+		}
+		dataList = append(dataList, *data)
+	}
+	return dataList, nil
+}
+
+// SetAppData - set app data to KVStore
+func (as AppDataStorage) SetAppData(ctx sdk.Context, app types.AccountKey, data *AppData) sdk.Error {
+	store := ctx.KVStore(as.key)
+	dataByte, err := as.cdc.MarshalBinaryLengthPrefixed(*data)
+	if err != nil {
+		return ErrFailedToMarshalAppData(err)
+	}
+	store.Set(GetAppDataKey(app, data.Key), dataByte)
+	return nil
+}
+
+// DeleteAppData - delete app data from KVStore
+func (as AppDataStorage) DeleteAppData(ctx sdk.Context, app types.AccountKey, key string) sdk.Error {
+	store := ctx.KVStore(as.key)
+	store.Delete(GetAppDataKey(app, key))
+	return nil
+}
+
+// GetStorageUsage - get storage usage of app, empty usage if app never writes
+func (as AppDataStorage) GetStorageUsage(ctx sdk.Context, app types.AccountKey) (*StorageUsage, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	usageByte := store.Get(GetStorageUsageKey(app))
+	if usageByte == nil {
+		return &StorageUsage{}, nil
+	}
+	usage := new(StorageUsage)
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(usageByte, usage); err != nil {
+		return nil, ErrFailedToUnmarshalStorageUsage(err)
+	}
+	return usage, nil
+}
+
+// SetStorageUsage - set storage usage of app to KVStore
+func (as AppDataStorage) SetStorageUsage(ctx sdk.Context, app types.AccountKey, usage *StorageUsage) sdk.Error {
+	store := ctx.KVStore(as.key)
+	usageByte, err := as.cdc.MarshalBinaryLengthPrefixed(*usage)
+	if err != nil {
+		return ErrFailedToMarshalStorageUsage(err)
+	}
+	store.Set(GetStorageUsageKey(app), usageByte)
+	return nil
+}
+
+// Export - app data state
+func (as AppDataStorage) Export(ctx sdk.Context) *AppDataTables {
+	tables := &AppDataTables{}
+	store := ctx.KVStore(as.key)
+	// export table.AppData
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, appDataSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.SplitN(string(itr.Key()[1:]), types.KeySeparator, 2)
+			if len(strs) != 2 {
+				panic("illegal app data key: " + string(itr.Key()[1:]))
+			}
+			data := new(AppData)
+			if err := as.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), data); err != nil {
+				panic("failed to read app data: " + err.Error())
+			}
+			tables.AppData = append(tables.AppData, AppDataRow{
+				App:  types.AccountKey(strs[0]),
+				Data: *data,
+			})
+		}
+	}()
+	// export table.StorageUsages
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, storageUsageSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			app := types.AccountKey(itr.Key()[1:])
+			usage, err := as.GetStorageUsage(ctx, app)
+			if err != nil {
+				panic("failed to read storage usage: " + err.Error())
+			}
+			tables.StorageUsages = append(tables.StorageUsages, StorageUsageRow{
+				App:   app,
+				Usage: *usage,
+			})
+		}
+	}()
+	return tables
+}
+
+// Import from tablesIR.
+func (as AppDataStorage) Import(ctx sdk.Context, tb *AppDataTablesIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[as] Failed to import: " + e.Error())
+		}
+	}
+	// import table.AppData
+	for _, v := range tb.AppData {
+		err := as.SetAppData(ctx, v.App, &v.Data)
+		check(err)
+	}
+	// import table.StorageUsages
+	for _, v := range tb.StorageUsages {
+		err := as.SetStorageUsage(ctx, v.App, &v.Usage)
+		check(err)
+	}
+}
+
+// GetAppDataPrefix - "app data substore" + "app" + sep
+func GetAppDataPrefix(app types.AccountKey) []byte {
+	return append(append(appDataSubstore, app...), types.KeySeparator...)
+}
+
+// GetAppDataKey - "app data substore" + "app" + sep + "key"
+func GetAppDataKey(app types.AccountKey, key string) []byte {
+	return append(GetAppDataPrefix(app), key...)
+}
+
+// GetStorageUsageKey - "storage usage substore" + "app"
+func GetStorageUsageKey(app types.AccountKey) []byte {
+	return append(storageUsageSubstore, app...)
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	TestKVStoreKey = sdk.NewKVStoreKey("appdata")
+)
+
+func TestAppData(t *testing.T) {
+	app := types.AccountKey("app")
+	data := AppData{
+		Key:       "profile/theme",
+		Value:     "dark",
+		UpdatedAt: int64(1000),
+	}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.as.GetAppData(env.ctx, app, data.Key)
+		assert.Equal(t, ErrAppDataNotFound().Code(), err.Code())
+		assert.False(t, env.as.DoesAppDataExist(env.ctx, app, data.Key))
+
+		err = env.as.SetAppData(env.ctx, app, &data)
+		assert.Nil(t, err)
+		assert.True(t, env.as.DoesAppDataExist(env.ctx, app, data.Key))
+		assert.False(t, env.as.DoesAppDataExist(env.ctx, types.AccountKey("other"), data.Key))
+
+		resultPtr, err := env.as.GetAppData(env.ctx, app, data.Key)
+		assert.Nil(t, err)
+		assert.Equal(t, data, *resultPtr, "app data should be equal")
+
+		err = env.as.DeleteAppData(env.ctx, app, data.Key)
+		assert.Nil(t, err)
+		assert.False(t, env.as.DoesAppDataExist(env.ctx, app, data.Key))
+	})
+}
+
+func TestAppDataWithPrefix(t *testing.T) {
+	app := types.AccountKey("app")
+	dataList := []AppData{
+		{Key: "a/1", Value: "v1"},
+		{Key: "a/2", Value: "v2"},
+		{Key: "b/1", Value: "v3"},
+	}
+
+	runTest(t, func(env TestEnv) {
+		for _, data := range dataList {
+			d := data
+			err := env.as.SetAppData(env.ctx, app, &d)
+			assert.Nil(t, err)
+		}
+		// same key under another app should not be listed.
+		err := env.as.SetAppData(env.ctx, types.AccountKey("ap"), &AppData{Key: "pa/1"})
+		assert.Nil(t, err)
+
+		res, err := env.as.GetAppDataWithPrefix(env.ctx, app, "a/")
+		assert.Nil(t, err)
+		assert.Equal(t, dataList[:2], res)
+
+		res, err = env.as.GetAppDataWithPrefix(env.ctx, app, "")
+		assert.Nil(t, err)
+		assert.Equal(t, dataList, res)
+
+		res, err = env.as.GetAppDataWithPrefix(env.ctx, app, "c/")
+		assert.Nil(t, err)
+		assert.Equal(t, []AppData{}, res)
+	})
+}
+
+func TestStorageUsage(t *testing.T) {
+	app := types.AccountKey("app")
+	usage := StorageUsage{
+		UsedBytes: int64(100),
+		NumOfKeys: int64(2),
+	}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.as.GetStorageUsage(env.ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, StorageUsage{}, *resultPtr, "storage usage should be empty")
+
+		err = env.as.SetStorageUsage(env.ctx, app, &usage)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.as.GetStorageUsage(env.ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, usage, *resultPtr, "storage usage should be equal")
+	})
+}
+
+func TestExportImport(t *testing.T) {
+	app := types.AccountKey("app")
+	data := AppData{Key: "k/with/sep", Value: "v", UpdatedAt: 10}
+	usage := StorageUsage{UsedBytes: data.Size(), NumOfKeys: 1}
+
+	runTest(t, func(env TestEnv) {
+		assert.Nil(t, env.as.SetAppData(env.ctx, app, &data))
+		assert.Nil(t, env.as.SetStorageUsage(env.ctx, app, &usage))
+
+		tables := env.as.Export(env.ctx)
+		assert.Equal(t, &AppDataTables{
+			AppData:       []AppDataRow{{App: app, Data: data}},
+			StorageUsages: []StorageUsageRow{{App: app, Usage: usage}},
+		}, tables)
+
+		ctx := getContext()
+		ir := tables.ToIR()
+		env.as.Import(ctx, &ir)
+		resultPtr, err := env.as.GetAppData(ctx, app, data.Key)
+		assert.Nil(t, err)
+		assert.Equal(t, data, *resultPtr)
+		usagePtr, err := env.as.GetStorageUsage(ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, usage, *usagePtr)
+	})
+}
+
+//
+// Test Environment setup
+//
+
+type TestEnv struct {
+	as  AppDataStorage
+	ctx sdk.Context
+}
+
+func runTest(t *testing.T, fc func(env TestEnv)) {
+	env := TestEnv{
+		as:  NewAppDataStorage(TestKVStoreKey),
+		ctx: getContext(),
+	}
+	fc(env)
+}
+
+func getContext() sdk.Context {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(TestKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	return sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+}
@@ -0,0 +1,141 @@
+package appdata
+
+// nolint
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+var _ types.Msg = SetAppDataMsg{}
+var _ types.Msg = DeleteAppDataMsg{}
+
+// SetAppDataMsg - app writes value under key of its namespace
+type SetAppDataMsg struct {
+	App   types.AccountKey `json:"app"`
+	Key   string           `json:"key"`
+	Value string           `json:"value"`
+}
+
+// DeleteAppDataMsg - app deletes key from its namespace
+type DeleteAppDataMsg struct {
+	App types.AccountKey `json:"app"`
+	Key string           `json:"key"`
+}
+
+//----------------------------------------
+// SetAppDataMsg Msg Implementations
+// NewSetAppDataMsg - new SetAppDataMsg
+func NewSetAppDataMsg(app, key, value string) SetAppDataMsg {
+	return SetAppDataMsg{
+		App:   types.AccountKey(app),
+		Key:   key,
+		Value: value,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetAppDataMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetAppDataMsg) Type() string { return "SetAppDataMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetAppDataMsg) ValidateBasic() sdk.Error {
+	if len(msg.App) < types.MinimumUsernameLength ||
+		len(msg.App) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Key) == 0 || len(msg.Key) > types.MaxAppDataKeyLength {
+		return ErrInvalidAppDataKey()
+	}
+	if len(msg.Value) > types.MaxAppDataValueLength {
+		return ErrAppDataValueTooLong()
+	}
+	return nil
+}
+
+func (msg SetAppDataMsg) String() string {
+	return fmt.Sprintf("SetAppDataMsg{App:%v, Key:%v, Value:%v}", msg.App, msg.Key, msg.Value)
+}
+
+// GetPermission - implements types.Msg
+func (msg SetAppDataMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetAppDataMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetAppDataMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.App)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetAppDataMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// DeleteAppDataMsg Msg Implementations
+// NewDeleteAppDataMsg - new DeleteAppDataMsg
+func NewDeleteAppDataMsg(app, key string) DeleteAppDataMsg {
+	return DeleteAppDataMsg{
+		App: types.AccountKey(app),
+		Key: key,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg DeleteAppDataMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg DeleteAppDataMsg) Type() string { return "DeleteAppDataMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg DeleteAppDataMsg) ValidateBasic() sdk.Error {
+	if len(msg.App) < types.MinimumUsernameLength ||
+		len(msg.App) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Key) == 0 || len(msg.Key) > types.MaxAppDataKeyLength {
+		return ErrInvalidAppDataKey()
+	}
+	return nil
+}
+
+func (msg DeleteAppDataMsg) String() string {
+	return fmt.Sprintf("DeleteAppDataMsg{App:%v, Key:%v}", msg.App, msg.Key)
+}
+
+// GetPermission - implements types.Msg
+func (msg DeleteAppDataMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg DeleteAppDataMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg DeleteAppDataMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.App)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg DeleteAppDataMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
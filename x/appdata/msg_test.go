@@ -0,0 +1,158 @@
+package appdata
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAppDataMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		setAppDataMsg SetAppDataMsg
+		expectError   sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			setAppDataMsg: NewSetAppDataMsg("app", "config/theme", "dark"),
+			expectError:   nil,
+		},
+		{
+			testName:      "empty value",
+			setAppDataMsg: NewSetAppDataMsg("app", "config/theme", ""),
+			expectError:   nil,
+		},
+		{
+			testName:      "invalid username",
+			setAppDataMsg: NewSetAppDataMsg("", "config/theme", "dark"),
+			expectError:   ErrInvalidUsername(),
+		},
+		{
+			testName:      "empty key",
+			setAppDataMsg: NewSetAppDataMsg("app", "", "dark"),
+			expectError:   ErrInvalidAppDataKey(),
+		},
+		{
+			testName:      "key is too long",
+			setAppDataMsg: NewSetAppDataMsg("app", strings.Repeat("k", types.MaxAppDataKeyLength+1), "dark"),
+			expectError:   ErrInvalidAppDataKey(),
+		},
+		{
+			testName:      "value is too long",
+			setAppDataMsg: NewSetAppDataMsg("app", "config/theme", strings.Repeat("v", types.MaxAppDataValueLength+1)),
+			expectError:   ErrAppDataValueTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.setAppDataMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestDeleteAppDataMsg(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		deleteAppDataMsg DeleteAppDataMsg
+		expectError      sdk.Error
+	}{
+		{
+			testName:         "normal case",
+			deleteAppDataMsg: NewDeleteAppDataMsg("app", "config/theme"),
+			expectError:      nil,
+		},
+		{
+			testName:         "invalid username",
+			deleteAppDataMsg: NewDeleteAppDataMsg("", "config/theme"),
+			expectError:      ErrInvalidUsername(),
+		},
+		{
+			testName:         "empty key",
+			deleteAppDataMsg: NewDeleteAppDataMsg("app", ""),
+			expectError:      ErrInvalidAppDataKey(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.deleteAppDataMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestMsgPermission(t *testing.T) {
+	testCases := map[string]struct {
+		msg              types.Msg
+		expectPermission types.Permission
+	}{
+		"set app data msg": {
+			msg:              NewSetAppDataMsg("app", "key", "value"),
+			expectPermission: types.AppPermission,
+		},
+		"delete app data msg": {
+			msg:              NewDeleteAppDataMsg("app", "key"),
+			expectPermission: types.AppPermission,
+		},
+	}
+
+	for testName, tc := range testCases {
+		permission := tc.msg.GetPermission()
+		if tc.expectPermission != permission {
+			t.Errorf("%s: diff permission,  got %v, want %v", testName, permission, tc.expectPermission)
+			return
+		}
+	}
+}
+
+func TestGetSignBytes(t *testing.T) {
+	testCases := map[string]struct {
+		msg types.Msg
+	}{
+		"set app data msg": {
+			msg: NewSetAppDataMsg("app", "key", "value"),
+		},
+		"delete app data msg": {
+			msg: NewDeleteAppDataMsg("app", "key"),
+		},
+	}
+
+	for testName, tc := range testCases {
+		require.NotPanics(t, func() { tc.msg.GetSignBytes() }, testName)
+	}
+}
+
+func TestGetSigners(t *testing.T) {
+	testCases := map[string]struct {
+		msg           types.Msg
+		expectSigners []types.AccountKey
+	}{
+		"set app data msg": {
+			msg:           NewSetAppDataMsg("app", "key", "value"),
+			expectSigners: []types.AccountKey{"app"},
+		},
+		"delete app data msg": {
+			msg:           NewDeleteAppDataMsg("app", "key"),
+			expectSigners: []types.AccountKey{"app"},
+		},
+	}
+
+	for testName, tc := range testCases {
+		if len(tc.msg.GetSigners()) != len(tc.expectSigners) {
+			t.Errorf("%s: expect number of signers wrong, got %v, want %v", testName, len(tc.msg.GetSigners()), len(tc.expectSigners))
+			return
+		}
+		for i, signer := range tc.msg.GetSigners() {
+			if types.AccountKey(signer) != tc.expectSigners[i] {
+				t.Errorf("%s: expect signer wrong, got %v, want %v", testName, types.AccountKey(signer), tc.expectSigners[i])
+				return
+			}
+		}
+	}
+}
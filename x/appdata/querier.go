@@ -0,0 +1,91 @@
+package appdata
+
+import (
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// ModuleKey is the name of the module
+	ModuleName = "appdata"
+
+	// RouterKey is the message route for appdata
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for appdata
+	QuerierRoute = ModuleName
+
+	QueryAppData      = "data"
+	QueryAppDataList  = "dataList"
+	QueryStorageUsage = "usage"
+)
+
+// creates a querier for appdata REST endpoints
+func NewQuerier(adm AppDataManager) sdk.Querier {
+	cdc := wire.New()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryAppData:
+			return queryAppData(ctx, cdc, path[1:], req, adm)
+		case QueryAppDataList:
+			return queryAppDataList(ctx, cdc, path[1:], req, adm)
+		case QueryStorageUsage:
+			return queryStorageUsage(ctx, cdc, path[1:], req, adm)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown appdata query endpoint")
+		}
+	}
+}
+
+func queryAppData(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, adm AppDataManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	data, err := adm.GetAppData(ctx, types.AccountKey(path[0]), path[1])
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(data)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// path: app, optional key prefix
+func queryAppDataList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, adm AppDataManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	prefix := ""
+	if len(path) > 1 {
+		prefix = path[1]
+	}
+	dataList, err := adm.GetAppDataWithPrefix(ctx, types.AccountKey(path[0]), prefix)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(dataList)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryStorageUsage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, adm AppDataManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	usage, err := adm.GetStorageUsage(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(usage)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
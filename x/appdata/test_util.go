@@ -0,0 +1,41 @@
+package appdata
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	dev "github.com/lino-network/lino/x/developer"
+)
+
+var (
+	testAppDataKVStoreKey   = sdk.NewKVStoreKey("appdata")
+	testDeveloperKVStoreKey = sdk.NewKVStoreKey("developer")
+	testParamKVStoreKey     = sdk.NewKVStoreKey("param")
+)
+
+func setupTest(t *testing.T, height int64) (sdk.Context, AppDataManager, dev.DeveloperManager) {
+	ctx := getContext(height)
+	ph := param.NewParamHolder(testParamKVStoreKey)
+	ph.InitParam(ctx)
+	adm := NewAppDataManager(testAppDataKVStoreKey, ph)
+	dm := dev.NewDeveloperManager(testDeveloperKVStoreKey, ph)
+	dm.InitGenesis(ctx)
+	return ctx, adm, dm
+}
+
+func getContext(height int64) sdk.Context {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(testAppDataKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	return sdk.NewContext(ms, abci.Header{Height: height}, false, log.NewNopLogger())
+}
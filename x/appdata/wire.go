@@ -0,0 +1,17 @@
+package appdata
+
+import (
+	wire "github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterWire - register concrete types on wire codec
+func RegisterWire(cdc *wire.Codec) {
+	cdc.RegisterConcrete(SetAppDataMsg{}, "lino/setAppData", nil)
+	cdc.RegisterConcrete(DeleteAppDataMsg{}, "lino/deleteAppData", nil)
+}
+
+var msgCdc = wire.New()
+
+func init() {
+	RegisterWire(msgCdc)
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
@@ -10,6 +11,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/appdata"
+	dev "github.com/lino-network/lino/x/developer"
 	post "github.com/lino-network/lino/x/post"
 )
 
@@ -64,9 +67,28 @@ func GetMsgDonationValidAmount(ctx sdk.Context, msg types.Msg, am acc.AccountMan
 	return rst
 }
 
+// GetMsgTPSCapacityMultiplier - return how many transactions @p msg costs in TPS capacity.
+// Post chunk costs one transaction per MaxPostContentLength characters,
+// app data write costs one transaction per AppDataBytesPerTransaction bytes, others cost one.
+func GetMsgTPSCapacityMultiplier(msg types.Msg) int64 {
+	var length, unit int64
+	switch msg := msg.(type) {
+	case post.UploadPostChunkMsg:
+		length, unit = int64(utf8.RuneCountInString(msg.Chunk)), types.MaxPostContentLength
+	case appdata.SetAppDataMsg:
+		length, unit = int64(len(msg.Key)+len(msg.Value)), types.AppDataBytesPerTransaction
+	default:
+		return 1
+	}
+	if length <= unit {
+		return 1
+	}
+	return (length + unit - 1) / unit
+}
+
 // NewAnteHandler - return an AnteHandler
 func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
-	pm post.PostManager) sdk.AnteHandler {
+	pm post.PostManager, dm dev.DeveloperManager) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (_ sdk.Context, _ sdk.Result, abort bool) {
@@ -134,9 +156,17 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 					if err != nil {
 						return ctx, err.Result(), true
 					}
+					tpsCapacityRatio = tpsCapacityRatio.Mul(sdk.NewDec(GetMsgTPSCapacityMultiplier(msg)))
 					// check user tps capacity
 					if err = am.CheckUserTPSCapacity(ctx, types.AccountKey(msgSigner), tpsCapacityRatio); err != nil {
-						return ctx, err.Result(), true
+						if err.Code() != types.CodeAccountTPSCapacityNotEnough {
+							return ctx, err.Result(), true
+						}
+						// charge developer who granted fee when user capacity is not enough
+						if grantErr := dm.ConsumeFeeGrant(
+							ctx, types.AccountKey(msgSigner), tpsCapacityRatio); grantErr != nil {
+							return ctx, err.Result(), true
+						}
 					}
 				}
 				// construct sign bytes and verify sequence number.
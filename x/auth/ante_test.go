@@ -2,6 +2,7 @@ package auth
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,8 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/appdata"
+	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/global"
 	post "github.com/lino-network/lino/x/post"
 )
@@ -84,6 +87,7 @@ type AnteTestSuite struct {
 	am   acc.AccountManager
 	pm   post.PostManager
 	gm   global.GlobalManager
+	dm   dev.DeveloperManager
 	ph   param.ParamHolder
 	ctx  sdk.Context
 	ante sdk.AnteHandler
@@ -94,6 +98,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	TestPostKVStoreKey := sdk.NewKVStoreKey("post")
 	TestGlobalKVStoreKey := sdk.NewKVStoreKey("global")
 	TestParamKVStoreKey := sdk.NewKVStoreKey("param")
+	TestDeveloperKVStoreKey := sdk.NewKVStoreKey("developer")
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -101,6 +106,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	ms.MountStoreWithDB(TestPostKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(TestDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 	ctx := sdk.NewContext(
 		ms, abci.Header{ChainID: "Lino", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
@@ -110,12 +116,15 @@ func (suite *AnteTestSuite) SetupTest() {
 	am := acc.NewAccountManager(TestAccountKVStoreKey, ph)
 	pm := post.NewPostManager(TestPostKVStoreKey, ph)
 	gm := global.NewGlobalManager(TestGlobalKVStoreKey, ph)
+	dm := dev.NewDeveloperManager(TestDeveloperKVStoreKey, ph)
 	initGlobalManager(ctx, gm)
-	anteHandler := NewAnteHandler(am, gm, pm)
+	dm.InitGenesis(ctx)
+	anteHandler := NewAnteHandler(am, gm, pm, dm)
 
 	suite.am = am
 	suite.pm = pm
 	suite.gm = gm
+	suite.dm = dm
 	suite.ph = ph
 	suite.ctx = ctx
 	suite.ante = anteHandler
@@ -478,6 +487,138 @@ func (suite *AnteTestSuite) TestTPSCapacityDonationAfterUpdate4() {
 	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
 }
 
+// post chunk costs capacity in proportion to its size.
+func (suite *AnteTestSuite) TestTPSCapacityPostChunk() {
+	_, transaction1, _, user1 := suite.createTestAccount("user1")
+
+	var tx sdk.Tx
+	privs, seqs := []crypto.PrivKey{transaction1}, []uint64{0}
+	tx = newTestTx(suite.ctx, []sdk.Msg{newTestMsg(user1)}, privs, seqs)
+	suite.checkValidTx(tx)
+
+	suite.ctx = suite.ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Height: 2, Time: time.Now(), NumTxs: 1000})
+	suite.gm.SetLastBlockTime(suite.ctx, time.Now().Unix()-1)
+	suite.gm.UpdateTPS(suite.ctx)
+
+	// chunk of two max post content length costs two transactions.
+	msg := post.NewUploadPostChunkMsg(
+		"user1", "post1", 0, strings.Repeat("a", 2*types.MaxPostContentLength))
+	seqs = []uint64{1}
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
+
+	msg = post.NewUploadPostChunkMsg(
+		"user1", "post1", 0, strings.Repeat("a", types.MaxPostContentLength))
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkValidTx(tx)
+}
+
+func (suite *AnteTestSuite) TestTPSCapacityFeeGrant() {
+	_, transaction1, _, user1 := suite.createTestAccount("user1")
+	_, _, _, app := suite.createTestAccount("app")
+	devParam, _ := suite.ph.GetDeveloperParam(suite.ctx)
+	err := suite.dm.RegisterDeveloper(suite.ctx, app, devParam.DeveloperMinDeposit, "", "", "")
+	suite.Nil(err)
+
+	var tx sdk.Tx
+	msg := newTestMsg(user1)
+	privs, seqs := []crypto.PrivKey{transaction1}, []uint64{0}
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkValidTx(tx)
+
+	suite.ctx = suite.ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Height: 2, Time: time.Now(), NumTxs: 1000})
+	suite.gm.SetLastBlockTime(suite.ctx, time.Now().Unix()-1)
+	suite.gm.UpdateTPS(suite.ctx)
+
+	seqs = []uint64{1}
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkValidTx(tx)
+
+	// grant limit can't cover transaction cost
+	err = suite.dm.GrantFee(suite.ctx, app, user1, types.NewCoinFromInt64(1), 3600)
+	suite.Nil(err)
+	seqs = []uint64{2}
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
+
+	// developer pays transaction cost when user capacity is not enough
+	err = suite.dm.GrantFee(suite.ctx, app, user1, types.NewCoinFromInt64(1000*types.Decimals), 3600)
+	suite.Nil(err)
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, seqs)
+	suite.checkValidTx(tx)
+	grants, err := suite.dm.GetFeeGrants(suite.ctx, user1)
+	suite.Nil(err)
+	suite.Equal(1, len(grants))
+	suite.True(grants[0].Used.IsPositive())
+}
+
+func TestGetMsgTPSCapacityMultiplier(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		msg            types.Msg
+		expectMultiple int64
+	}{
+		{
+			testName:       "normal msg",
+			msg:            newTestMsg(types.AccountKey("user1")),
+			expectMultiple: 1,
+		},
+		{
+			testName:       "empty chunk",
+			msg:            post.NewUploadPostChunkMsg("user1", "post1", 0, ""),
+			expectMultiple: 1,
+		},
+		{
+			testName: "chunk of max post content length",
+			msg: post.NewUploadPostChunkMsg(
+				"user1", "post1", 0, strings.Repeat("好", types.MaxPostContentLength)),
+			expectMultiple: 1,
+		},
+		{
+			testName: "chunk exceeds max post content length",
+			msg: post.NewUploadPostChunkMsg(
+				"user1", "post1", 0, strings.Repeat("a", types.MaxPostContentLength+1)),
+			expectMultiple: 2,
+		},
+		{
+			testName: "chunk of max chunk length",
+			msg: post.NewUploadPostChunkMsg(
+				"user1", "post1", 0, strings.Repeat("a", types.MaxPostChunkLength)),
+			expectMultiple: types.MaxPostChunkLength / types.MaxPostContentLength,
+		},
+		{
+			testName:       "small app data",
+			msg:            appdata.NewSetAppDataMsg("app", "key", "value"),
+			expectMultiple: 1,
+		},
+		{
+			testName: "app data of bytes per transaction",
+			msg: appdata.NewSetAppDataMsg(
+				"app", "key", strings.Repeat("v", types.AppDataBytesPerTransaction-3)),
+			expectMultiple: 1,
+		},
+		{
+			testName: "app data exceeds bytes per transaction",
+			msg: appdata.NewSetAppDataMsg(
+				"app", "key", strings.Repeat("v", types.AppDataBytesPerTransaction-2)),
+			expectMultiple: 2,
+		},
+		{
+			testName: "app data of max value length",
+			msg: appdata.NewSetAppDataMsg(
+				"app", "key", strings.Repeat("v", types.MaxAppDataValueLength)),
+			expectMultiple: types.MaxAppDataValueLength/types.AppDataBytesPerTransaction + 1,
+		},
+	}
+	for _, tc := range testCases {
+		multiple := GetMsgTPSCapacityMultiplier(tc.msg)
+		if multiple != tc.expectMultiple {
+			t.Errorf("%s: diff multiple, got %v, want %v", tc.testName, multiple, tc.expectMultiple)
+		}
+	}
+}
 
 func TestAnteTestSuite(t *testing.T) {
 	suite.Run(t, &AnteTestSuite{})
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dev "github.com/lino-network/lino/x/developer"
+)
+
+// SetDeveloperAdminTxCmd - developer adds admin or replaces roles of existing admin
+func SetDeveloperAdminTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-developer-admin",
+		Short: "developer adds admin with roles (0: metadata, 1: finance, 2: grants)",
+		RunE:  sendSetDeveloperAdminTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer who owns the admin")
+	cmd.Flags().String(client.FlagAdmin, "", "admin account")
+	cmd.Flags().StringSlice(client.FlagRoles, []string{}, "comma separated roles of admin")
+	return cmd
+}
+
+// send set developer admin transaction to the blockchain
+func sendSetDeveloperAdminTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		developer := viper.GetString(client.FlagDeveloper)
+		admin := viper.GetString(client.FlagAdmin)
+		roles := []types.AdminRole{}
+		for _, str := range viper.GetStringSlice(client.FlagRoles) {
+			role, err := strconv.Atoi(str)
+			if err != nil {
+				return err
+			}
+			roles = append(roles, types.AdminRole(role))
+		}
+
+		msg := dev.NewSetDeveloperAdminMsg(developer, admin, roles)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// RemoveDeveloperAdminTxCmd - developer removes admin
+func RemoveDeveloperAdminTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-developer-admin",
+		Short: "developer removes admin",
+		RunE:  sendRemoveDeveloperAdminTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer who owns the admin")
+	cmd.Flags().String(client.FlagAdmin, "", "admin account")
+	return cmd
+}
+
+// send remove developer admin transaction to the blockchain
+func sendRemoveDeveloperAdminTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		developer := viper.GetString(client.FlagDeveloper)
+		admin := viper.GetString(client.FlagAdmin)
+
+		msg := dev.NewRemoveDeveloperAdminMsg(developer, admin)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -20,6 +21,7 @@ func DeveloperRevokeTxCmd(cdc *wire.Codec) *cobra.Command {
 		RunE:  sendDeveloperRevokeTx(cdc),
 	}
 	cmd.Flags().String(client.FlagDeveloper, "", "developer name of this transaction")
+	cmd.Flags().String(client.FlagAdmin, "", "finance admin signing for developer, omit if developer signs")
 	return cmd
 }
 
@@ -29,6 +31,7 @@ func sendDeveloperRevokeTx(cdc *wire.Codec) client.CommandTxCallback {
 		ctx := client.NewCoreContextFromViper()
 		username := viper.GetString(client.FlagDeveloper)
 		msg := developer.NewDeveloperRevokeMsg(username)
+		msg.Admin = types.AccountKey(viper.GetString(client.FlagAdmin))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
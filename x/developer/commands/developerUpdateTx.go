@@ -5,6 +5,7 @@ import (
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -23,6 +24,7 @@ func DeveloperUpdateTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagWebsite, "", "website of the app")
 	cmd.Flags().String(client.FlagDescription, "", "description of the app")
 	cmd.Flags().String(client.FlagAppMeta, "", "meta-data of the app")
+	cmd.Flags().String(client.FlagAdmin, "", "metadata admin signing for developer, omit if developer signs")
 	return cmd
 }
 
@@ -34,6 +36,7 @@ func sendDeveloperUpdateTx(cdc *wire.Codec) client.CommandTxCallback {
 		msg := developer.NewDeveloperUpdateMsg(
 			username, viper.GetString(client.FlagWebsite),
 			viper.GetString(client.FlagDescription), viper.GetString(client.FlagAppMeta))
+		msg.Admin = types.AccountKey(viper.GetString(client.FlagAdmin))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dev "github.com/lino-network/lino/x/developer"
+)
+
+// GrantFeeTxCmd - developer covers TPS capacity of user
+func GrantFeeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee",
+		Short: "developer pays transaction capacity of user when user capacity is not enough",
+		RunE:  sendGrantFeeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer who pays the capacity")
+	cmd.Flags().String(client.FlagUser, "", "user to grant")
+	cmd.Flags().Int64(client.FlagSeconds, 3600, "seconds till expire")
+	cmd.Flags().String(client.FlagGrantAmount, "", "maximum capacity the developer pays")
+	cmd.Flags().String(client.FlagAdmin, "", "grants admin signing for developer, omit if developer signs")
+	return cmd
+}
+
+// send grant fee transaction to the blockchain
+func sendGrantFeeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		developer := viper.GetString(client.FlagDeveloper)
+		username := viper.GetString(client.FlagUser)
+		seconds := viper.GetInt64(client.FlagSeconds)
+		amount := viper.GetString(client.FlagGrantAmount)
+
+		msg := dev.NewGrantFeeMsg(developer, username, amount, seconds)
+		msg.Admin = types.AccountKey(viper.GetString(client.FlagAdmin))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// RevokeFeeGrantTxCmd - developer revokes fee grant of user
+func RevokeFeeGrantTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-fee-grant",
+		Short: "developer revokes fee grant of user",
+		RunE:  sendRevokeFeeGrantTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer who granted the fee")
+	cmd.Flags().String(client.FlagUser, "", "user to revoke")
+	cmd.Flags().String(client.FlagAdmin, "", "grants admin signing for developer, omit if developer signs")
+	return cmd
+}
+
+// send revoke fee grant transaction to the blockchain
+func sendRevokeFeeGrantTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		developer := viper.GetString(client.FlagDeveloper)
+		username := viper.GetString(client.FlagUser)
+
+		msg := dev.NewRevokeFeeGrantMsg(developer, username)
+		msg.Admin = types.AccountKey(viper.GetString(client.FlagAdmin))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
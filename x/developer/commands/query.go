@@ -40,6 +40,71 @@ func GetDevelopersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetFeeGrantsCmd - returns fee grants received by user
+func GetFeeGrantsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "fee-grants",
+		Short: "Query fee grants received by user",
+		RunE:  cmdr.getFeeGrantsCmd,
+	}
+}
+
+// GetMonthlyStatementsCmd - returns archived monthly statements of developer
+func GetMonthlyStatementsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "monthly-statements",
+		Short: "Query monthly consumption statements of developer",
+		RunE:  cmdr.getMonthlyStatementsCmd,
+	}
+}
+
+// GetDeveloperAdminsCmd - returns admins of developer and their roles
+func GetDeveloperAdminsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "developer-admins",
+		Short: "Query admins of developer",
+		RunE:  cmdr.getDeveloperAdminsCmd,
+	}
+}
+
+// GetRewardPoolCmd - returns reward pool of developer
+func GetRewardPoolCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "reward-pool",
+		Short: "Query user reward pool of developer",
+		RunE:  cmdr.getRewardPoolCmd,
+	}
+}
+
+// GetPendingPayoutCmd - returns coin user gets from reward pool of developer at next payout
+func GetPendingPayoutCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "pending-payout",
+		Short: "pending-payout <developer> <username>",
+		RunE:  cmdr.getPendingPayoutCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -90,3 +155,149 @@ func (c commander) getDevelopersCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func (c commander) getFeeGrantsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetFeeGrantPrefix(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	grants := []model.FeeGrant{}
+	for _, kv := range resKVs {
+		var grant model.FeeGrant
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(kv.Value, &grant); err != nil {
+			return err
+		}
+		grants = append(grants, grant)
+	}
+
+	output, err := json.MarshalIndent(grants, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getMonthlyStatementsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a developer name")
+	}
+
+	resKVs, err := ctx.QuerySubspace(
+		c.cdc, model.GetMonthlyStatementPrefix(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	statements := []model.MonthlyStatement{}
+	for _, kv := range resKVs {
+		var statement model.MonthlyStatement
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(kv.Value, &statement); err != nil {
+			return err
+		}
+		statements = append(statements, statement)
+	}
+
+	output, err := json.MarshalIndent(statements, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getDeveloperAdminsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a developer name")
+	}
+
+	resKVs, err := ctx.QuerySubspace(
+		c.cdc, model.GetDeveloperAdminPrefix(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	admins := []model.DeveloperAdmin{}
+	for _, kv := range resKVs {
+		var admin model.DeveloperAdmin
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(kv.Value, &admin); err != nil {
+			return err
+		}
+		admins = append(admins, admin)
+	}
+
+	output, err := json.MarshalIndent(admins, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getRewardPoolCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a developer name")
+	}
+
+	res, err := ctx.Query(model.GetRewardPoolKey(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	pool := new(model.RewardPool)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, pool); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(pool, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getPendingPayoutCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide a developer name and a username")
+	}
+	developer := types.AccountKey(args[0])
+	username := types.AccountKey(args[1])
+
+	res, err := ctx.Query(model.GetRewardPoolKey(developer), c.storeName)
+	if err != nil {
+		return err
+	}
+	pool := new(model.RewardPool)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, pool); err != nil {
+		return err
+	}
+
+	payout := types.NewCoinFromInt64(0)
+	res, err = ctx.Query(model.GetUserDonationKey(developer, username), c.storeName)
+	if err != nil {
+		return err
+	}
+	// user hasn't donated through app since last payout
+	if len(res) != 0 && !pool.TotalDonation.IsZero() {
+		donation := new(model.UserDonation)
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, donation); err != nil {
+			return err
+		}
+		payout = types.DecToCoin(
+			pool.Balance.ToDec().Mul(donation.Amount.ToDec()).Quo(pool.TotalDonation.ToDec()))
+	}
+
+	output, err := json.MarshalIndent(payout, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	developer "github.com/lino-network/lino/x/developer"
+)
+
+// SetRewardPoolShareTxCmd - set share of developer inflation paid to user reward pool
+func SetRewardPoolShareTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reward-pool-share",
+		Short: "set share of developer inflation paid to users donating through app",
+		RunE:  sendSetRewardPoolShareTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer name of this transaction")
+	cmd.Flags().String(client.FlagShareRatio, "", "share of developer inflation paid to reward pool, between 0 and 1")
+	cmd.Flags().String(client.FlagAdmin, "", "finance admin signing for developer, omit if developer signs")
+	return cmd
+}
+
+// send set reward pool share transaction to the blockchain
+func sendSetRewardPoolShareTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagDeveloper)
+		msg := developer.NewSetRewardPoolShareMsg(username, viper.GetString(client.FlagShareRatio))
+		msg.Admin = types.AccountKey(viper.GetString(client.FlagAdmin))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
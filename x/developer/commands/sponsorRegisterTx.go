@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	developer "github.com/lino-network/lino/x/developer"
+)
+
+// SponsorRegisterTxCmd - developer sponsors registration of new user
+func SponsorRegisterTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-register",
+		Short: "developer sponsors registration of new user from developer deposit",
+		RunE:  sendSponsorRegisterTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer who sponsors the registration")
+	cmd.Flags().String(client.FlagUser, "", "register user")
+	cmd.Flags().String(client.FlagAmount, "", "amount to register new user")
+	return cmd
+}
+
+// send sponsor register transaction to the blockchain
+func sendSponsorRegisterTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		app := viper.GetString(client.FlagDeveloper)
+		name := viper.GetString(client.FlagUser)
+		amount := viper.GetString(client.FlagAmount)
+
+		resetPriv := secp256k1.GenPrivKey()
+		transactionPriv := secp256k1.GenPrivKey()
+		appPriv := secp256k1.GenPrivKey()
+
+		fmt.Println("reset private key is:", strings.ToUpper(hex.EncodeToString(resetPriv.Bytes())))
+		fmt.Println("transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+		fmt.Println("app private key is:", strings.ToUpper(hex.EncodeToString(appPriv.Bytes())))
+
+		msg := developer.NewSponsorRegisterMsg(
+			app, name, types.LNO(amount),
+			resetPriv.PubKey(), transactionPriv.PubKey(), appPriv.PubKey())
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -71,3 +71,48 @@ func ErrInvalidGrantPermission() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeDeveloperQueryFailed, fmt.Sprintf("query developer store failed"))
 }
+
+// ErrSponsorshipLimitExceeded - error if developer sponsored too many registrations today
+func ErrSponsorshipLimitExceeded(developer types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeSponsorshipLimitExceeded, fmt.Sprintf("developer %v exceeds daily sponsorship limit", developer))
+}
+
+// ErrInsufficientSponsorDeposit - error if developer deposit can't cover sponsored registration
+func ErrInsufficientSponsorDeposit() sdk.Error {
+	return types.NewError(types.CodeInsufficientSponsorDeposit, fmt.Sprintf("developer deposit is insufficient for sponsorship"))
+}
+
+// ErrInvalidFeeGrantLimit - error if fee grant limit is not positive
+func ErrInvalidFeeGrantLimit() sdk.Error {
+	return types.NewError(types.CodeInvalidFeeGrantLimit, fmt.Sprintf("fee grant limit must be positive"))
+}
+
+// ErrFeeGrantNotEnough - error if no fee grant can cover transaction cost of user
+func ErrFeeGrantNotEnough(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeFeeGrantNotEnough, fmt.Sprintf("fee grants of %v can't cover transaction cost", username))
+}
+
+// ErrDeveloperInPunishCooldown - error if punished developer registers again before cooldown ends
+func ErrDeveloperInPunishCooldown(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeDeveloperInPunishCooldown, fmt.Sprintf("%v can't register as developer until punish cooldown ends", username))
+}
+
+// ErrDeveloperUnderPunishment - error if developer revokes while punish proposal against it is undecided
+func ErrDeveloperUnderPunishment(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeDeveloperUnderPunishment, fmt.Sprintf("%v can't revoke until punish proposal is decided", username))
+}
+
+// ErrInvalidAdminRole - error if developer admin roles are empty, unknown or duplicated
+func ErrInvalidAdminRole() sdk.Error {
+	return types.NewError(types.CodeInvalidAdminRole, fmt.Sprintf("invalid developer admin role"))
+}
+
+// ErrAdminNotAuthorized - error if signer is not admin of developer in required role
+func ErrAdminNotAuthorized(developer, signer types.AccountKey, role types.AdminRole) sdk.Error {
+	return types.NewError(types.CodeAdminNotAuthorized, fmt.Sprintf("%v is not authorized to act for developer %v in role %v", signer, developer, role))
+}
+
+// ErrInvalidRewardPoolShare - error if reward pool share is not a ratio between 0 and 1
+func ErrInvalidRewardPoolShare() sdk.Error {
+	return types.NewError(types.CodeInvalidRewardPoolShare, fmt.Sprintf("invalid reward pool share"))
+}
@@ -27,6 +27,18 @@ func NewHandler(dm DeveloperManager, am acc.AccountManager, gm *global.GlobalMan
 			return handleDeveloperRevokeMsg(ctx, dm, am, gm, msg)
 		case RevokePermissionMsg:
 			return handleRevokePermissionMsg(ctx, dm, am, msg)
+		case SponsorRegisterMsg:
+			return handleSponsorRegisterMsg(ctx, dm, am, gm, msg)
+		case GrantFeeMsg:
+			return handleGrantFeeMsg(ctx, dm, am, msg)
+		case RevokeFeeGrantMsg:
+			return handleRevokeFeeGrantMsg(ctx, dm, msg)
+		case SetDeveloperAdminMsg:
+			return handleSetDeveloperAdminMsg(ctx, dm, am, msg)
+		case RemoveDeveloperAdminMsg:
+			return handleRemoveDeveloperAdminMsg(ctx, dm, msg)
+		case SetRewardPoolShareMsg:
+			return handleSetRewardPoolShareMsg(ctx, dm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized developer msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -44,6 +56,10 @@ func handleDeveloperRegisterMsg(
 		return ErrDeveloperAlreadyExist(msg.Username).Result()
 	}
 
+	if dm.IsInPunishCooldown(ctx, msg.Username) {
+		return ErrDeveloperInPunishCooldown(msg.Username).Result()
+	}
+
 	deposit, err := types.LinoToCoin(msg.Deposit)
 	if err != nil {
 		return err.Result()
@@ -71,6 +87,10 @@ func handleDeveloperUpdateMsg(
 		return ErrDeveloperNotFound().Result()
 	}
 
+	if err := checkAdmin(ctx, dm, msg.Username, msg.Admin, types.MetadataAdmin); err != nil {
+		return err.Result()
+	}
+
 	if err := dm.UpdateDeveloper(
 		ctx, msg.Username, msg.Website, msg.Description, msg.AppMetaData); err != nil {
 		return err.Result()
@@ -85,25 +105,57 @@ func handleDeveloperRevokeMsg(
 		return ErrDeveloperNotFound().Result()
 	}
 
-	if err := dm.RemoveFromDeveloperList(ctx, msg.Username); err != nil {
+	if err := checkAdmin(ctx, dm, msg.Username, msg.Admin, types.FinanceAdmin); err != nil {
 		return err.Result()
 	}
 
-	coin, withdrawErr := dm.WithdrawAll(ctx, msg.Username)
-	if withdrawErr != nil {
-		return withdrawErr.Result()
+	// deposit must stay until punish proposal against developer is decided
+	if dm.IsUnderPunishment(ctx, msg.Username) {
+		return ErrDeveloperUnderPunishment(msg.Username).Result()
 	}
 
-	param, err := dm.paramHolder.GetDeveloperParam(ctx)
-	if err != nil {
+	if err := RevokeDeveloper(ctx, dm, am, gm, msg.Username); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	if err := returnCoinTo(
-		ctx, msg.Username, gm, am, param.DeveloperCoinReturnTimes, param.DeveloperCoinReturnIntervalSec, coin); err != nil {
-		return err.Result()
+// RevokeDeveloper - remove developer from developer list and return remaining deposit and
+// reward pool by coin return events, shared by revoke msg and passed developer punish proposal.
+// Punish proposal empties reward pool before revoke so it is never returned to punished developer.
+func RevokeDeveloper(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager,
+	gm *global.GlobalManager, username types.AccountKey) sdk.Error {
+	if err := dm.RemoveFromDeveloperList(ctx, username); err != nil {
+		return err
 	}
-	return sdk.Result{}
+	// admins must not carry over if account registers as developer again
+	if err := dm.ClearDeveloperAdmins(ctx, username); err != nil {
+		return err
+	}
+
+	coin, err := dm.WithdrawAll(ctx, username)
+	if err != nil {
+		return err
+	}
+	// undistributed reward pool goes back to developer with deposit
+	poolBalance, err := dm.WithdrawRewardPool(ctx, username)
+	if err != nil {
+		return err
+	}
+	coin = coin.Plus(poolBalance)
+	// deposit may have been slashed entirely
+	if coin.IsZero() {
+		return nil
+	}
+
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	return returnCoinTo(
+		ctx, username, gm, am, param.DeveloperCoinReturnTimes, param.DeveloperCoinReturnIntervalSec, coin)
 }
 
 func handleGrantPermissionMsg(
@@ -182,6 +234,134 @@ func handlePreAuthorizationMsg(
 	return sdk.Result{}
 }
 
+func handleSponsorRegisterMsg(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager,
+	gm *global.GlobalManager, msg SponsorRegisterMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+	if am.DoesAccountExist(ctx, msg.NewUser) {
+		return acc.ErrAccountAlreadyExists(msg.NewUser).Result()
+	}
+	coin, err := types.LinoToCoin(msg.RegisterFee)
+	if err != nil {
+		return err.Result()
+	}
+	accParams, err := dm.paramHolder.GetAccountParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if accParams.RegisterFee.IsGT(coin) {
+		return acc.ErrRegisterFeeInsufficient().Result()
+	}
+
+	// register fee and initial deposit are paid from developer deposit
+	if err := dm.SponsorRegistration(ctx, msg.Developer, coin); err != nil {
+		return err.Result()
+	}
+	// the open account fee will be added to developer inflation pool
+	if err := gm.AddToDeveloperInflationPool(ctx, accParams.RegisterFee); err != nil {
+		return err.Result()
+	}
+
+	if err := am.CreateAccount(
+		ctx, msg.Developer, msg.NewUser, msg.NewResetPubKey, msg.NewTransactionPubKey,
+		msg.NewAppPubKey, coin.Minus(accParams.RegisterFee)); err != nil {
+		return err.Result()
+	}
+	// later activity of new user is attributed to sponsoring app
+	if err := am.SetSponsor(ctx, msg.NewUser, msg.Developer); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleGrantFeeMsg(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager, msg GrantFeeMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+	if err := checkAdmin(ctx, dm, msg.Developer, msg.Admin, types.GrantsAdmin); err != nil {
+		return err.Result()
+	}
+
+	limit, err := types.LinoToCoin(msg.Limit)
+	if err != nil {
+		return err.Result()
+	}
+	if err := dm.GrantFee(ctx, msg.Developer, msg.Username, limit, msg.ValidityPeriodSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleRevokeFeeGrantMsg(
+	ctx sdk.Context, dm DeveloperManager, msg RevokeFeeGrantMsg) sdk.Result {
+	if err := checkAdmin(ctx, dm, msg.Developer, msg.Admin, types.GrantsAdmin); err != nil {
+		return err.Result()
+	}
+	if err := dm.RevokeFeeGrant(ctx, msg.Developer, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleSetDeveloperAdminMsg(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager, msg SetDeveloperAdminMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Admin) {
+		return ErrAccountNotFound().Result()
+	}
+	if err := dm.SetDeveloperAdmin(ctx, msg.Developer, msg.Admin, msg.Roles); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleSetRewardPoolShareMsg(
+	ctx sdk.Context, dm DeveloperManager, msg SetRewardPoolShareMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+	if err := checkAdmin(ctx, dm, msg.Developer, msg.Admin, types.FinanceAdmin); err != nil {
+		return err.Result()
+	}
+	share, err := sdk.NewDecFromStr(msg.ShareRatio)
+	if err != nil {
+		return ErrInvalidRewardPoolShare().Result()
+	}
+	if err := dm.SetRewardPoolShare(ctx, msg.Developer, share); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleRemoveDeveloperAdminMsg(
+	ctx sdk.Context, dm DeveloperManager, msg RemoveDeveloperAdminMsg) sdk.Result {
+	if err := dm.RemoveDeveloperAdmin(ctx, msg.Developer, msg.Admin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// checkAdmin - msg signed by admin must come from an admin of developer in role,
+// empty admin means developer signs by itself
+func checkAdmin(
+	ctx sdk.Context, dm DeveloperManager, developer, admin types.AccountKey, role types.AdminRole) sdk.Error {
+	if len(admin) == 0 {
+		return nil
+	}
+	if !dm.IsAuthorizedAdmin(ctx, developer, admin, role) {
+		return ErrAdminNotAuthorized(developer, admin, role)
+	}
+	return nil
+}
+
 func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager,
 	am acc.AccountManager, times int64, interval int64, coin types.Coin) sdk.Error {
@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
 	accstore "github.com/lino-network/lino/x/account/model"
+	"github.com/lino-network/lino/x/developer/model"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func TestRegistertBasic(t *testing.T) {
@@ -349,3 +352,345 @@ func TestRevokePermissionMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestSponsorRegisterMsg(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+
+	handler := NewHandler(dm, am, &gm)
+	dm.InitGenesis(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance)
+	createTestAccount(ctx, am, "app", minBalance)
+	err = dm.RegisterDeveloper(
+		ctx, "app", param.DeveloperMinDeposit.Plus(types.NewCoinFromInt64(3*types.Decimals)), "", "", "")
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		msg          SponsorRegisterMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName: "normal sponsored registration",
+			msg: NewSponsorRegisterMsg(
+				"app", "newuser1", "2", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName: "sponsor is not developer",
+			msg: NewSponsorRegisterMsg(
+				"user1", "newuser2", "1", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: ErrDeveloperNotFound().Result(),
+		},
+		{
+			testName: "new user already exists",
+			msg: NewSponsorRegisterMsg(
+				"app", "newuser1", "1", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: acc.ErrAccountAlreadyExists("newuser1").Result(),
+		},
+		{
+			testName: "register fee insufficient",
+			msg: NewSponsorRegisterMsg(
+				"app", "newuser2", "0.1", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: acc.ErrRegisterFeeInsufficient().Result(),
+		},
+		{
+			testName: "remaining deposit is less than minimum deposit",
+			msg: NewSponsorRegisterMsg(
+				"app", "newuser2", "2", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: ErrInsufficientSponsorDeposit().Result(),
+		},
+		{
+			testName: "sponsor with remaining deposit",
+			msg: NewSponsorRegisterMsg(
+				"app", "newuser2", "1", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+			expectResult: sdk.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// check sponsoring app is recorded and paid from developer deposit
+	for _, user := range []types.AccountKey{"newuser1", "newuser2"} {
+		sponsor, err := am.GetSponsor(ctx, user)
+		assert.Nil(t, err)
+		assert.Equal(t, types.AccountKey("app"), sponsor)
+	}
+	saving, _ := am.GetSavingFromBank(ctx, "newuser1")
+	assert.True(t, saving.IsEqual(minBalance))
+	developer, err := dm.storage.GetDeveloper(ctx, "app")
+	assert.Nil(t, err)
+	assert.True(t, developer.Deposit.IsEqual(param.DeveloperMinDeposit))
+	assert.Equal(t, int64(2), developer.SponsoredCount)
+}
+
+func TestFeeGrantMsg(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+
+	handler := NewHandler(dm, am, &gm)
+	dm.InitGenesis(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance)
+	createTestAccount(ctx, am, "app", minBalance)
+	err = dm.RegisterDeveloper(ctx, "app", param.DeveloperMinDeposit, "", "", "")
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+		expectGrants int
+	}{
+		{
+			testName:     "grant fee from non-exist developer",
+			msg:          NewGrantFeeMsg("user1", "app", "1", 1000),
+			expectResult: ErrDeveloperNotFound().Result(),
+			expectGrants: 0,
+		},
+		{
+			testName:     "grant fee to non-exist user",
+			msg:          NewGrantFeeMsg("app", "invalid", "1", 1000),
+			expectResult: ErrAccountNotFound().Result(),
+			expectGrants: 0,
+		},
+		{
+			testName:     "normal fee grant",
+			msg:          NewGrantFeeMsg("app", "user1", "1", 1000),
+			expectResult: sdk.Result{},
+			expectGrants: 1,
+		},
+		{
+			testName:     "grant again replaces previous grant",
+			msg:          NewGrantFeeMsg("app", "user1", "2", 1000),
+			expectResult: sdk.Result{},
+			expectGrants: 1,
+		},
+		{
+			testName:     "normal revoke fee grant",
+			msg:          NewRevokeFeeGrantMsg("app", "user1"),
+			expectResult: sdk.Result{},
+			expectGrants: 0,
+		},
+		{
+			testName:     "revoke non-exist fee grant",
+			msg:          NewRevokeFeeGrantMsg("app", "user1"),
+			expectResult: model.ErrFeeGrantNotFound().Result(),
+			expectGrants: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		grants, err := dm.GetFeeGrants(ctx, "user1")
+		if err != nil {
+			t.Errorf("%s: failed to get fee grants, got err %v", tc.testName, err)
+		}
+		if len(grants) != tc.expectGrants {
+			t.Errorf("%s: diff number of fee grants, got %v, want %v", tc.testName, len(grants), tc.expectGrants)
+		}
+	}
+}
+
+func TestDeveloperAdminMsg(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+
+	handler := NewHandler(dm, am, &gm)
+	dm.InitGenesis(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance)
+	createTestAccount(ctx, am, "app", minBalance)
+	createTestAccount(ctx, am, "operator", minBalance)
+	createTestAccount(ctx, am, "accountant", minBalance)
+	err = dm.RegisterDeveloper(ctx, "app", param.DeveloperMinDeposit, "", "", "")
+	assert.Nil(t, err)
+
+	updateByOperator := NewDeveloperUpdateMsg("app", "https://lino.network", "", "")
+	updateByOperator.Admin = "operator"
+	grantByOperator := NewGrantFeeMsg("app", "user1", "1", 1000)
+	grantByOperator.Admin = "operator"
+	revokeGrantByAccountant := NewRevokeFeeGrantMsg("app", "user1")
+	revokeGrantByAccountant.Admin = "accountant"
+	revokeByOperator := NewDeveloperRevokeMsg("app")
+	revokeByOperator.Admin = "operator"
+	revokeByAccountant := NewDeveloperRevokeMsg("app")
+	revokeByAccountant.Admin = "accountant"
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "update by non-admin",
+			msg:          updateByOperator,
+			expectResult: ErrAdminNotAuthorized("app", "operator", types.MetadataAdmin).Result(),
+		},
+		{
+			testName:     "set admin of non-exist developer",
+			msg:          NewSetDeveloperAdminMsg("user1", "operator", []types.AdminRole{types.MetadataAdmin}),
+			expectResult: ErrDeveloperNotFound().Result(),
+		},
+		{
+			testName:     "set non-exist account as admin",
+			msg:          NewSetDeveloperAdminMsg("app", "invalid", []types.AdminRole{types.MetadataAdmin}),
+			expectResult: ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "set metadata and grants admin",
+			msg: NewSetDeveloperAdminMsg(
+				"app", "operator", []types.AdminRole{types.MetadataAdmin, types.GrantsAdmin}),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "set finance admin",
+			msg:          NewSetDeveloperAdminMsg("app", "accountant", []types.AdminRole{types.FinanceAdmin}),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "update by metadata admin",
+			msg:          updateByOperator,
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "grant fee by grants admin",
+			msg:          grantByOperator,
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "revoke fee grant by finance admin",
+			msg:          revokeGrantByAccountant,
+			expectResult: ErrAdminNotAuthorized("app", "accountant", types.GrantsAdmin).Result(),
+		},
+		{
+			testName:     "revoke developer by metadata admin",
+			msg:          revokeByOperator,
+			expectResult: ErrAdminNotAuthorized("app", "operator", types.FinanceAdmin).Result(),
+		},
+		{
+			testName:     "remove non-exist admin",
+			msg:          NewRemoveDeveloperAdminMsg("app", "user1"),
+			expectResult: model.ErrDeveloperAdminNotFound().Result(),
+		},
+		{
+			testName:     "revoke developer by finance admin",
+			msg:          revokeByAccountant,
+			expectResult: sdk.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// deposit returns to developer, not admin
+	appSaving, _ := am.GetSavingFromBank(ctx, "app")
+	assert.True(t, appSaving.IsEqual(minBalance))
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, "app")
+	assert.Equal(t, 1, len(frozenMoneyList))
+	accountantSaving, _ := am.GetSavingFromBank(ctx, "accountant")
+	assert.True(t, accountantSaving.IsEqual(minBalance))
+
+	// admins are cleared with developer
+	admins, err := dm.GetDeveloperAdmins(ctx, "app")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(admins))
+}
+
+func TestSetRewardPoolShareMsg(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+
+	handler := NewHandler(dm, am, &gm)
+	dm.InitGenesis(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "app", minBalance)
+	createTestAccount(ctx, am, "operator", minBalance)
+	createTestAccount(ctx, am, "accountant", minBalance)
+	err = dm.RegisterDeveloper(ctx, "app", param.DeveloperMinDeposit, "", "", "")
+	assert.Nil(t, err)
+	err = dm.SetDeveloperAdmin(ctx, "app", "operator", []types.AdminRole{types.MetadataAdmin})
+	assert.Nil(t, err)
+	err = dm.SetDeveloperAdmin(ctx, "app", "accountant", []types.AdminRole{types.FinanceAdmin})
+	assert.Nil(t, err)
+
+	setByOperator := NewSetRewardPoolShareMsg("app", "0.5")
+	setByOperator.Admin = "operator"
+	setByAccountant := NewSetRewardPoolShareMsg("app", "0.5")
+	setByAccountant.Admin = "accountant"
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "set share of non-exist developer",
+			msg:          NewSetRewardPoolShareMsg("operator", "0.1"),
+			expectResult: ErrDeveloperNotFound().Result(),
+		},
+		{
+			testName:     "set share by developer",
+			msg:          NewSetRewardPoolShareMsg("app", "0.1"),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "set share by metadata admin",
+			msg:          setByOperator,
+			expectResult: ErrAdminNotAuthorized("app", "operator", types.FinanceAdmin).Result(),
+		},
+		{
+			testName:     "set share by finance admin",
+			msg:          setByAccountant,
+			expectResult: sdk.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+	pool, err := dm.GetRewardPool(ctx, "app")
+	assert.Nil(t, err)
+	assert.True(t, pool.ShareRatio.Equal(types.NewDecFromRat(1, 2)))
+
+	// undistributed reward pool returns to developer with deposit
+	funded, err := dm.FundRewardPool(ctx, "app", types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	result := handler(ctx, NewDeveloperRevokeMsg("app"))
+	assert.Equal(t, sdk.Result{}, result)
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, "app")
+	assert.Equal(t, 1, len(frozenMoneyList))
+	assert.True(t, frozenMoneyList[0].Amount.IsEqual(param.DeveloperMinDeposit.Plus(funded)))
+	_, err = dm.GetRewardPool(ctx, "app")
+	assert.Equal(t, types.CodeRewardPoolNotFound, err.Code())
+}
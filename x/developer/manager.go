@@ -124,6 +124,133 @@ func (dm DeveloperManager) ReportConsumption(
 	return nil
 }
 
+// SponsorRegistration - pay sponsored registration from developer deposit, developer can't
+// sponsor more than daily cap and remaining deposit must meet minimum deposit requirement
+func (dm DeveloperManager) SponsorRegistration(
+	ctx sdk.Context, username types.AccountKey, cost types.Coin) sdk.Error {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	if now-developer.SponsorPeriodStart >= types.SponsorshipIntervalSec {
+		developer.SponsorPeriodStart = now
+		developer.SponsoredCount = 0
+	}
+	if developer.SponsoredCount >= param.DeveloperMaxDailySponsorship {
+		return ErrSponsorshipLimitExceeded(username)
+	}
+	if !developer.Deposit.Minus(cost).IsGTE(param.DeveloperMinDeposit) {
+		return ErrInsufficientSponsorDeposit()
+	}
+
+	developer.Deposit = developer.Deposit.Minus(cost)
+	developer.SponsoredCount++
+	if err := dm.storage.SetDeveloper(ctx, username, developer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GrantFee - developer covers user's TPS capacity up to limit, replaces previous grant
+func (dm DeveloperManager) GrantFee(
+	ctx sdk.Context, developer, username types.AccountKey,
+	limit types.Coin, validityPeriodSec int64) sdk.Error {
+	now := ctx.BlockHeader().Time.Unix()
+	grant := &model.FeeGrant{
+		Developer: developer,
+		Limit:     limit,
+		Used:      types.NewCoinFromInt64(0),
+		CreatedAt: now,
+		ExpiresAt: now + validityPeriodSec,
+	}
+	return dm.storage.SetFeeGrant(ctx, username, grant)
+}
+
+// RevokeFeeGrant - revoke fee grant from developer to user
+func (dm DeveloperManager) RevokeFeeGrant(
+	ctx sdk.Context, developer, username types.AccountKey) sdk.Error {
+	if _, err := dm.storage.GetFeeGrant(ctx, username, developer); err != nil {
+		return err
+	}
+	return dm.storage.DeleteFeeGrant(ctx, username, developer)
+}
+
+// GetFeeGrants - get all fee grants received by user
+func (dm DeveloperManager) GetFeeGrants(
+	ctx sdk.Context, username types.AccountKey) ([]model.FeeGrant, sdk.Error) {
+	return dm.storage.GetFeeGrants(ctx, username)
+}
+
+// ConsumeFeeGrant - charge transaction cost of user to capacity of a developer
+// which granted fee to user, grant must not expire or exceed its limit
+func (dm DeveloperManager) ConsumeFeeGrant(
+	ctx sdk.Context, username types.AccountKey, tpsCapacityRatio sdk.Dec) sdk.Error {
+	bandwidthParams, err := dm.paramHolder.GetBandwidthParam(ctx)
+	if err != nil {
+		return err
+	}
+	grants, err := dm.storage.GetFeeGrants(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	txCost := types.DecToCoin(
+		bandwidthParams.CapacityUsagePerTransaction.ToDec().Mul(tpsCapacityRatio))
+	for _, grant := range grants {
+		if grant.ExpiresAt <= now || txCost.Plus(grant.Used).IsGT(grant.Limit) {
+			continue
+		}
+		developer, err := dm.storage.GetDeveloper(ctx, grant.Developer)
+		if err != nil {
+			continue
+		}
+		if !consumeDeveloperCapacity(developer, txCost, now, bandwidthParams) {
+			continue
+		}
+		if err := dm.storage.SetDeveloper(ctx, grant.Developer, developer); err != nil {
+			return err
+		}
+		grant.Used = grant.Used.Plus(txCost)
+		if err := dm.storage.SetFeeGrant(ctx, username, &grant); err != nil {
+			return err
+		}
+		return nil
+	}
+	return ErrFeeGrantNotEnough(username)
+}
+
+// consumeDeveloperCapacity - developer capacity recovers towards its deposit as user
+// capacity recovers towards coin day, return false if capacity can't cover the cost
+func consumeDeveloperCapacity(
+	developer *model.Developer, txCost types.Coin, now int64,
+	bandwidthParams *param.BandwidthParam) bool {
+	if developer.TransactionCapacity.IsGTE(developer.Deposit) {
+		developer.TransactionCapacity = developer.Deposit
+	} else {
+		incrementRatio := types.NewDecFromRat(
+			now-developer.LastActivityAt, bandwidthParams.SecondsToRecoverBandwidth)
+		if incrementRatio.GT(sdk.OneDec()) {
+			incrementRatio = sdk.OneDec()
+		}
+		capacityTillDeposit := developer.Deposit.Minus(developer.TransactionCapacity)
+		developer.TransactionCapacity = developer.TransactionCapacity.Plus(
+			types.DecToCoin(capacityTillDeposit.ToDec().Mul(incrementRatio)))
+	}
+	if txCost.IsGT(developer.TransactionCapacity) {
+		return false
+	}
+	developer.TransactionCapacity = developer.TransactionCapacity.Minus(txCost)
+	developer.LastActivityAt = now
+	return true
+}
+
 // GetConsumptionWeight - given app name, get consumption percentage report by this app
 func (dm DeveloperManager) GetConsumptionWeight(
 	ctx sdk.Context, username types.AccountKey) (sdk.Dec, sdk.Error) {
@@ -152,6 +279,292 @@ func (dm DeveloperManager) GetConsumptionWeight(
 	return myConsumption.ToDec().Quo(totalConsumption.ToDec()), nil
 }
 
+// ArchiveMonthlyStatement - archive consumption of this month with its consumption weight
+// and inflation paid, must be called before consumption is cleared
+func (dm DeveloperManager) ArchiveMonthlyStatement(
+	ctx sdk.Context, username types.AccountKey, weight sdk.Dec, inflation types.Coin) sdk.Error {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	statement := &model.MonthlyStatement{
+		SettledAt:         ctx.BlockHeader().Time.Unix(),
+		Consumption:       developer.AppConsumption,
+		ConsumptionWeight: weight,
+		Inflation:         inflation,
+	}
+	return dm.storage.SetMonthlyStatement(ctx, username, statement)
+}
+
+// GetMonthlyStatements - get all monthly statements of developer
+func (dm DeveloperManager) GetMonthlyStatements(
+	ctx sdk.Context, username types.AccountKey) ([]model.MonthlyStatement, sdk.Error) {
+	return dm.storage.GetMonthlyStatements(ctx, username)
+}
+
+// SlashDeposit - slash given ratio of developer deposit, return slashed coin
+func (dm DeveloperManager) SlashDeposit(
+	ctx sdk.Context, username types.AccountKey, ratio sdk.Dec) (types.Coin, sdk.Error) {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	slashed := types.DecToCoin(developer.Deposit.ToDec().Mul(ratio))
+	developer.Deposit = developer.Deposit.Minus(slashed)
+	if err := dm.storage.SetDeveloper(ctx, username, developer); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return slashed, nil
+}
+
+// StartPunishCooldown - punished account can't register as developer until cooldown ends
+func (dm DeveloperManager) StartPunishCooldown(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	param, err := dm.paramHolder.GetDeveloperParam(ctx)
+	if err != nil {
+		return err
+	}
+	return dm.storage.SetPunishCooldown(
+		ctx, username, ctx.BlockHeader().Time.Unix()+param.DeveloperPunishCooldownSec)
+}
+
+// IsInPunishCooldown - check if account is still in punish cooldown
+func (dm DeveloperManager) IsInPunishCooldown(ctx sdk.Context, username types.AccountKey) bool {
+	until, err := dm.storage.GetPunishCooldown(ctx, username)
+	if err != nil {
+		return false
+	}
+	return ctx.BlockHeader().Time.Unix() < until
+}
+
+// MarkPendingPunish - developer is under punishment until punish proposal is decided
+func (dm DeveloperManager) MarkPendingPunish(
+	ctx sdk.Context, username types.AccountKey, proposalID types.ProposalKey) {
+	dm.storage.SetPendingPunish(ctx, username, proposalID)
+}
+
+// ReleasePendingPunish - remove decided or cancelled punish proposal from developer
+func (dm DeveloperManager) ReleasePendingPunish(
+	ctx sdk.Context, username types.AccountKey, proposalID types.ProposalKey) {
+	dm.storage.DeletePendingPunish(ctx, username, proposalID)
+}
+
+// IsUnderPunishment - check if any punish proposal against developer is undecided
+func (dm DeveloperManager) IsUnderPunishment(ctx sdk.Context, username types.AccountKey) bool {
+	return len(dm.storage.GetPendingPunishes(ctx, username)) != 0
+}
+
+// GetStorageQuota - app data bytes developer can store, tied to deposit
+func (dm DeveloperManager) GetStorageQuota(ctx sdk.Context, username types.AccountKey) (int64, sdk.Error) {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	deposit, err := developer.Deposit.ToInt64()
+	if err != nil {
+		return 0, err
+	}
+	return deposit / types.AppDataCoinPerByte, nil
+}
+
+// SetDeveloperAdmin - add admin to developer or replace roles of existing admin
+func (dm DeveloperManager) SetDeveloperAdmin(
+	ctx sdk.Context, developer, admin types.AccountKey, roles []types.AdminRole) sdk.Error {
+	developerAdmin, err := dm.storage.GetDeveloperAdmin(ctx, developer, admin)
+	if err != nil {
+		developerAdmin = &model.DeveloperAdmin{
+			Admin:     admin,
+			CreatedAt: ctx.BlockHeader().Time.Unix(),
+		}
+	}
+	developerAdmin.Roles = roles
+	return dm.storage.SetDeveloperAdmin(ctx, developer, developerAdmin)
+}
+
+// RemoveDeveloperAdmin - remove admin from developer
+func (dm DeveloperManager) RemoveDeveloperAdmin(
+	ctx sdk.Context, developer, admin types.AccountKey) sdk.Error {
+	if _, err := dm.storage.GetDeveloperAdmin(ctx, developer, admin); err != nil {
+		return err
+	}
+	return dm.storage.DeleteDeveloperAdmin(ctx, developer, admin)
+}
+
+// ClearDeveloperAdmins - remove all admins of developer
+func (dm DeveloperManager) ClearDeveloperAdmins(ctx sdk.Context, developer types.AccountKey) sdk.Error {
+	admins, err := dm.storage.GetDeveloperAdmins(ctx, developer)
+	if err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		if err := dm.storage.DeleteDeveloperAdmin(ctx, developer, admin.Admin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDeveloperAdmins - get all admins of developer
+func (dm DeveloperManager) GetDeveloperAdmins(
+	ctx sdk.Context, developer types.AccountKey) ([]model.DeveloperAdmin, sdk.Error) {
+	return dm.storage.GetDeveloperAdmins(ctx, developer)
+}
+
+// IsAuthorizedAdmin - check if signer can act for developer in role,
+// developer itself is authorized in all roles
+func (dm DeveloperManager) IsAuthorizedAdmin(
+	ctx sdk.Context, developer, signer types.AccountKey, role types.AdminRole) bool {
+	if signer == developer {
+		return true
+	}
+	developerAdmin, err := dm.storage.GetDeveloperAdmin(ctx, developer, signer)
+	if err != nil {
+		return false
+	}
+	return developerAdmin.HasRole(role)
+}
+
+// SetRewardPoolShare - set share of developer inflation paid into user reward pool,
+// reward pool is created if developer doesn't have one
+func (dm DeveloperManager) SetRewardPoolShare(
+	ctx sdk.Context, developer types.AccountKey, share sdk.Dec) sdk.Error {
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		pool = &model.RewardPool{
+			Balance:       types.NewCoinFromInt64(0),
+			TotalDonation: types.NewCoinFromInt64(0),
+		}
+	}
+	pool.ShareRatio = share
+	return dm.storage.SetRewardPool(ctx, developer, pool)
+}
+
+// GetRewardPool - get reward pool of developer
+func (dm DeveloperManager) GetRewardPool(
+	ctx sdk.Context, developer types.AccountKey) (*model.RewardPool, sdk.Error) {
+	return dm.storage.GetRewardPool(ctx, developer)
+}
+
+// RecordUserDonation - record donation made by user through developer app,
+// donation is ignored if developer doesn't have reward pool
+func (dm DeveloperManager) RecordUserDonation(
+	ctx sdk.Context, developer, username types.AccountKey, amount types.Coin) sdk.Error {
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		return nil
+	}
+	donation, err := dm.storage.GetUserDonation(ctx, developer, username)
+	if err != nil {
+		return err
+	}
+	donation.Amount = donation.Amount.Plus(amount)
+	if err := dm.storage.SetUserDonation(ctx, developer, donation); err != nil {
+		return err
+	}
+	pool.TotalDonation = pool.TotalDonation.Plus(amount)
+	return dm.storage.SetRewardPool(ctx, developer, pool)
+}
+
+// GetPendingPayout - coin user gets from reward pool at next payout if no more donations are made
+func (dm DeveloperManager) GetPendingPayout(
+	ctx sdk.Context, developer, username types.AccountKey) (types.Coin, sdk.Error) {
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if pool.TotalDonation.IsZero() {
+		return types.NewCoinFromInt64(0), nil
+	}
+	donation, err := dm.storage.GetUserDonation(ctx, developer, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return types.DecToCoin(
+		pool.Balance.ToDec().Mul(donation.Amount.ToDec()).Quo(pool.TotalDonation.ToDec())), nil
+}
+
+// DistributeRewardPool - split reward pool balance among users pro rata to their donations,
+// the last user gets the rest of balance. Donations are cleared after payout, balance is
+// kept for next month if no user donated through app.
+func (dm DeveloperManager) DistributeRewardPool(
+	ctx sdk.Context, developer types.AccountKey) ([]model.RewardPayout, sdk.Error) {
+	payouts := []model.RewardPayout{}
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		return payouts, nil
+	}
+	donations, err := dm.storage.GetUserDonations(ctx, developer)
+	if err != nil {
+		return nil, err
+	}
+	if len(donations) == 0 || pool.TotalDonation.IsZero() {
+		return payouts, nil
+	}
+	distributed := types.NewCoinFromInt64(0)
+	for idx, donation := range donations {
+		payout := types.DecToCoin(
+			pool.Balance.ToDec().Mul(donation.Amount.ToDec()).Quo(pool.TotalDonation.ToDec()))
+		if idx == (len(donations) - 1) {
+			payout = pool.Balance.Minus(distributed)
+		}
+		distributed = distributed.Plus(payout)
+		if err := dm.storage.DeleteUserDonation(ctx, developer, donation.Username); err != nil {
+			return nil, err
+		}
+		if payout.IsZero() {
+			continue
+		}
+		payouts = append(payouts, model.RewardPayout{
+			Username: donation.Username,
+			Amount:   payout,
+		})
+	}
+	pool.Balance = types.NewCoinFromInt64(0)
+	pool.TotalDonation = types.NewCoinFromInt64(0)
+	if err := dm.storage.SetRewardPool(ctx, developer, pool); err != nil {
+		return nil, err
+	}
+	return payouts, nil
+}
+
+// FundRewardPool - move share of developer inflation into reward pool,
+// return coin moved, zero if developer doesn't have reward pool
+func (dm DeveloperManager) FundRewardPool(
+	ctx sdk.Context, developer types.AccountKey, inflation types.Coin) (types.Coin, sdk.Error) {
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	funded := types.DecToCoin(inflation.ToDec().Mul(pool.ShareRatio))
+	pool.Balance = pool.Balance.Plus(funded)
+	if err := dm.storage.SetRewardPool(ctx, developer, pool); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return funded, nil
+}
+
+// WithdrawRewardPool - remove reward pool and recorded donations of developer,
+// return remaining balance
+func (dm DeveloperManager) WithdrawRewardPool(
+	ctx sdk.Context, developer types.AccountKey) (types.Coin, sdk.Error) {
+	pool, err := dm.storage.GetRewardPool(ctx, developer)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	donations, err := dm.storage.GetUserDonations(ctx, developer)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	for _, donation := range donations {
+		if err := dm.storage.DeleteUserDonation(ctx, developer, donation.Username); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+	}
+	if err := dm.storage.DeleteRewardPool(ctx, developer); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return pool.Balance, nil
+}
+
 func (dm DeveloperManager) GetDeveloperList(ctx sdk.Context) (*model.DeveloperList, sdk.Error) {
 	return dm.storage.GetDeveloperList(ctx)
 }
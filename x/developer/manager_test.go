@@ -2,10 +2,12 @@ package developer
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestReportConsumption(t *testing.T) {
@@ -116,3 +118,276 @@ func TestUpdateDeveloper(t *testing.T) {
 		}
 	}
 }
+
+func TestSponsorRegistration(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	cost := types.NewCoinFromInt64(1 * types.Decimals)
+	dm.RegisterDeveloper(
+		ctx, "developer1", devParam.DeveloperMinDeposit.Plus(cost.Plus(cost)), "", "", "")
+
+	baseTime := time.Unix(1000000, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: baseTime})
+	err := dm.SponsorRegistration(ctx, "developer1", cost)
+	assert.Nil(t, err)
+
+	// reach daily cap
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	developer.SponsoredCount = devParam.DeveloperMaxDailySponsorship
+	dm.storage.SetDeveloper(ctx, "developer1", developer)
+	err = dm.SponsorRegistration(ctx, "developer1", cost)
+	assert.Equal(t, ErrSponsorshipLimitExceeded("developer1"), err)
+
+	// cap is reset in next interval
+	ctx = ctx.WithBlockHeader(abci.Header{Time: baseTime.Add(types.SponsorshipIntervalSec * time.Second)})
+	err = dm.SponsorRegistration(ctx, "developer1", cost)
+	assert.Nil(t, err)
+	developer, _ = dm.storage.GetDeveloper(ctx, "developer1")
+	assert.Equal(t, int64(1), developer.SponsoredCount)
+	assert.True(t, developer.Deposit.IsEqual(devParam.DeveloperMinDeposit))
+
+	// deposit can't go below minimum deposit
+	err = dm.SponsorRegistration(ctx, "developer1", cost)
+	assert.Equal(t, ErrInsufficientSponsorDeposit(), err)
+}
+
+func TestConsumeFeeGrant(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	bandwidthParam, _ := dm.paramHolder.GetBandwidthParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+	txCost := bandwidthParam.CapacityUsagePerTransaction
+
+	baseTime := time.Unix(1000000, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: baseTime})
+	err := dm.ConsumeFeeGrant(ctx, "user1", sdk.OneDec())
+	assert.Equal(t, ErrFeeGrantNotEnough("user1"), err)
+
+	// grant covers exactly one transaction
+	dm.GrantFee(ctx, "developer1", "user1", txCost, 3600)
+	err = dm.ConsumeFeeGrant(ctx, "user1", sdk.OneDec())
+	assert.Nil(t, err)
+	err = dm.ConsumeFeeGrant(ctx, "user1", sdk.OneDec())
+	assert.Equal(t, ErrFeeGrantNotEnough("user1"), err)
+
+	grants, _ := dm.GetFeeGrants(ctx, "user1")
+	assert.Equal(t, 1, len(grants))
+	assert.True(t, grants[0].Used.IsEqual(txCost))
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	assert.True(t, developer.TransactionCapacity.IsEqual(devParam.DeveloperMinDeposit.Minus(txCost)))
+
+	// expired grant doesn't cover transaction
+	dm.GrantFee(ctx, "developer1", "user1", txCost.Plus(txCost), 3600)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: baseTime.Add(3600 * time.Second)})
+	err = dm.ConsumeFeeGrant(ctx, "user1", sdk.OneDec())
+	assert.Equal(t, ErrFeeGrantNotEnough("user1"), err)
+}
+
+func TestArchiveMonthlyStatement(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+	dm.AddToDeveloperList(ctx, "developer1")
+
+	months := []struct {
+		settledAt   int64
+		consumption types.Coin
+		weight      sdk.Dec
+		inflation   types.Coin
+	}{
+		{
+			settledAt:   1000,
+			consumption: types.NewCoinFromInt64(100),
+			weight:      types.NewDecFromRat(1, 4),
+			inflation:   types.NewCoinFromInt64(25),
+		},
+		{
+			settledAt:   2000,
+			consumption: types.NewCoinFromInt64(0),
+			weight:      sdk.OneDec(),
+			inflation:   types.NewCoinFromInt64(100),
+		},
+	}
+	for _, month := range months {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(month.settledAt, 0)})
+		dm.ReportConsumption(ctx, "developer1", month.consumption)
+		err := dm.ArchiveMonthlyStatement(ctx, "developer1", month.weight, month.inflation)
+		assert.Nil(t, err)
+		dm.ClearConsumption(ctx)
+	}
+
+	statements, err := dm.GetMonthlyStatements(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, len(months), len(statements))
+	for i, month := range months {
+		assert.Equal(t, month.settledAt, statements[i].SettledAt)
+		assert.True(t, statements[i].Consumption.IsEqual(month.consumption))
+		assert.True(t, statements[i].ConsumptionWeight.Equal(month.weight))
+		assert.True(t, statements[i].Inflation.IsEqual(month.inflation))
+	}
+
+	statements, err = dm.GetMonthlyStatements(ctx, "developer2")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(statements))
+}
+
+func TestSlashDepositAndPunishCooldown(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	slashed, err := dm.SlashDeposit(ctx, "developer1", types.NewDecFromRat(1, 4))
+	assert.Nil(t, err)
+	assert.True(t, slashed.IsEqual(types.DecToCoin(devParam.DeveloperMinDeposit.ToDec().Quo(sdk.NewDec(4)))))
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	assert.True(t, developer.Deposit.Plus(slashed).IsEqual(devParam.DeveloperMinDeposit))
+
+	_, err = dm.SlashDeposit(ctx, "developer2", types.NewDecFromRat(1, 4))
+	assert.Equal(t, types.CodeDeveloperNotFound, err.Code())
+
+	assert.False(t, dm.IsInPunishCooldown(ctx, "developer1"))
+	err = dm.StartPunishCooldown(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.True(t, dm.IsInPunishCooldown(ctx, "developer1"))
+
+	ctx = ctx.WithBlockHeader(abci.Header{
+		Time: ctx.BlockHeader().Time.Add(time.Duration(devParam.DeveloperPunishCooldownSec) * time.Second)})
+	assert.False(t, dm.IsInPunishCooldown(ctx, "developer1"))
+}
+
+func TestGetStorageQuota(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	deposit, _ := devParam.DeveloperMinDeposit.ToInt64()
+	quota, err := dm.GetStorageQuota(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, deposit/types.AppDataCoinPerByte, quota)
+
+	_, err = dm.GetStorageQuota(ctx, "developer2")
+	assert.Equal(t, types.CodeDeveloperNotFound, err.Code())
+}
+
+func TestDeveloperAdmin(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	assert.True(t, dm.IsAuthorizedAdmin(ctx, "developer1", "developer1", types.FinanceAdmin))
+	assert.False(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.MetadataAdmin))
+
+	err := dm.SetDeveloperAdmin(ctx, "developer1", "admin1", []types.AdminRole{types.MetadataAdmin})
+	assert.Nil(t, err)
+	assert.True(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.MetadataAdmin))
+	assert.False(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.FinanceAdmin))
+	assert.False(t, dm.IsAuthorizedAdmin(ctx, "developer2", "admin1", types.MetadataAdmin))
+
+	// replace roles
+	err = dm.SetDeveloperAdmin(ctx, "developer1", "admin1", []types.AdminRole{types.FinanceAdmin})
+	assert.Nil(t, err)
+	assert.False(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.MetadataAdmin))
+	assert.True(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.FinanceAdmin))
+
+	err = dm.SetDeveloperAdmin(ctx, "developer1", "admin2", []types.AdminRole{types.GrantsAdmin})
+	assert.Nil(t, err)
+	admins, _ := dm.GetDeveloperAdmins(ctx, "developer1")
+	assert.Equal(t, 2, len(admins))
+
+	err = dm.RemoveDeveloperAdmin(ctx, "developer1", "admin1")
+	assert.Nil(t, err)
+	assert.False(t, dm.IsAuthorizedAdmin(ctx, "developer1", "admin1", types.FinanceAdmin))
+	err = dm.RemoveDeveloperAdmin(ctx, "developer1", "admin1")
+	assert.Equal(t, types.CodeDeveloperAdminNotFound, err.Code())
+
+	err = dm.ClearDeveloperAdmins(ctx, "developer1")
+	assert.Nil(t, err)
+	admins, _ = dm.GetDeveloperAdmins(ctx, "developer1")
+	assert.Equal(t, 0, len(admins))
+}
+
+func TestRewardPool(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	// donations are ignored and nothing is funded without reward pool
+	err := dm.RecordUserDonation(ctx, "developer1", "user1", types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	funded, err := dm.FundRewardPool(ctx, "developer1", types.NewCoinFromInt64(1000))
+	assert.Nil(t, err)
+	assert.True(t, funded.IsZero())
+	_, err = dm.GetRewardPool(ctx, "developer1")
+	assert.Equal(t, types.CodeRewardPoolNotFound, err.Code())
+
+	err = dm.SetRewardPoolShare(ctx, "developer1", types.NewDecFromRat(1, 10))
+	assert.Nil(t, err)
+	funded, err = dm.FundRewardPool(ctx, "developer1", types.NewCoinFromInt64(1000))
+	assert.Nil(t, err)
+	assert.True(t, funded.IsEqual(types.NewCoinFromInt64(100)))
+
+	// no donation, balance is kept for next month
+	payouts, err := dm.DistributeRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(payouts))
+	pool, err := dm.GetRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.True(t, pool.Balance.IsEqual(types.NewCoinFromInt64(100)))
+
+	for _, username := range []types.AccountKey{"user1", "user2", "user3"} {
+		err = dm.RecordUserDonation(ctx, "developer1", username, types.NewCoinFromInt64(10))
+		assert.Nil(t, err)
+	}
+	pool, err = dm.GetRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.True(t, pool.TotalDonation.IsEqual(types.NewCoinFromInt64(30)))
+	pending, err := dm.GetPendingPayout(ctx, "developer1", "user1")
+	assert.Nil(t, err)
+	assert.True(t, pending.IsEqual(types.NewCoinFromInt64(33)))
+	pending, err = dm.GetPendingPayout(ctx, "developer1", "user4")
+	assert.Nil(t, err)
+	assert.True(t, pending.IsZero())
+
+	// last user gets the rest of balance
+	payouts, err = dm.DistributeRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(payouts))
+	assert.Equal(t, types.AccountKey("user1"), payouts[0].Username)
+	assert.True(t, payouts[0].Amount.IsEqual(types.NewCoinFromInt64(33)))
+	assert.True(t, payouts[1].Amount.IsEqual(types.NewCoinFromInt64(33)))
+	assert.Equal(t, types.AccountKey("user3"), payouts[2].Username)
+	assert.True(t, payouts[2].Amount.IsEqual(types.NewCoinFromInt64(34)))
+	pool, err = dm.GetRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.True(t, pool.Balance.IsZero())
+	assert.True(t, pool.TotalDonation.IsZero())
+	pending, err = dm.GetPendingPayout(ctx, "developer1", "user1")
+	assert.Nil(t, err)
+	assert.True(t, pending.IsZero())
+
+	funded, err = dm.FundRewardPool(ctx, "developer1", types.NewCoinFromInt64(500))
+	assert.Nil(t, err)
+	err = dm.RecordUserDonation(ctx, "developer1", "user1", types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	balance, err := dm.WithdrawRewardPool(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.True(t, balance.IsEqual(funded))
+	_, err = dm.GetRewardPool(ctx, "developer1")
+	assert.Equal(t, types.CodeRewardPoolNotFound, err.Code())
+	pending, err = dm.GetPendingPayout(ctx, "developer1", "user1")
+	assert.Equal(t, types.CodeRewardPoolNotFound, err.Code())
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Developer - developer is account with developer deposit, can get developer inflation
@@ -12,9 +14,68 @@ type Developer struct {
 	Website        string           `json:"web_site"`
 	Description    string           `json:"description"`
 	AppMetaData    string           `json:"app_meta_data"`
+	// sponsored registrations in the interval starting at SponsorPeriodStart
+	SponsoredCount     int64 `json:"sponsored_count"`
+	SponsorPeriodStart int64 `json:"sponsor_period_start"`
+	// capacity backed by deposit, used to cover fee grants
+	TransactionCapacity types.Coin `json:"transaction_capacity"`
+	LastActivityAt      int64      `json:"last_activity_at"`
+}
+
+// FeeGrant - developer covers TPS capacity of user up to limit before expiry
+type FeeGrant struct {
+	Developer types.AccountKey `json:"developer"`
+	Limit     types.Coin       `json:"limit"`
+	Used      types.Coin       `json:"used"`
+	CreatedAt int64            `json:"created_at"`
+	ExpiresAt int64            `json:"expires_at"`
+}
+
+// DeveloperAdmin - account allowed to act for developer in given roles
+type DeveloperAdmin struct {
+	Admin     types.AccountKey  `json:"admin"`
+	Roles     []types.AdminRole `json:"roles"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// HasRole - check if admin is allowed to act in role
+func (admin DeveloperAdmin) HasRole(role types.AdminRole) bool {
+	for _, r := range admin.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RewardPool - share of developer inflation set aside for users donating through app
+type RewardPool struct {
+	ShareRatio    sdk.Dec    `json:"share_ratio"`
+	Balance       types.Coin `json:"balance"`
+	TotalDonation types.Coin `json:"total_donation"`
+}
+
+// UserDonation - donations made by user through app since last reward pool payout
+type UserDonation struct {
+	Username types.AccountKey `json:"username"`
+	Amount   types.Coin       `json:"amount"`
+}
+
+// RewardPayout - coin paid to user from reward pool of app
+type RewardPayout struct {
+	Username types.AccountKey `json:"username"`
+	Amount   types.Coin       `json:"amount"`
 }
 
 // DeveloperList - list of developers
 type DeveloperList struct {
 	AllDevelopers []types.AccountKey `json:"all_developers"`
 }
+
+// MonthlyStatement - developer consumption, consumption weight and inflation of a month
+type MonthlyStatement struct {
+	SettledAt         int64      `json:"settled_at"`
+	Consumption       types.Coin `json:"consumption"`
+	ConsumptionWeight sdk.Dec    `json:"consumption_weight"`
+	Inflation         types.Coin `json:"inflation"`
+}
@@ -37,3 +37,78 @@ func ErrFailedToUnmarshalDeveloper(err error) sdk.Error {
 func ErrFailedToUnmarshalDeveloperList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalDeveloperList, fmt.Sprintf("failed to unmarshal developer list: %s", err.Error()))
 }
+
+// ErrFeeGrantNotFound - error if fee grant is not found in KVStore
+func ErrFeeGrantNotFound() sdk.Error {
+	return types.NewError(types.CodeFeeGrantNotFound, fmt.Sprintf("fee grant is not found"))
+}
+
+// ErrFailedToMarshalFeeGrant - error if marshal fee grant failed
+func ErrFailedToMarshalFeeGrant(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalFeeGrant, fmt.Sprintf("failed to marshal fee grant: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalFeeGrant - error if unmarshal fee grant failed
+func ErrFailedToUnmarshalFeeGrant(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFeeGrant, fmt.Sprintf("failed to unmarshal fee grant: %s", err.Error()))
+}
+
+// ErrFailedToMarshalStatement - error if marshal monthly statement failed
+func ErrFailedToMarshalStatement(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalStatement, fmt.Sprintf("failed to marshal monthly statement: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalStatement - error if unmarshal monthly statement failed
+func ErrFailedToUnmarshalStatement(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalStatement, fmt.Sprintf("failed to unmarshal monthly statement: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCooldown - error if marshal punish cooldown failed
+func ErrFailedToMarshalCooldown(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCooldown, fmt.Sprintf("failed to marshal punish cooldown: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCooldown - error if unmarshal punish cooldown failed
+func ErrFailedToUnmarshalCooldown(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCooldown, fmt.Sprintf("failed to unmarshal punish cooldown: %s", err.Error()))
+}
+
+// ErrDeveloperAdminNotFound - error if developer admin is not found
+func ErrDeveloperAdminNotFound() sdk.Error {
+	return types.NewError(types.CodeDeveloperAdminNotFound, fmt.Sprintf("developer admin is not found"))
+}
+
+// ErrFailedToMarshalAdmin - error if marshal developer admin failed
+func ErrFailedToMarshalAdmin(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalAdmin, fmt.Sprintf("failed to marshal developer admin: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalAdmin - error if unmarshal developer admin failed
+func ErrFailedToUnmarshalAdmin(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalAdmin, fmt.Sprintf("failed to unmarshal developer admin: %s", err.Error()))
+}
+
+// ErrRewardPoolNotFound - error if reward pool is not found
+func ErrRewardPoolNotFound() sdk.Error {
+	return types.NewError(types.CodeRewardPoolNotFound, fmt.Sprintf("reward pool is not found"))
+}
+
+// ErrFailedToMarshalRewardPool - error if marshal reward pool failed
+func ErrFailedToMarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRewardPool, fmt.Sprintf("failed to marshal reward pool: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRewardPool - error if unmarshal reward pool failed
+func ErrFailedToUnmarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRewardPool, fmt.Sprintf("failed to unmarshal reward pool: %s", err.Error()))
+}
+
+// ErrFailedToMarshalUserDonation - error if marshal user donation failed
+func ErrFailedToMarshalUserDonation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUserDonation, fmt.Sprintf("failed to marshal user donation: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalUserDonation - error if unmarshal user donation failed
+func ErrFailedToUnmarshalUserDonation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUserDonation, fmt.Sprintf("failed to unmarshal user donation: %s", err.Error()))
+}
@@ -15,10 +15,59 @@ type DeveloperListTable struct {
 	List DeveloperList `json:"list"`
 }
 
+// FeeGrantRow - pk: (Username, Grant.Developer)
+type FeeGrantRow struct {
+	Username types.AccountKey `json:"username"`
+	Grant    FeeGrant         `json:"grant"`
+}
+
+// MonthlyStatementRow - pk: (Developer, Statement.SettledAt)
+type MonthlyStatementRow struct {
+	Developer types.AccountKey `json:"developer"`
+	Statement MonthlyStatement `json:"statement"`
+}
+
+// PunishCooldownRow - pk: Username
+type PunishCooldownRow struct {
+	Username types.AccountKey `json:"username"`
+	Until    int64            `json:"until"`
+}
+
+// DeveloperAdminRow - pk: (Developer, Admin.Admin)
+type DeveloperAdminRow struct {
+	Developer types.AccountKey `json:"developer"`
+	Admin     DeveloperAdmin   `json:"admin"`
+}
+
+// RewardPoolRow - pk: Developer
+type RewardPoolRow struct {
+	Developer types.AccountKey `json:"developer"`
+	Pool      RewardPool       `json:"pool"`
+}
+
+// UserDonationRow - pk: (Developer, Donation.Username)
+type UserDonationRow struct {
+	Developer types.AccountKey `json:"developer"`
+	Donation  UserDonation     `json:"donation"`
+}
+
+// PendingPunishRow - pk: (Developer, ProposalID)
+type PendingPunishRow struct {
+	Developer  types.AccountKey  `json:"developer"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
 // DeveloperTables is the state of developer storage, organized as a table.
 type DeveloperTables struct {
-	Developers    []DeveloperRow     `json:"developers"`
-	DeveloperList DeveloperListTable `json:"developer_list"`
+	Developers        []DeveloperRow        `json:"developers"`
+	DeveloperList     DeveloperListTable    `json:"developer_list"`
+	FeeGrants         []FeeGrantRow         `json:"fee_grants"`
+	MonthlyStatements []MonthlyStatementRow `json:"monthly_statements"`
+	PunishCooldowns   []PunishCooldownRow   `json:"punish_cooldowns"`
+	DeveloperAdmins   []DeveloperAdminRow   `json:"developer_admins"`
+	RewardPools       []RewardPoolRow       `json:"reward_pools"`
+	UserDonations     []UserDonationRow     `json:"user_donations"`
+	PendingPunishes   []PendingPunishRow    `json:"pending_punishes"`
 }
 
 // ToIR -
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/types"
 
@@ -10,6 +13,13 @@ import (
 var (
 	developerSubstore     = []byte{0x00}
 	developerListSubstore = []byte{0x01}
+	feeGrantSubstore      = []byte{0x02}
+	statementSubstore     = []byte{0x03}
+	cooldownSubstore      = []byte{0x04}
+	adminSubstore         = []byte{0x05}
+	rewardPoolSubstore    = []byte{0x06}
+	userDonationSubstore  = []byte{0x07}
+	pendingPunishSubstore = []byte{0x08}
 )
 
 // DeveloperStorage - developer storage
@@ -101,6 +111,280 @@ func (ds DeveloperStorage) SetDeveloperList(ctx sdk.Context, lst *DeveloperList)
 	return nil
 }
 
+// GetFeeGrant - get fee grant from developer to user
+func (ds DeveloperStorage) GetFeeGrant(
+	ctx sdk.Context, username, developer types.AccountKey) (*FeeGrant, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	grantByte := store.Get(GetFeeGrantKey(username, developer))
+	if grantByte == nil {
+		return nil, ErrFeeGrantNotFound()
+	}
+	grant := new(FeeGrant)
+	if err := ds.cdc.UnmarshalBinaryLengthPrefixed(grantByte, grant); err != nil {
+		return nil, ErrFailedToUnmarshalFeeGrant(err)
+	}
+	return grant, nil
+}
+
+// GetFeeGrants - get all fee grants received by user
+func (ds DeveloperStorage) GetFeeGrants(
+	ctx sdk.Context, username types.AccountKey) ([]FeeGrant, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	grants := []FeeGrant{}
+	iter := sdk.KVStorePrefixIterator(store, GetFeeGrantPrefix(username))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		grant := new(FeeGrant)
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), grant); err != nil {
+			return nil, ErrFailedToUnmarshalFeeGrant(err)
+		}
+		grants = append(grants, *grant)
+	}
+	return grants, nil
+}
+
+// SetFeeGrant - set fee grant from developer to user
+func (ds DeveloperStorage) SetFeeGrant(
+	ctx sdk.Context, username types.AccountKey, grant *FeeGrant) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	grantByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*grant)
+	if err != nil {
+		return ErrFailedToMarshalFeeGrant(err)
+	}
+	store.Set(GetFeeGrantKey(username, grant.Developer), grantByte)
+	return nil
+}
+
+// DeleteFeeGrant - delete fee grant from developer to user
+func (ds DeveloperStorage) DeleteFeeGrant(
+	ctx sdk.Context, username, developer types.AccountKey) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetFeeGrantKey(username, developer))
+	return nil
+}
+
+// GetMonthlyStatements - get monthly statements of developer in settlement order
+func (ds DeveloperStorage) GetMonthlyStatements(
+	ctx sdk.Context, developer types.AccountKey) ([]MonthlyStatement, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	statements := []MonthlyStatement{}
+	iter := sdk.KVStorePrefixIterator(store, GetMonthlyStatementPrefix(developer))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		statement := new(MonthlyStatement)
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), statement); err != nil {
+			return nil, ErrFailedToUnmarshalStatement(err)
+		}
+		statements = append(statements, *statement)
+	}
+	return statements, nil
+}
+
+// SetMonthlyStatement - set monthly statement of developer
+func (ds DeveloperStorage) SetMonthlyStatement(
+	ctx sdk.Context, developer types.AccountKey, statement *MonthlyStatement) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	statementByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*statement)
+	if err != nil {
+		return ErrFailedToMarshalStatement(err)
+	}
+	store.Set(GetMonthlyStatementKey(developer, statement.SettledAt), statementByte)
+	return nil
+}
+
+// GetPunishCooldown - get time until which punished account can't register as developer,
+// zero if account has never been punished
+func (ds DeveloperStorage) GetPunishCooldown(
+	ctx sdk.Context, username types.AccountKey) (int64, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	cooldownByte := store.Get(GetPunishCooldownKey(username))
+	if cooldownByte == nil {
+		return 0, nil
+	}
+	var until int64
+	if err := ds.cdc.UnmarshalBinaryLengthPrefixed(cooldownByte, &until); err != nil {
+		return 0, ErrFailedToUnmarshalCooldown(err)
+	}
+	return until, nil
+}
+
+// SetPunishCooldown - set time until which punished account can't register as developer
+func (ds DeveloperStorage) SetPunishCooldown(
+	ctx sdk.Context, username types.AccountKey, until int64) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	cooldownByte, err := ds.cdc.MarshalBinaryLengthPrefixed(until)
+	if err != nil {
+		return ErrFailedToMarshalCooldown(err)
+	}
+	store.Set(GetPunishCooldownKey(username), cooldownByte)
+	return nil
+}
+
+// GetDeveloperAdmin - get admin of developer from KVStore
+func (ds DeveloperStorage) GetDeveloperAdmin(
+	ctx sdk.Context, developer, admin types.AccountKey) (*DeveloperAdmin, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	adminByte := store.Get(GetDeveloperAdminKey(developer, admin))
+	if adminByte == nil {
+		return nil, ErrDeveloperAdminNotFound()
+	}
+	developerAdmin := new(DeveloperAdmin)
+	if err := ds.cdc.UnmarshalBinaryLengthPrefixed(adminByte, developerAdmin); err != nil {
+		return nil, ErrFailedToUnmarshalAdmin(err)
+	}
+	return developerAdmin, nil
+}
+
+// GetDeveloperAdmins - get all admins of developer
+func (ds DeveloperStorage) GetDeveloperAdmins(
+	ctx sdk.Context, developer types.AccountKey) ([]DeveloperAdmin, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	admins := []DeveloperAdmin{}
+	iter := sdk.KVStorePrefixIterator(store, GetDeveloperAdminPrefix(developer))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		developerAdmin := new(DeveloperAdmin)
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), developerAdmin); err != nil {
+			return nil, ErrFailedToUnmarshalAdmin(err)
+		}
+		admins = append(admins, *developerAdmin)
+	}
+	return admins, nil
+}
+
+// SetDeveloperAdmin - set admin of developer to KVStore
+func (ds DeveloperStorage) SetDeveloperAdmin(
+	ctx sdk.Context, developer types.AccountKey, developerAdmin *DeveloperAdmin) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	adminByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*developerAdmin)
+	if err != nil {
+		return ErrFailedToMarshalAdmin(err)
+	}
+	store.Set(GetDeveloperAdminKey(developer, developerAdmin.Admin), adminByte)
+	return nil
+}
+
+// DeleteDeveloperAdmin - delete admin of developer from KVStore
+func (ds DeveloperStorage) DeleteDeveloperAdmin(
+	ctx sdk.Context, developer, admin types.AccountKey) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetDeveloperAdminKey(developer, admin))
+	return nil
+}
+
+// GetRewardPool - get reward pool of developer from KVStore
+func (ds DeveloperStorage) GetRewardPool(
+	ctx sdk.Context, developer types.AccountKey) (*RewardPool, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	poolByte := store.Get(GetRewardPoolKey(developer))
+	if poolByte == nil {
+		return nil, ErrRewardPoolNotFound()
+	}
+	pool := new(RewardPool)
+	if err := ds.cdc.UnmarshalBinaryLengthPrefixed(poolByte, pool); err != nil {
+		return nil, ErrFailedToUnmarshalRewardPool(err)
+	}
+	return pool, nil
+}
+
+// SetRewardPool - set reward pool of developer to KVStore
+func (ds DeveloperStorage) SetRewardPool(
+	ctx sdk.Context, developer types.AccountKey, pool *RewardPool) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	poolByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*pool)
+	if err != nil {
+		return ErrFailedToMarshalRewardPool(err)
+	}
+	store.Set(GetRewardPoolKey(developer), poolByte)
+	return nil
+}
+
+// DeleteRewardPool - delete reward pool of developer from KVStore
+func (ds DeveloperStorage) DeleteRewardPool(ctx sdk.Context, developer types.AccountKey) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetRewardPoolKey(developer))
+	return nil
+}
+
+// GetUserDonation - get donation of user through developer, zero if user hasn't donated
+func (ds DeveloperStorage) GetUserDonation(
+	ctx sdk.Context, developer, username types.AccountKey) (*UserDonation, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	donationByte := store.Get(GetUserDonationKey(developer, username))
+	if donationByte == nil {
+		return &UserDonation{Username: username, Amount: types.NewCoinFromInt64(0)}, nil
+	}
+	donation := new(UserDonation)
+	if err := ds.cdc.UnmarshalBinaryLengthPrefixed(donationByte, donation); err != nil {
+		return nil, ErrFailedToUnmarshalUserDonation(err)
+	}
+	return donation, nil
+}
+
+// GetUserDonations - get all user donations through developer, ordered by username
+func (ds DeveloperStorage) GetUserDonations(
+	ctx sdk.Context, developer types.AccountKey) ([]UserDonation, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	donations := []UserDonation{}
+	iter := sdk.KVStorePrefixIterator(store, GetUserDonationPrefix(developer))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		donation := new(UserDonation)
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), donation); err != nil {
+			return nil, ErrFailedToUnmarshalUserDonation(err)
+		}
+		donations = append(donations, *donation)
+	}
+	return donations, nil
+}
+
+// SetUserDonation - set donation of user through developer to KVStore
+func (ds DeveloperStorage) SetUserDonation(
+	ctx sdk.Context, developer types.AccountKey, donation *UserDonation) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	donationByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*donation)
+	if err != nil {
+		return ErrFailedToMarshalUserDonation(err)
+	}
+	store.Set(GetUserDonationKey(developer, donation.Username), donationByte)
+	return nil
+}
+
+// DeleteUserDonation - delete donation of user through developer from KVStore
+func (ds DeveloperStorage) DeleteUserDonation(
+	ctx sdk.Context, developer, username types.AccountKey) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetUserDonationKey(developer, username))
+	return nil
+}
+
+// GetPendingPunishes - get undecided punish proposals against developer
+func (ds DeveloperStorage) GetPendingPunishes(
+	ctx sdk.Context, developer types.AccountKey) []types.ProposalKey {
+	store := ctx.KVStore(ds.key)
+	proposalIDs := []types.ProposalKey{}
+	iter := sdk.KVStorePrefixIterator(store, GetPendingPunishPrefix(developer))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proposalIDs = append(proposalIDs, types.ProposalKey(iter.Value()))
+	}
+	return proposalIDs
+}
+
+// SetPendingPunish - record undecided punish proposal against developer to KVStore
+func (ds DeveloperStorage) SetPendingPunish(
+	ctx sdk.Context, developer types.AccountKey, proposalID types.ProposalKey) {
+	store := ctx.KVStore(ds.key)
+	store.Set(GetPendingPunishKey(developer, proposalID), []byte(proposalID))
+}
+
+// DeletePendingPunish - delete decided punish proposal against developer from KVStore
+func (ds DeveloperStorage) DeletePendingPunish(
+	ctx sdk.Context, developer types.AccountKey, proposalID types.ProposalKey) {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetPendingPunishKey(developer, proposalID))
+}
+
 // Export developer storage state
 func (ds DeveloperStorage) Export(ctx sdk.Context) *DeveloperTables {
 	tables := &DeveloperTables{}
@@ -131,6 +415,129 @@ func (ds DeveloperStorage) Export(ctx sdk.Context) *DeveloperTables {
 	tables.DeveloperList = DeveloperListTable{
 		List: *list,
 	}
+	// export table.FeeGrants
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, feeGrantSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal fee grant key: " + string(itr.Key()[1:]))
+			}
+			grant := new(FeeGrant)
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), grant); err != nil {
+				panic("failed to read fee grant: " + err.Error())
+			}
+			tables.FeeGrants = append(tables.FeeGrants, FeeGrantRow{
+				Username: types.AccountKey(strs[0]),
+				Grant:    *grant,
+			})
+		}
+	}()
+	// export table.MonthlyStatements
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, statementSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal monthly statement key: " + string(itr.Key()[1:]))
+			}
+			statement := new(MonthlyStatement)
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), statement); err != nil {
+				panic("failed to read monthly statement: " + err.Error())
+			}
+			tables.MonthlyStatements = append(tables.MonthlyStatements, MonthlyStatementRow{
+				Developer: types.AccountKey(strs[0]),
+				Statement: *statement,
+			})
+		}
+	}()
+	// export table.PunishCooldowns
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, cooldownSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			username := types.AccountKey(itr.Key()[1:])
+			until, err := ds.GetPunishCooldown(ctx, username)
+			if err != nil {
+				panic("failed to read punish cooldown: " + err.Error())
+			}
+			tables.PunishCooldowns = append(tables.PunishCooldowns, PunishCooldownRow{
+				Username: username,
+				Until:    until,
+			})
+		}
+	}()
+	// export table.DeveloperAdmins
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, adminSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal developer admin key: " + string(itr.Key()[1:]))
+			}
+			developerAdmin := new(DeveloperAdmin)
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), developerAdmin); err != nil {
+				panic("failed to read developer admin: " + err.Error())
+			}
+			tables.DeveloperAdmins = append(tables.DeveloperAdmins, DeveloperAdminRow{
+				Developer: types.AccountKey(strs[0]),
+				Admin:     *developerAdmin,
+			})
+		}
+	}()
+	// export table.RewardPools
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, rewardPoolSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			developer := types.AccountKey(itr.Key()[1:])
+			pool, err := ds.GetRewardPool(ctx, developer)
+			if err != nil {
+				panic("failed to read reward pool: " + err.Error())
+			}
+			tables.RewardPools = append(tables.RewardPools, RewardPoolRow{
+				Developer: developer,
+				Pool:      *pool,
+			})
+		}
+	}()
+	// export table.UserDonations
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, userDonationSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal user donation key: " + string(itr.Key()[1:]))
+			}
+			donation := new(UserDonation)
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), donation); err != nil {
+				panic("failed to read user donation: " + err.Error())
+			}
+			tables.UserDonations = append(tables.UserDonations, UserDonationRow{
+				Developer: types.AccountKey(strs[0]),
+				Donation:  *donation,
+			})
+		}
+	}()
+	// export table.PendingPunishes
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, pendingPunishSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal pending punish key: " + string(itr.Key()[1:]))
+			}
+			tables.PendingPunishes = append(tables.PendingPunishes, PendingPunishRow{
+				Developer:  types.AccountKey(strs[0]),
+				ProposalID: types.ProposalKey(itr.Value()),
+			})
+		}
+	}()
 	return tables
 }
 
@@ -149,6 +556,40 @@ func (ds DeveloperStorage) Import(ctx sdk.Context, tb *DeveloperTablesIR) {
 	// import DeveloperList
 	err := ds.SetDeveloperList(ctx, &tb.DeveloperList.List)
 	check(err)
+	// import table.FeeGrants
+	for _, v := range tb.FeeGrants {
+		err := ds.SetFeeGrant(ctx, v.Username, &v.Grant)
+		check(err)
+	}
+	// import table.MonthlyStatements
+	for _, v := range tb.MonthlyStatements {
+		err := ds.SetMonthlyStatement(ctx, v.Developer, &v.Statement)
+		check(err)
+	}
+	// import table.PunishCooldowns
+	for _, v := range tb.PunishCooldowns {
+		err := ds.SetPunishCooldown(ctx, v.Username, v.Until)
+		check(err)
+	}
+	// import table.DeveloperAdmins
+	for _, v := range tb.DeveloperAdmins {
+		err := ds.SetDeveloperAdmin(ctx, v.Developer, &v.Admin)
+		check(err)
+	}
+	// import table.RewardPools
+	for _, v := range tb.RewardPools {
+		err := ds.SetRewardPool(ctx, v.Developer, &v.Pool)
+		check(err)
+	}
+	// import table.UserDonations
+	for _, v := range tb.UserDonations {
+		err := ds.SetUserDonation(ctx, v.Developer, &v.Donation)
+		check(err)
+	}
+	// import table.PendingPunishes
+	for _, v := range tb.PendingPunishes {
+		ds.SetPendingPunish(ctx, v.Developer, v.ProposalID)
+	}
 }
 
 // GetDeveloperKey - "developer substore" + "developer"
@@ -160,3 +601,63 @@ func GetDeveloperKey(accKey types.AccountKey) []byte {
 func GetDeveloperListKey() []byte {
 	return developerListSubstore
 }
+
+// GetFeeGrantPrefix - "fee grant substore" + "username" + sep
+func GetFeeGrantPrefix(username types.AccountKey) []byte {
+	return append(append(feeGrantSubstore, username...), types.KeySeparator...)
+}
+
+// GetFeeGrantKey - "fee grant substore" + "username" + sep + "developer"
+func GetFeeGrantKey(username, developer types.AccountKey) []byte {
+	return append(GetFeeGrantPrefix(username), developer...)
+}
+
+// GetMonthlyStatementPrefix - "statement substore" + "developer" + sep
+func GetMonthlyStatementPrefix(developer types.AccountKey) []byte {
+	return append(append(statementSubstore, developer...), types.KeySeparator...)
+}
+
+// GetMonthlyStatementKey - "statement substore" + "developer" + sep + "settled at"
+func GetMonthlyStatementKey(developer types.AccountKey, settledAt int64) []byte {
+	return append(GetMonthlyStatementPrefix(developer), fmt.Sprintf("%020d", settledAt)...)
+}
+
+// GetPunishCooldownKey - "cooldown substore" + "username"
+func GetPunishCooldownKey(username types.AccountKey) []byte {
+	return append(cooldownSubstore, username...)
+}
+
+// GetDeveloperAdminPrefix - "admin substore" + "developer" + sep
+func GetDeveloperAdminPrefix(developer types.AccountKey) []byte {
+	return append(append(adminSubstore, developer...), types.KeySeparator...)
+}
+
+// GetDeveloperAdminKey - "admin substore" + "developer" + sep + "admin"
+func GetDeveloperAdminKey(developer, admin types.AccountKey) []byte {
+	return append(GetDeveloperAdminPrefix(developer), admin...)
+}
+
+// GetRewardPoolKey - "reward pool substore" + "developer"
+func GetRewardPoolKey(developer types.AccountKey) []byte {
+	return append(rewardPoolSubstore, developer...)
+}
+
+// GetUserDonationPrefix - "user donation substore" + "developer" + sep
+func GetUserDonationPrefix(developer types.AccountKey) []byte {
+	return append(append(userDonationSubstore, developer...), types.KeySeparator...)
+}
+
+// GetUserDonationKey - "user donation substore" + "developer" + sep + "username"
+func GetUserDonationKey(developer, username types.AccountKey) []byte {
+	return append(GetUserDonationPrefix(developer), username...)
+}
+
+// GetPendingPunishPrefix - "pending punish substore" + "developer" + sep
+func GetPendingPunishPrefix(developer types.AccountKey) []byte {
+	return append(append(pendingPunishSubstore, developer...), types.KeySeparator...)
+}
+
+// GetPendingPunishKey - "pending punish substore" + "developer" + sep + "proposal id"
+func GetPendingPunishKey(developer types.AccountKey, proposalID types.ProposalKey) []byte {
+	return append(GetPendingPunishPrefix(developer), proposalID...)
+}
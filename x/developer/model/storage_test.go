@@ -50,6 +50,91 @@ func TestDeveloperList(t *testing.T) {
 
 }
 
+func TestDeveloperAdmin(t *testing.T) {
+	developer := types.AccountKey("dev")
+	admin := DeveloperAdmin{
+		Admin:     "admin1",
+		Roles:     []types.AdminRole{types.MetadataAdmin, types.GrantsAdmin},
+		CreatedAt: 100,
+	}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ds.GetDeveloperAdmin(env.ctx, developer, admin.Admin)
+		assert.Equal(t, ErrDeveloperAdminNotFound(), err)
+
+		err = env.ds.SetDeveloperAdmin(env.ctx, developer, &admin)
+		assert.Nil(t, err)
+
+		resultPtr, err := env.ds.GetDeveloperAdmin(env.ctx, developer, admin.Admin)
+		assert.Nil(t, err)
+		assert.Equal(t, admin, *resultPtr, "developer admin should be equal")
+		assert.True(t, resultPtr.HasRole(types.GrantsAdmin))
+		assert.False(t, resultPtr.HasRole(types.FinanceAdmin))
+
+		admins, err := env.ds.GetDeveloperAdmins(env.ctx, developer)
+		assert.Nil(t, err)
+		assert.Equal(t, []DeveloperAdmin{admin}, admins)
+
+		err = env.ds.DeleteDeveloperAdmin(env.ctx, developer, admin.Admin)
+		assert.Nil(t, err)
+		admins, err = env.ds.GetDeveloperAdmins(env.ctx, developer)
+		assert.Nil(t, err)
+		assert.Equal(t, []DeveloperAdmin{}, admins)
+	})
+}
+
+func TestRewardPool(t *testing.T) {
+	developer := types.AccountKey("dev")
+	pool := RewardPool{
+		ShareRatio:    types.NewDecFromRat(1, 10),
+		Balance:       types.NewCoinFromInt64(100),
+		TotalDonation: types.NewCoinFromInt64(30),
+	}
+	donations := []UserDonation{
+		{Username: "user1", Amount: types.NewCoinFromInt64(10)},
+		{Username: "user2", Amount: types.NewCoinFromInt64(20)},
+	}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ds.GetRewardPool(env.ctx, developer)
+		assert.Equal(t, ErrRewardPoolNotFound(), err)
+
+		err = env.ds.SetRewardPool(env.ctx, developer, &pool)
+		assert.Nil(t, err)
+		resultPtr, err := env.ds.GetRewardPool(env.ctx, developer)
+		assert.Nil(t, err)
+		assert.True(t, pool.ShareRatio.Equal(resultPtr.ShareRatio))
+		assert.Equal(t, pool.Balance, resultPtr.Balance)
+		assert.Equal(t, pool.TotalDonation, resultPtr.TotalDonation)
+
+		donation, err := env.ds.GetUserDonation(env.ctx, developer, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, UserDonation{Username: "user1", Amount: types.NewCoinFromInt64(0)}, *donation)
+
+		for _, d := range donations {
+			err = env.ds.SetUserDonation(env.ctx, developer, &d)
+			assert.Nil(t, err)
+		}
+		donation, err = env.ds.GetUserDonation(env.ctx, developer, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, donations[0], *donation)
+		result, err := env.ds.GetUserDonations(env.ctx, developer)
+		assert.Nil(t, err)
+		assert.Equal(t, donations, result)
+
+		err = env.ds.DeleteUserDonation(env.ctx, developer, "user1")
+		assert.Nil(t, err)
+		result, err = env.ds.GetUserDonations(env.ctx, developer)
+		assert.Nil(t, err)
+		assert.Equal(t, donations[1:], result)
+
+		err = env.ds.DeleteRewardPool(env.ctx, developer)
+		assert.Nil(t, err)
+		_, err = env.ds.GetRewardPool(env.ctx, developer)
+		assert.Equal(t, ErrRewardPoolNotFound(), err)
+	})
+}
+
 //
 // Test Environment setup
 //
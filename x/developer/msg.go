@@ -3,9 +3,11 @@ package developer
 // nolint
 import (
 	"fmt"
+	"regexp"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,6 +18,12 @@ var _ types.Msg = DeveloperRevokeMsg{}
 var _ types.Msg = GrantPermissionMsg{}
 var _ types.Msg = RevokePermissionMsg{}
 var _ types.Msg = PreAuthorizationMsg{}
+var _ types.Msg = SponsorRegisterMsg{}
+var _ types.Msg = GrantFeeMsg{}
+var _ types.Msg = RevokeFeeGrantMsg{}
+var _ types.Msg = SetDeveloperAdminMsg{}
+var _ types.Msg = RemoveDeveloperAdminMsg{}
+var _ types.Msg = SetRewardPoolShareMsg{}
 
 // DeveloperRegisterMsg - register developer on blockchain
 type DeveloperRegisterMsg struct {
@@ -32,11 +40,15 @@ type DeveloperUpdateMsg struct {
 	Website     string           `json:"website"`
 	Description string           `json:"description"`
 	AppMetaData string           `json:"app_meta_data"`
+	// optional, metadata admin signs on behalf of developer
+	Admin types.AccountKey `json:"admin,omitempty"`
 }
 
 // DeveloperRevokeMsg - revoke developer on blockchain
 type DeveloperRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
+	// optional, finance admin signs on behalf of developer
+	Admin types.AccountKey `json:"admin,omitempty"`
 }
 
 // GrantPermissionMsg - user grant permission to app
@@ -63,6 +75,55 @@ type PreAuthorizationMsg struct {
 	Amount            types.LNO        `json:"amount"`
 }
 
+// SponsorRegisterMsg - developer registers new user, register fee is paid from developer deposit
+type SponsorRegisterMsg struct {
+	Developer            types.AccountKey `json:"developer"`
+	RegisterFee          types.LNO        `json:"register_fee"`
+	NewUser              types.AccountKey `json:"new_username"`
+	NewResetPubKey       crypto.PubKey    `json:"new_reset_public_key"`
+	NewTransactionPubKey crypto.PubKey    `json:"new_transaction_public_key"`
+	NewAppPubKey         crypto.PubKey    `json:"new_app_public_key"`
+}
+
+// GrantFeeMsg - developer covers TPS capacity of user when user's own capacity is not enough
+type GrantFeeMsg struct {
+	Developer         types.AccountKey `json:"developer"`
+	Username          types.AccountKey `json:"username"`
+	Limit             types.LNO        `json:"limit"`
+	ValidityPeriodSec int64            `json:"validity_period_second"`
+	// optional, grants admin signs on behalf of developer
+	Admin types.AccountKey `json:"admin,omitempty"`
+}
+
+// RevokeFeeGrantMsg - developer revokes fee grant of user
+type RevokeFeeGrantMsg struct {
+	Developer types.AccountKey `json:"developer"`
+	Username  types.AccountKey `json:"username"`
+	// optional, grants admin signs on behalf of developer
+	Admin types.AccountKey `json:"admin,omitempty"`
+}
+
+// SetDeveloperAdminMsg - developer adds admin or replaces roles of existing admin
+type SetDeveloperAdminMsg struct {
+	Developer types.AccountKey  `json:"developer"`
+	Admin     types.AccountKey  `json:"admin"`
+	Roles     []types.AdminRole `json:"roles"`
+}
+
+// RemoveDeveloperAdminMsg - developer removes admin
+type RemoveDeveloperAdminMsg struct {
+	Developer types.AccountKey `json:"developer"`
+	Admin     types.AccountKey `json:"admin"`
+}
+
+// SetRewardPoolShareMsg - developer sets share of its inflation paid to users donating through app
+type SetRewardPoolShareMsg struct {
+	Developer  types.AccountKey `json:"developer"`
+	ShareRatio string           `json:"share_ratio"`
+	// optional, finance admin signs on behalf of developer
+	Admin types.AccountKey `json:"admin,omitempty"`
+}
+
 // DeveloperRegisterMsg Msg Implementations
 func NewDeveloperRegisterMsg(developer string, deposit types.LNO, website string, description string, appMetaData string) DeveloperRegisterMsg {
 	return DeveloperRegisterMsg{
@@ -165,13 +226,16 @@ func (msg DeveloperUpdateMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.AppMetaData) > types.MaximumLengthOfAppMetadata {
 		return ErrInvalidAppMetadata()
 	}
+	if !isValidAdmin(msg.Admin) {
+		return ErrInvalidUsername()
+	}
 	return nil
 }
 
 func (msg DeveloperUpdateMsg) String() string {
 	return fmt.Sprintf(
-		"DeveloperUpdateMsg{Username:%v, Website:%v, Description:%v, Metadata:%v}",
-		msg.Username, msg.Website, msg.Description, msg.AppMetaData)
+		"DeveloperUpdateMsg{Username:%v, Website:%v, Description:%v, Metadata:%v, Admin:%v}",
+		msg.Username, msg.Website, msg.Description, msg.AppMetaData, msg.Admin)
 }
 
 func (msg DeveloperUpdateMsg) GetPermission() types.Permission {
@@ -188,7 +252,7 @@ func (msg DeveloperUpdateMsg) GetSignBytes() []byte {
 
 // GetSigners - implements sdk.Msg
 func (msg DeveloperUpdateMsg) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+	return []sdk.AccAddress{getSigner(msg.Username, msg.Admin)}
 }
 
 // GetConsumeAmount - implements types.Msg
@@ -211,14 +275,15 @@ func (msg DeveloperRevokeMsg) Type() string { return "DeveloperRevokeMsg" }
 
 func (msg DeveloperRevokeMsg) ValidateBasic() sdk.Error {
 	if len(msg.Username) < types.MinimumUsernameLength ||
-		len(msg.Username) > types.MaximumUsernameLength {
+		len(msg.Username) > types.MaximumUsernameLength ||
+		!isValidAdmin(msg.Admin) {
 		return ErrInvalidUsername()
 	}
 	return nil
 }
 
 func (msg DeveloperRevokeMsg) String() string {
-	return fmt.Sprintf("DeveloperRevokeMsg{Username:%v}", msg.Username)
+	return fmt.Sprintf("DeveloperRevokeMsg{Username:%v, Admin:%v}", msg.Username, msg.Admin)
 }
 
 func (msg DeveloperRevokeMsg) GetPermission() types.Permission {
@@ -236,7 +301,7 @@ func (msg DeveloperRevokeMsg) GetSignBytes() []byte {
 
 // GetSigners - implements sdk.Msg
 func (msg DeveloperRevokeMsg) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+	return []sdk.AccAddress{getSigner(msg.Username, msg.Admin)}
 }
 
 // GetConsumeAmount - implements types.Msg
@@ -456,3 +521,388 @@ func (msg PreAuthorizationMsg) GetSigners() []sdk.AccAddress {
 func (msg PreAuthorizationMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// SponsorRegister Msg Implementations
+func NewSponsorRegisterMsg(
+	developer string, newUser string, registerFee types.LNO,
+	resetPubkey, transactionPubkey, appPubkey crypto.PubKey) SponsorRegisterMsg {
+	return SponsorRegisterMsg{
+		Developer:            types.AccountKey(developer),
+		RegisterFee:          registerFee,
+		NewUser:              types.AccountKey(newUser),
+		NewResetPubKey:       resetPubkey,
+		NewTransactionPubKey: transactionPubkey,
+		NewAppPubKey:         appPubkey,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SponsorRegisterMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SponsorRegisterMsg) Type() string { return "SponsorRegisterMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SponsorRegisterMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.NewUser) < types.MinimumUsernameLength ||
+		len(msg.NewUser) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	match, err := regexp.MatchString(types.UsernameReCheck, string(msg.NewUser))
+	if err != nil || !match {
+		return ErrInvalidUsername()
+	}
+	match, err = regexp.MatchString(types.IllegalUsernameReCheck, string(msg.NewUser))
+	if err != nil || match {
+		return ErrInvalidUsername()
+	}
+
+	_, coinErr := types.LinoToCoin(msg.RegisterFee)
+	if coinErr != nil {
+		return coinErr
+	}
+	return nil
+}
+
+func (msg SponsorRegisterMsg) String() string {
+	return fmt.Sprintf("SponsorRegisterMsg{Developer:%v, NewUser:%v, RegisterFee:%v}",
+		msg.Developer, msg.NewUser, msg.RegisterFee)
+}
+
+func (msg SponsorRegisterMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SponsorRegisterMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SponsorRegisterMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Developer)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SponsorRegisterMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GrantFee Msg Implementations
+func NewGrantFeeMsg(developer, username string, limit types.LNO, validityPeriodSec int64) GrantFeeMsg {
+	return GrantFeeMsg{
+		Developer:         types.AccountKey(developer),
+		Username:          types.AccountKey(username),
+		Limit:             limit,
+		ValidityPeriodSec: validityPeriodSec,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg GrantFeeMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg GrantFeeMsg) Type() string { return "GrantFeeMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg GrantFeeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength ||
+		!isValidAdmin(msg.Admin) {
+		return ErrInvalidUsername()
+	}
+
+	if msg.ValidityPeriodSec <= 0 ||
+		msg.ValidityPeriodSec > types.MaxGranPermValiditySec {
+		return ErrInvalidValidityPeriod()
+	}
+
+	limit, err := types.LinoToCoin(msg.Limit)
+	if err != nil {
+		return err
+	}
+	if !limit.IsPositive() {
+		return ErrInvalidFeeGrantLimit()
+	}
+	return nil
+}
+
+func (msg GrantFeeMsg) String() string {
+	return fmt.Sprintf("GrantFeeMsg{Developer:%v, User:%v, Limit:%v, Validate Period:%v, Admin:%v}",
+		msg.Developer, msg.Username, msg.Limit, msg.ValidityPeriodSec, msg.Admin)
+}
+
+func (msg GrantFeeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg GrantFeeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg GrantFeeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{getSigner(msg.Developer, msg.Admin)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg GrantFeeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// RevokeFeeGrant Msg Implementations
+func NewRevokeFeeGrantMsg(developer, username string) RevokeFeeGrantMsg {
+	return RevokeFeeGrantMsg{
+		Developer: types.AccountKey(developer),
+		Username:  types.AccountKey(username),
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg RevokeFeeGrantMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RevokeFeeGrantMsg) Type() string { return "RevokeFeeGrantMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RevokeFeeGrantMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength ||
+		!isValidAdmin(msg.Admin) {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg RevokeFeeGrantMsg) String() string {
+	return fmt.Sprintf("RevokeFeeGrantMsg{Developer:%v, User:%v, Admin:%v}", msg.Developer, msg.Username, msg.Admin)
+}
+
+func (msg RevokeFeeGrantMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RevokeFeeGrantMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RevokeFeeGrantMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{getSigner(msg.Developer, msg.Admin)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg RevokeFeeGrantMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// SetDeveloperAdmin Msg Implementations
+func NewSetDeveloperAdminMsg(developer, admin string, roles []types.AdminRole) SetDeveloperAdminMsg {
+	return SetDeveloperAdminMsg{
+		Developer: types.AccountKey(developer),
+		Admin:     types.AccountKey(admin),
+		Roles:     roles,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetDeveloperAdminMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetDeveloperAdminMsg) Type() string { return "SetDeveloperAdminMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetDeveloperAdminMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.Admin) < types.MinimumUsernameLength ||
+		len(msg.Admin) > types.MaximumUsernameLength ||
+		msg.Admin == msg.Developer {
+		return ErrInvalidUsername()
+	}
+
+	if len(msg.Roles) == 0 {
+		return ErrInvalidAdminRole()
+	}
+	seen := map[types.AdminRole]bool{}
+	for _, role := range msg.Roles {
+		if role < types.MetadataAdmin || role > types.GrantsAdmin || seen[role] {
+			return ErrInvalidAdminRole()
+		}
+		seen[role] = true
+	}
+	return nil
+}
+
+func (msg SetDeveloperAdminMsg) String() string {
+	return fmt.Sprintf("SetDeveloperAdminMsg{Developer:%v, Admin:%v, Roles:%v}", msg.Developer, msg.Admin, msg.Roles)
+}
+
+func (msg SetDeveloperAdminMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetDeveloperAdminMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetDeveloperAdminMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Developer)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetDeveloperAdminMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// RemoveDeveloperAdmin Msg Implementations
+func NewRemoveDeveloperAdminMsg(developer, admin string) RemoveDeveloperAdminMsg {
+	return RemoveDeveloperAdminMsg{
+		Developer: types.AccountKey(developer),
+		Admin:     types.AccountKey(admin),
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg RemoveDeveloperAdminMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RemoveDeveloperAdminMsg) Type() string { return "RemoveDeveloperAdminMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RemoveDeveloperAdminMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.Admin) < types.MinimumUsernameLength ||
+		len(msg.Admin) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg RemoveDeveloperAdminMsg) String() string {
+	return fmt.Sprintf("RemoveDeveloperAdminMsg{Developer:%v, Admin:%v}", msg.Developer, msg.Admin)
+}
+
+func (msg RemoveDeveloperAdminMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RemoveDeveloperAdminMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RemoveDeveloperAdminMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Developer)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg RemoveDeveloperAdminMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// SetRewardPoolShare Msg Implementations
+func NewSetRewardPoolShareMsg(developer, shareRatio string) SetRewardPoolShareMsg {
+	return SetRewardPoolShareMsg{
+		Developer:  types.AccountKey(developer),
+		ShareRatio: shareRatio,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetRewardPoolShareMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetRewardPoolShareMsg) Type() string { return "SetRewardPoolShareMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetRewardPoolShareMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		!isValidAdmin(msg.Admin) {
+		return ErrInvalidUsername()
+	}
+	share, err := sdk.NewDecFromStr(msg.ShareRatio)
+	if err != nil {
+		return ErrInvalidRewardPoolShare()
+	}
+	if share.LT(sdk.ZeroDec()) || share.GT(sdk.OneDec()) {
+		return ErrInvalidRewardPoolShare()
+	}
+	return nil
+}
+
+func (msg SetRewardPoolShareMsg) String() string {
+	return fmt.Sprintf("SetRewardPoolShareMsg{Developer:%v, ShareRatio:%v, Admin:%v}",
+		msg.Developer, msg.ShareRatio, msg.Admin)
+}
+
+func (msg SetRewardPoolShareMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetRewardPoolShareMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetRewardPoolShareMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{getSigner(msg.Developer, msg.Admin)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetRewardPoolShareMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// admin is optional, empty admin means developer signs by itself
+func isValidAdmin(admin types.AccountKey) bool {
+	return len(admin) == 0 ||
+		(len(admin) >= types.MinimumUsernameLength && len(admin) <= types.MaximumUsernameLength)
+}
+
+// admin signs instead of developer if present
+func getSigner(developer, admin types.AccountKey) sdk.AccAddress {
+	if len(admin) != 0 {
+		return sdk.AccAddress(admin)
+	}
+	return sdk.AccAddress(developer)
+}
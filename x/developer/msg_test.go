@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 var (
@@ -196,6 +197,16 @@ func TestDeveloperRevokeMsg(t *testing.T) {
 			developerRevokeMsg: NewDeveloperRevokeMsg(""),
 			expectError:        ErrInvalidUsername(),
 		},
+		{
+			testName:           "signed by admin",
+			developerRevokeMsg: DeveloperRevokeMsg{Username: "user1", Admin: "admin1"},
+			expectError:        nil,
+		},
+		{
+			testName:           "invalid admin",
+			developerRevokeMsg: DeveloperRevokeMsg{Username: "user1", Admin: "a"},
+			expectError:        ErrInvalidUsername(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -388,6 +399,262 @@ func TestPreAuthorizationMsgMsg(t *testing.T) {
 	}
 }
 
+func TestSponsorRegisterMsg(t *testing.T) {
+	resetKey := secp256k1.GenPrivKey().PubKey()
+	txKey := secp256k1.GenPrivKey().PubKey()
+	appKey := secp256k1.GenPrivKey().PubKey()
+	testCases := []struct {
+		testName           string
+		sponsorRegisterMsg SponsorRegisterMsg
+		expectError        sdk.Error
+	}{
+		{
+			testName:           "normal sponsored registration",
+			sponsorRegisterMsg: NewSponsorRegisterMsg("app", "newuser", "1", resetKey, txKey, appKey),
+			expectError:        nil,
+		},
+		{
+			testName:           "developer name is too short",
+			sponsorRegisterMsg: NewSponsorRegisterMsg("ap", "newuser", "1", resetKey, txKey, appKey),
+			expectError:        ErrInvalidUsername(),
+		},
+		{
+			testName:           "new username is too long",
+			sponsorRegisterMsg: NewSponsorRegisterMsg("app", "newusernewusernewusernew", "1", resetKey, txKey, appKey),
+			expectError:        ErrInvalidUsername(),
+		},
+		{
+			testName:           "new username with illegal character",
+			sponsorRegisterMsg: NewSponsorRegisterMsg("app", "new@user", "1", resetKey, txKey, appKey),
+			expectError:        ErrInvalidUsername(),
+		},
+		{
+			testName:           "illegal LNO",
+			sponsorRegisterMsg: NewSponsorRegisterMsg("app", "newuser", "*", resetKey, txKey, appKey),
+			expectError:        types.ErrInvalidCoins("Illegal LNO"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.sponsorRegisterMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestGrantFeeMsg(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		grantFeeMsg GrantFeeMsg
+		expectError sdk.Error
+	}{
+		{
+			testName:    "normal fee grant",
+			grantFeeMsg: NewGrantFeeMsg("app", "user1", "1", 1000),
+			expectError: nil,
+		},
+		{
+			testName:    "developer name is too short",
+			grantFeeMsg: NewGrantFeeMsg("ap", "user1", "1", 1000),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "username is too long",
+			grantFeeMsg: NewGrantFeeMsg("app", "user1user1user1user1user1", "1", 1000),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "invalid validity second",
+			grantFeeMsg: NewGrantFeeMsg("app", "user1", "1", 0),
+			expectError: ErrInvalidValidityPeriod(),
+		},
+		{
+			testName:    "illegal LNO",
+			grantFeeMsg: NewGrantFeeMsg("app", "user1", "*", 1000),
+			expectError: types.ErrInvalidCoins("Illegal LNO"),
+		},
+		{
+			testName:    "zero limit",
+			grantFeeMsg: NewGrantFeeMsg("app", "user1", "0", 1000),
+			expectError: ErrInvalidFeeGrantLimit(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.grantFeeMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestRevokeFeeGrantMsg(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		revokeFeeGrantMsg RevokeFeeGrantMsg
+		expectError       sdk.Error
+	}{
+		{
+			testName:          "normal revoke fee grant",
+			revokeFeeGrantMsg: NewRevokeFeeGrantMsg("app", "user1"),
+			expectError:       nil,
+		},
+		{
+			testName:          "developer name is too short",
+			revokeFeeGrantMsg: NewRevokeFeeGrantMsg("ap", "user1"),
+			expectError:       ErrInvalidUsername(),
+		},
+		{
+			testName:          "username is too long",
+			revokeFeeGrantMsg: NewRevokeFeeGrantMsg("app", "user1user1user1user1user1"),
+			expectError:       ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.revokeFeeGrantMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestSetDeveloperAdminMsg(t *testing.T) {
+	testCases := []struct {
+		testName             string
+		setDeveloperAdminMsg SetDeveloperAdminMsg
+		expectError          sdk.Error
+	}{
+		{
+			testName: "normal case",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg(
+				"app", "admin1", []types.AdminRole{types.MetadataAdmin, types.FinanceAdmin, types.GrantsAdmin}),
+			expectError: nil,
+		},
+		{
+			testName:             "invalid developer",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg("", "admin1", []types.AdminRole{types.MetadataAdmin}),
+			expectError:          ErrInvalidUsername(),
+		},
+		{
+			testName:             "invalid admin",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg("app", "", []types.AdminRole{types.MetadataAdmin}),
+			expectError:          ErrInvalidUsername(),
+		},
+		{
+			testName:             "developer can't be its own admin",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg("app", "app", []types.AdminRole{types.MetadataAdmin}),
+			expectError:          ErrInvalidUsername(),
+		},
+		{
+			testName:             "no role",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg("app", "admin1", []types.AdminRole{}),
+			expectError:          ErrInvalidAdminRole(),
+		},
+		{
+			testName:             "unknown role",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg("app", "admin1", []types.AdminRole{types.AdminRole(3)}),
+			expectError:          ErrInvalidAdminRole(),
+		},
+		{
+			testName: "duplicate role",
+			setDeveloperAdminMsg: NewSetDeveloperAdminMsg(
+				"app", "admin1", []types.AdminRole{types.FinanceAdmin, types.FinanceAdmin}),
+			expectError: ErrInvalidAdminRole(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.setDeveloperAdminMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestRemoveDeveloperAdminMsg(t *testing.T) {
+	testCases := []struct {
+		testName                string
+		removeDeveloperAdminMsg RemoveDeveloperAdminMsg
+		expectError             sdk.Error
+	}{
+		{
+			testName:                "normal case",
+			removeDeveloperAdminMsg: NewRemoveDeveloperAdminMsg("app", "admin1"),
+			expectError:             nil,
+		},
+		{
+			testName:                "invalid admin",
+			removeDeveloperAdminMsg: NewRemoveDeveloperAdminMsg("app", ""),
+			expectError:             ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.removeDeveloperAdminMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestSetRewardPoolShareMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		setRewardPoolShareMsg SetRewardPoolShareMsg
+		expectError           sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "0.25"),
+			expectError:           nil,
+		},
+		{
+			testName:              "zero share",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "0"),
+			expectError:           nil,
+		},
+		{
+			testName:              "full share",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "1"),
+			expectError:           nil,
+		},
+		{
+			testName:              "invalid developer",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("", "0.25"),
+			expectError:           ErrInvalidUsername(),
+		},
+		{
+			testName:              "invalid admin",
+			setRewardPoolShareMsg: SetRewardPoolShareMsg{Developer: "app", ShareRatio: "0.25", Admin: "a"},
+			expectError:           ErrInvalidUsername(),
+		},
+		{
+			testName:              "share is not a number",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "quarter"),
+			expectError:           ErrInvalidRewardPoolShare(),
+		},
+		{
+			testName:              "negative share",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "-0.1"),
+			expectError:           ErrInvalidRewardPoolShare(),
+		},
+		{
+			testName:              "share larger than one",
+			setRewardPoolShareMsg: NewSetRewardPoolShareMsg("app", "1.1"),
+			expectError:           ErrInvalidRewardPoolShare(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.setRewardPoolShareMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -444,6 +711,36 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewPreAuthorizationMsg("test", "app", 1000, "1"),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName:         "sponsor register msg",
+			msg:              NewSponsorRegisterMsg("test", "newuser", "1", nil, nil, nil),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "grant fee msg",
+			msg:              NewGrantFeeMsg("test", "user1", "1", 1000),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "revoke fee grant msg",
+			msg:              NewRevokeFeeGrantMsg("test", "user1"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "set developer admin msg",
+			msg:              NewSetDeveloperAdminMsg("test", "admin1", []types.AdminRole{types.MetadataAdmin}),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "remove developer admin msg",
+			msg:              NewRemoveDeveloperAdminMsg("test", "admin1"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "set reward pool share msg",
+			msg:              NewSetRewardPoolShareMsg("test", "0.25"),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -492,6 +789,61 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewPreAuthorizationMsg("test", "app", 1000, "1"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "sponsor register msg",
+			msg:           NewSponsorRegisterMsg("test", "newuser", "1", nil, nil, nil),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "grant fee msg",
+			msg:           NewGrantFeeMsg("test", "user1", "1", 1000),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "revoke fee grant msg",
+			msg:           NewRevokeFeeGrantMsg("test", "user1"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "developer update msg signed by admin",
+			msg:           DeveloperUpdateMsg{Username: "test", Admin: "admin1"},
+			expectSigners: []types.AccountKey{"admin1"},
+		},
+		{
+			testName:      "developer revoke msg signed by admin",
+			msg:           DeveloperRevokeMsg{Username: "test", Admin: "admin1"},
+			expectSigners: []types.AccountKey{"admin1"},
+		},
+		{
+			testName:      "grant fee msg signed by admin",
+			msg:           GrantFeeMsg{Developer: "test", Username: "user1", Admin: "admin1"},
+			expectSigners: []types.AccountKey{"admin1"},
+		},
+		{
+			testName:      "revoke fee grant msg signed by admin",
+			msg:           RevokeFeeGrantMsg{Developer: "test", Username: "user1", Admin: "admin1"},
+			expectSigners: []types.AccountKey{"admin1"},
+		},
+		{
+			testName:      "set developer admin msg",
+			msg:           NewSetDeveloperAdminMsg("test", "admin1", []types.AdminRole{types.MetadataAdmin}),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "remove developer admin msg",
+			msg:           NewRemoveDeveloperAdminMsg("test", "admin1"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "set reward pool share msg",
+			msg:           NewSetRewardPoolShareMsg("test", "0.25"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "set reward pool share msg signed by admin",
+			msg:           SetRewardPoolShareMsg{Developer: "test", ShareRatio: "0.25", Admin: "admin1"},
+			expectSigners: []types.AccountKey{"admin1"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -537,6 +889,32 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "preauth msg",
 			msg:      NewPreAuthorizationMsg("test", "app", 1000, "1"),
 		},
+		{
+			testName: "sponsor register msg",
+			msg: NewSponsorRegisterMsg(
+				"test", "newuser", "1", secp256k1.GenPrivKey().PubKey(),
+				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+		},
+		{
+			testName: "grant fee msg",
+			msg:      NewGrantFeeMsg("test", "user1", "1", 1000),
+		},
+		{
+			testName: "revoke fee grant msg",
+			msg:      NewRevokeFeeGrantMsg("test", "user1"),
+		},
+		{
+			testName: "set developer admin msg",
+			msg:      NewSetDeveloperAdminMsg("test", "admin1", []types.AdminRole{types.MetadataAdmin}),
+		},
+		{
+			testName: "remove developer admin msg",
+			msg:      NewRemoveDeveloperAdminMsg("test", "admin1"),
+		},
+		{
+			testName: "set reward pool share msg",
+			msg:      NewSetRewardPoolShareMsg("test", "0.25"),
+		},
 	}
 
 	for _, tc := range testCases {
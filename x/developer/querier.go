@@ -20,6 +20,11 @@ const (
 
 	QueryDeveloper     = "dev"
 	QueryDeveloperList = "devList"
+	QueryFeeGrants     = "feeGrants"
+	QueryStatements    = "statements"
+	QueryAdmins        = "admins"
+	QueryRewardPool    = "rewardPool"
+	QueryPendingPayout = "pendingPayout"
 )
 
 // creates a querier for developer REST endpoints
@@ -32,6 +37,16 @@ func NewQuerier(dm DeveloperManager) sdk.Querier {
 			return queryDeveloper(ctx, cdc, path[1:], req, dm)
 		case QueryDeveloperList:
 			return queryDeveloperList(ctx, cdc, path[1:], req, dm)
+		case QueryFeeGrants:
+			return queryFeeGrants(ctx, cdc, path[1:], req, dm)
+		case QueryStatements:
+			return queryStatements(ctx, cdc, path[1:], req, dm)
+		case QueryAdmins:
+			return queryAdmins(ctx, cdc, path[1:], req, dm)
+		case QueryRewardPool:
+			return queryRewardPool(ctx, cdc, path[1:], req, dm)
+		case QueryPendingPayout:
+			return queryPendingPayout(ctx, cdc, path[1:], req, dm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown developer query endpoint")
 		}
@@ -72,3 +87,78 @@ func queryDeveloperList(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryFeeGrants(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	grants, err := dm.GetFeeGrants(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(grants)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryStatements(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	statements, err := dm.GetMonthlyStatements(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(statements)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryAdmins(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	admins, err := dm.GetDeveloperAdmins(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(admins)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryRewardPool(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	pool, err := dm.GetRewardPool(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(pool)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryPendingPayout(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	payout, err := dm.GetPendingPayout(ctx, types.AccountKey(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(payout)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
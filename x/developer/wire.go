@@ -12,6 +12,12 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(GrantPermissionMsg{}, "lino/grantPermission", nil)
 	cdc.RegisterConcrete(RevokePermissionMsg{}, "lino/revokePermission", nil)
 	cdc.RegisterConcrete(PreAuthorizationMsg{}, "lino/preAuthorizationPermission", nil)
+	cdc.RegisterConcrete(SponsorRegisterMsg{}, "lino/sponsorRegister", nil)
+	cdc.RegisterConcrete(GrantFeeMsg{}, "lino/grantFee", nil)
+	cdc.RegisterConcrete(RevokeFeeGrantMsg{}, "lino/revokeFeeGrant", nil)
+	cdc.RegisterConcrete(SetDeveloperAdminMsg{}, "lino/setDeveloperAdmin", nil)
+	cdc.RegisterConcrete(RemoveDeveloperAdminMsg{}, "lino/removeDeveloperAdmin", nil)
+	cdc.RegisterConcrete(SetRewardPoolShareMsg{}, "lino/setRewardPoolShare", nil)
 }
 
 var msgCdc = wire.New()
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global/model"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+)
+
+// GetCoinReturnsCmd returns the coins which will be returned to user in the future
+func GetCoinReturnsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "coin-returns <username>",
+		Short: "Query pending coin returns of a user",
+		RunE:  cmdr.getCoinReturnsCmd,
+	}
+}
+
+// GetCommunityPoolCmd returns the balance and spend history of community pool
+func GetCommunityPoolCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "community-pool",
+		Short: "Query community pool balance and spend history",
+		RunE:  cmdr.getCommunityPoolCmd,
+	}
+}
+
+// GetUpgradePlanCmd returns the pending upgrade plan scheduled by protocol upgrade proposal
+func GetUpgradePlanCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "upgrade-plan",
+		Short: "Query pending upgrade plan",
+		RunE:  cmdr.getUpgradePlanCmd,
+	}
+}
+
+type commander struct {
+	storeName string
+	cdc       *wire.Codec
+}
+
+func (c commander) getCoinReturnsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+	username := types.AccountKey(args[0])
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetPendingCoinReturnPrefix(username), c.storeName)
+	if err != nil {
+		return err
+	}
+	coinReturns := []model.PendingCoinReturn{}
+	for _, KV := range resKVs {
+		var lst []model.PendingCoinReturn
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(KV.Value, &lst); err != nil {
+			return err
+		}
+		coinReturns = append(coinReturns, lst...)
+	}
+
+	if err := client.PrintIndent(coinReturns); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getCommunityPoolCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+
+	pool := model.CommunityPool{Balance: types.NewCoinFromInt64(0)}
+	res, err := ctx.Query(model.GetCommunityPoolKey(), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, &pool); err != nil {
+			return err
+		}
+	}
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetCommunityPoolSpendPrefix(), c.storeName)
+	if err != nil {
+		return err
+	}
+	spends := []model.CommunityPoolSpend{}
+	for _, KV := range resKVs {
+		var spend model.CommunityPoolSpend
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(KV.Value, &spend); err != nil {
+			return err
+		}
+		spends = append(spends, spend)
+	}
+
+	output := struct {
+		Balance types.Coin                 `json:"balance"`
+		Spends  []model.CommunityPoolSpend `json:"spends"`
+	}{
+		Balance: pool.Balance,
+		Spends:  spends,
+	}
+	if err := client.PrintIndent(output); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getUpgradePlanCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+
+	res, err := ctx.Query(model.GetUpgradePlanKey(), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		return errors.New("No pending upgrade plan")
+	}
+	plan := new(model.UpgradePlan)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, plan); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(plan); err != nil {
+		return err
+	}
+	return nil
+}
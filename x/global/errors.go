@@ -22,6 +22,21 @@ func ErrParseEventCacheList() sdk.Error {
 	return types.NewError(types.CodeFailedToParseEventCacheList, "parse event list failed")
 }
 
+// ErrCensorshipEscalationPoolNotEnough - error if censorship escalation pool can't fund the deposit
+func ErrCensorshipEscalationPoolNotEnough() sdk.Error {
+	return types.NewError(types.CodeCensorshipEscalationPoolNotEnough, fmt.Sprintf("censorship escalation pool is not enough"))
+}
+
+// ErrCommunityPoolNotEnough - error if community pool can't afford the spend
+func ErrCommunityPoolNotEnough() sdk.Error {
+	return types.NewError(types.CodeCommunityPoolNotEnough, fmt.Sprintf("community pool is not enough"))
+}
+
+// ErrUpgradePlanAlreadyExist - error if schedule upgrade while another upgrade plan is pending
+func ErrUpgradePlanAlreadyExist() sdk.Error {
+	return types.NewError(types.CodeUpgradePlanAlreadyExist, fmt.Sprintf("upgrade plan already exists"))
+}
+
 // ErrQueryFailed - error when query global store failed
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeGlobalQueryFailed, fmt.Sprintf("query global store failed"))
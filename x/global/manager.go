@@ -148,9 +148,29 @@ func (gm *GlobalManager) SetPastMinutes(ctx sdk.Context, minutes int64) sdk.Erro
 
 // RemoveTimeEventList - remove time event list from KVstore at given time
 func (gm *GlobalManager) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk.Error {
+	eventList, err := gm.storage.GetTimeEventList(ctx, unixTime)
+	if err != nil {
+		return err
+	}
+	if eventList != nil {
+		for _, event := range eventList.Events {
+			if coinReturn, ok := event.(types.CoinReturnEvent); ok {
+				if err := gm.storage.RemovePendingCoinReturns(
+					ctx, coinReturn.GetUsername(), unixTime); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return gm.storage.RemoveTimeEventList(ctx, unixTime)
 }
 
+// GetPendingCoinReturns - get all coins which will be returned to user in the future
+func (gm *GlobalManager) GetPendingCoinReturns(
+	ctx sdk.Context, username types.AccountKey) ([]model.PendingCoinReturn, sdk.Error) {
+	return gm.storage.GetAllPendingCoinReturns(ctx, username)
+}
+
 // GetConsumptionFrictionRate - get consumption friction rate
 func (gm *GlobalManager) GetConsumptionFrictionRate(ctx sdk.Context) (sdk.Dec, sdk.Error) {
 	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
@@ -238,19 +258,59 @@ func (gm *GlobalManager) MinusLinoStakeFromStat(ctx sdk.Context, linoStake types
 
 // GetInterestSince - get interest from unix time till now (exclusive)
 func (gm *GlobalManager) GetInterestSince(ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, sdk.Error) {
-	startDay, err := gm.GetPastDay(ctx, unixTime)
+	totalInterest, dailyInterests, err := gm.calculateInterestSince(ctx, unixTime, linoStake)
 	if err != nil {
 		return types.NewCoinFromInt64(0), err
 	}
-	endDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
+	// claim interest from lino stake statistic of each day
+	for _, dailyInterest := range dailyInterests {
+		linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, dailyInterest.day)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		linoStakeStat.UnclaimedFriction = linoStakeStat.UnclaimedFriction.Minus(dailyInterest.interest)
+		linoStakeStat.UnclaimedLinoStake = linoStakeStat.UnclaimedLinoStake.Minus(linoStake)
+		if err := gm.storage.SetLinoStakeStat(ctx, dailyInterest.day, linoStakeStat); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+	}
+	return totalInterest, nil
+}
+
+// EstimateInterestSince - estimate interest from unix time till now (exclusive),
+// same as GetInterestSince but doesn't claim interest from lino stake statistic
+func (gm *GlobalManager) EstimateInterestSince(ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, sdk.Error) {
+	totalInterest, _, err := gm.calculateInterestSince(ctx, unixTime, linoStake)
 	if err != nil {
 		return types.NewCoinFromInt64(0), err
 	}
+	return totalInterest, nil
+}
+
+// dailyInterest - interest of lino stake in a past day
+type dailyInterest struct {
+	day      int64
+	interest types.Coin
+}
+
+// calculateInterestSince - calculate interest of each past day from unix time till now (exclusive),
+// days without unclaimed lino stake are skipped and lino stake statistic is not changed
+func (gm *GlobalManager) calculateInterestSince(
+	ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, []dailyInterest, sdk.Error) {
+	startDay, err := gm.GetPastDay(ctx, unixTime)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	endDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
 	totalInterest := types.NewCoinFromInt64(0)
+	dailyInterests := []dailyInterest{}
 	for day := startDay; day < endDay; day++ {
 		linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, day)
 		if err != nil {
-			return types.NewCoinFromInt64(0), err
+			return types.NewCoinFromInt64(0), nil, err
 		}
 		if linoStakeStat.UnclaimedLinoStake.IsZero() {
 			continue
@@ -259,13 +319,40 @@ func (gm *GlobalManager) GetInterestSince(ctx sdk.Context, unixTime int64, linoS
 			types.DecToCoin(linoStakeStat.UnclaimedFriction.ToDec().Mul(
 				linoStake.ToDec().Quo(linoStakeStat.UnclaimedLinoStake.ToDec())))
 		totalInterest = totalInterest.Plus(interest)
-		linoStakeStat.UnclaimedFriction = linoStakeStat.UnclaimedFriction.Minus(interest)
-		linoStakeStat.UnclaimedLinoStake = linoStakeStat.UnclaimedLinoStake.Minus(linoStake)
-		if err := gm.storage.SetLinoStakeStat(ctx, day, linoStakeStat); err != nil {
-			return types.NewCoinFromInt64(0), err
+		dailyInterests = append(dailyInterests, dailyInterest{day: day, interest: interest})
+	}
+	return totalInterest, dailyInterests, nil
+}
+
+// GetStakeAPR - get annual percentage rate of lino stake interest,
+// averaged over the trailing complete days
+func (gm *GlobalManager) GetStakeAPR(ctx sdk.Context, days int64) (sdk.Dec, sdk.Error) {
+	endDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	startDay := endDay - days
+	if startDay < 0 {
+		startDay = 0
+	}
+	if startDay >= endDay {
+		return sdk.ZeroDec(), nil
+	}
+	totalDailyRate := sdk.ZeroDec()
+	for day := startDay; day < endDay; day++ {
+		linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, day)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		if linoStakeStat.TotalLinoStake.IsZero() {
+			continue
 		}
+		totalDailyRate = totalDailyRate.Add(
+			linoStakeStat.TotalConsumptionFriction.ToDec().Quo(linoStakeStat.TotalLinoStake.ToDec()))
 	}
-	return totalInterest, nil
+	// julian year of 365.25 days
+	daysPerYear := types.NewDecFromRat(types.HoursPerYear, 24)
+	return totalDailyRate.Quo(sdk.NewDec(endDay - startDay)).Mul(daysPerYear), nil
 }
 
 // RecordConsumptionAndLinoStake - records consumption and lino power to LinoStakeStat and renew to new slot
@@ -293,10 +380,19 @@ func (gm *GlobalManager) RecordConsumptionAndLinoStake(ctx sdk.Context) sdk.Erro
 func (gm *GlobalManager) RegisterCoinReturnEvent(
 	ctx sdk.Context, events []types.Event, times int64, intervalSec int64) sdk.Error {
 	for i := int64(0); i < times; i++ {
-		if err := gm.registerEventAtTime(
-			ctx, ctx.BlockHeader().Time.Unix()+(intervalSec*(i+1)), events[i]); err != nil {
+		returnAt := ctx.BlockHeader().Time.Unix() + (intervalSec * (i + 1))
+		if err := gm.registerEventAtTime(ctx, returnAt, events[i]); err != nil {
 			return err
 		}
+		if ctx.IsCheckTx() {
+			continue
+		}
+		// index coin return by username so user can query future returns
+		if coinReturn, ok := events[i].(types.CoinReturnEvent); ok {
+			if err := gm.storage.AddPendingCoinReturn(ctx, returnAt, coinReturn); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -311,6 +407,15 @@ func (gm *GlobalManager) RegisterProposalDecideEvent(
 	return nil
 }
 
+// RegisterPostDraftExpireEvent - register event to remove incomplete post draft at expired time
+func (gm *GlobalManager) RegisterPostDraftExpireEvent(
+	ctx sdk.Context, expiredAt int64, event types.Event) sdk.Error {
+	if err := gm.registerEventAtTime(ctx, expiredAt, event); err != nil {
+		return err
+	}
+	return nil
+}
+
 // RegisterParamChangeEvent - register parameter change event
 func (gm *GlobalManager) RegisterParamChangeEvent(ctx sdk.Context, event types.Event) sdk.Error {
 	if err := gm.registerEventAtTime(ctx,
@@ -327,6 +432,10 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	if err != nil {
 		return err
 	}
+	proposalParam, err := gm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
 	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
 	if err != nil {
 		return err
@@ -354,6 +463,16 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 		return err
 	}
 
+	// part of inflation is set aside to community pool before allocation
+	communityPoolInflation :=
+		types.DecToCoin(thisHourInflation.ToDec().Mul(globalAllocation.CommunityPoolAllocation))
+	if communityPoolInflation.IsPositive() {
+		if err := gm.AddToCommunityPool(ctx, communityPoolInflation); err != nil {
+			return err
+		}
+		thisHourInflation = thisHourInflation.Minus(communityPoolInflation)
+	}
+
 	// distribute content creator inflation to consumption meta
 	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
 	if err != nil {
@@ -367,6 +486,11 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 		types.DecToCoin(thisHourInflation.ToDec().Mul(globalAllocation.InfraAllocation))
 	developerInflation :=
 		thisHourInflation.Minus(contentCreatorInflation).Minus(validatorInflation).Minus(infraInflation)
+
+	// part of content creator inflation is set aside to fund censorship escalation
+	censorshipEscalationInflation :=
+		types.DecToCoin(contentCreatorInflation.ToDec().Mul(proposalParam.ContentCensorshipEscalationPoolRate))
+	contentCreatorInflation = contentCreatorInflation.Minus(censorshipEscalationInflation)
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Plus(contentCreatorInflation)
 
 	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
@@ -377,6 +501,7 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	pool.InfraInflationPool = pool.InfraInflationPool.Plus(infraInflation)
 	pool.ValidatorInflationPool = pool.ValidatorInflationPool.Plus(validatorInflation)
 	pool.DeveloperInflationPool = pool.DeveloperInflationPool.Plus(developerInflation)
+	pool.CensorshipEscalationPool = pool.CensorshipEscalationPool.Plus(censorshipEscalationInflation)
 	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
 		return err
 	}
@@ -491,6 +616,130 @@ func (gm *GlobalManager) AddToValidatorInflationPool(ctx sdk.Context, coin types
 	return nil
 }
 
+// GetCensorshipEscalationPool - get coin available in censorship escalation pool
+func (gm *GlobalManager) GetCensorshipEscalationPool(ctx sdk.Context) (types.Coin, sdk.Error) {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return pool.CensorshipEscalationPool, nil
+}
+
+// AddToCensorshipEscalationPool - add coin to censorship escalation pool
+func (gm *GlobalManager) AddToCensorshipEscalationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.CensorshipEscalationPool = pool.CensorshipEscalationPool.Plus(coin)
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MinusFromCensorshipEscalationPool - withdraw deposit of escalated content censorship proposal from pool
+func (gm *GlobalManager) MinusFromCensorshipEscalationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.CensorshipEscalationPool.IsGTE(coin) {
+		return ErrCensorshipEscalationPoolNotEnough()
+	}
+	pool.CensorshipEscalationPool = pool.CensorshipEscalationPool.Minus(coin)
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCommunityPool - get coin available in community pool
+func (gm *GlobalManager) GetCommunityPool(ctx sdk.Context) (types.Coin, sdk.Error) {
+	pool, err := gm.storage.GetCommunityPool(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return pool.Balance, nil
+}
+
+// AddToCommunityPool - add coin to community pool
+func (gm *GlobalManager) AddToCommunityPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.Balance = pool.Balance.Plus(coin)
+	if err := gm.storage.SetCommunityPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SpendFromCommunityPool - withdraw coin from community pool for passed spend proposal and record the spend
+func (gm *GlobalManager) SpendFromCommunityPool(
+	ctx sdk.Context, proposalID types.ProposalKey, recipient types.AccountKey,
+	amount types.Coin, reason string) sdk.Error {
+	pool, err := gm.storage.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.Balance.IsGTE(amount) {
+		return ErrCommunityPoolNotEnough()
+	}
+	pool.Balance = pool.Balance.Minus(amount)
+	if err := gm.addTotalLinoCoin(ctx, amount); err != nil {
+		return err
+	}
+	if err := gm.storage.SetCommunityPool(ctx, pool); err != nil {
+		return err
+	}
+	spend := &model.CommunityPoolSpend{
+		ProposalID: proposalID,
+		Recipient:  recipient,
+		Amount:     amount,
+		Reason:     reason,
+		SpentAt:    ctx.BlockHeader().Time.Unix(),
+	}
+	return gm.storage.AddCommunityPoolSpend(ctx, spend)
+}
+
+// GetCommunityPoolSpends - get spend history of community pool
+func (gm *GlobalManager) GetCommunityPoolSpends(ctx sdk.Context) ([]model.CommunityPoolSpend, sdk.Error) {
+	return gm.storage.GetCommunityPoolSpends(ctx)
+}
+
+// ScheduleUpgrade - schedule upgrade of passed protocol upgrade proposal,
+// only one upgrade plan can be pending at a time
+func (gm *GlobalManager) ScheduleUpgrade(
+	ctx sdk.Context, proposalID types.ProposalKey, name string, height int64, link string) sdk.Error {
+	if gm.storage.DoesUpgradePlanExist(ctx) {
+		return ErrUpgradePlanAlreadyExist()
+	}
+	plan := &model.UpgradePlan{
+		ProposalID: proposalID,
+		Name:       name,
+		Height:     height,
+		Link:       link,
+	}
+	return gm.storage.SetUpgradePlan(ctx, plan)
+}
+
+// DoesUpgradePlanExist - check if there is a pending upgrade plan
+func (gm *GlobalManager) DoesUpgradePlanExist(ctx sdk.Context) bool {
+	return gm.storage.DoesUpgradePlanExist(ctx)
+}
+
+// GetUpgradePlan - get pending upgrade plan
+func (gm *GlobalManager) GetUpgradePlan(ctx sdk.Context) (*model.UpgradePlan, sdk.Error) {
+	return gm.storage.GetUpgradePlan(ctx)
+}
+
+// ClearUpgradePlan - remove pending upgrade plan after the upgrade is applied
+func (gm *GlobalManager) ClearUpgradePlan(ctx sdk.Context) {
+	gm.storage.DeleteUpgradePlan(ctx)
+}
+
 // GetValidatorHourlyInflation - get validator hourly inflation
 func (gm *GlobalManager) GetValidatorHourlyInflation(ctx sdk.Context) (types.Coin, sdk.Error) {
 	pool, err := gm.storage.GetInflationPool(ctx)
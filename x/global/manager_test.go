@@ -22,6 +22,24 @@ const (
 
 type testEvent struct{}
 
+type testCoinReturnEvent struct {
+	Username   types.AccountKey
+	Amount     types.Coin
+	ReturnType types.TransferDetailType
+}
+
+func (event testCoinReturnEvent) GetUsername() types.AccountKey {
+	return event.Username
+}
+
+func (event testCoinReturnEvent) GetAmount() types.Coin {
+	return event.Amount
+}
+
+func (event testCoinReturnEvent) GetReturnType() types.TransferDetailType {
+	return event.ReturnType
+}
+
 // Construct some global addrs and txs for tests.
 var (
 	TestGlobalKVStoreKey = sdk.NewKVStoreKey("global")
@@ -51,6 +69,7 @@ func setupTest(t *testing.T) (sdk.Context, GlobalManager) {
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(testEvent{}, "test", nil)
+	cdc.RegisterConcrete(testCoinReturnEvent{}, "testCoinReturn", nil)
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
 	return ctx, globalManager
@@ -575,6 +594,56 @@ func TestRegisterCoinReturnEvent(t *testing.T) {
 	}
 }
 
+func TestPendingCoinReturns(t *testing.T) {
+	ctx, gm := setupTest(t)
+	baseTime := int64(1000)
+	user := types.AccountKey("user")
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+
+	// coin return registered in check tx is not indexed
+	checkTxCtx := ctx.WithIsCheckTx(true)
+	events := []types.Event{
+		testCoinReturnEvent{Username: user, Amount: types.NewCoinFromInt64(1), ReturnType: types.VoteReturnCoin},
+	}
+	err := gm.RegisterCoinReturnEvent(checkTxCtx, events, 1, 3600)
+	assert.Nil(t, err)
+	coinReturns, err := gm.GetPendingCoinReturns(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(coinReturns))
+
+	events = []types.Event{
+		testCoinReturnEvent{Username: user, Amount: types.NewCoinFromInt64(10), ReturnType: types.VoteReturnCoin},
+		testCoinReturnEvent{Username: user, Amount: types.NewCoinFromInt64(20), ReturnType: types.VoteReturnCoin},
+	}
+	err = gm.RegisterCoinReturnEvent(ctx, events, 2, 3600)
+	assert.Nil(t, err)
+	// other events are not indexed
+	err = gm.RegisterCoinReturnEvent(ctx, []types.Event{testEvent{}}, 1, 3600)
+	assert.Nil(t, err)
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+
+	coinReturns, err = gm.GetPendingCoinReturns(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PendingCoinReturn{
+		{ReturnAt: baseTime + 3600, Amount: types.NewCoinFromInt64(10), ReturnType: types.VoteReturnCoin},
+		{ReturnAt: baseTime + 7200, Amount: types.NewCoinFromInt64(20), ReturnType: types.VoteReturnCoin},
+	}, coinReturns)
+
+	coinReturns, err = gm.GetPendingCoinReturns(ctx, types.AccountKey("other"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(coinReturns))
+
+	// executed coin return is removed with time event list
+	err = gm.RemoveTimeEventList(ctx, baseTime+3600)
+	assert.Nil(t, err)
+	coinReturns, err = gm.GetPendingCoinReturns(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PendingCoinReturn{
+		{ReturnAt: baseTime + 7200, Amount: types.NewCoinFromInt64(20), ReturnType: types.VoteReturnCoin},
+	}, coinReturns)
+}
+
 func TestDistributeHourlyInflation(t *testing.T) {
 	ctx, gm := setupTest(t)
 	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
@@ -1490,6 +1559,73 @@ func TestGetInterestSince(t *testing.T) {
 	}
 }
 
+func TestGetStakeAPR(t *testing.T) {
+	ctx, gm := setupTest(t)
+	stats := []model.LinoStakeStat{
+		{
+			TotalConsumptionFriction: types.NewCoinFromInt64(1 * types.Decimals),
+			TotalLinoStake:           types.NewCoinFromInt64(1000 * types.Decimals),
+			UnclaimedFriction:        types.NewCoinFromInt64(1 * types.Decimals),
+			UnclaimedLinoStake:       types.NewCoinFromInt64(1000 * types.Decimals),
+		},
+		{
+			TotalConsumptionFriction: types.NewCoinFromInt64(3 * types.Decimals),
+			TotalLinoStake:           types.NewCoinFromInt64(1000 * types.Decimals),
+			UnclaimedFriction:        types.NewCoinFromInt64(3 * types.Decimals),
+			UnclaimedLinoStake:       types.NewCoinFromInt64(1000 * types.Decimals),
+		},
+	}
+	for i := range stats {
+		err := gm.storage.SetLinoStakeStat(ctx, int64(i), &stats[i])
+		assert.Nil(t, err)
+	}
+	daysPerYear := types.NewDecFromRat(types.HoursPerYear, 24)
+
+	testCases := []struct {
+		testName  string
+		current   int64
+		days      int64
+		expectAPR sdk.Dec
+	}{
+		{
+			testName:  "no complete day",
+			current:   0,
+			days:      7,
+			expectAPR: sdk.ZeroDec(),
+		},
+		{
+			testName:  "trailing days longer than chain history",
+			current:   3600 * 24 * 2,
+			days:      7,
+			expectAPR: types.NewDecFromRat(2, 1000).Mul(daysPerYear),
+		},
+		{
+			testName:  "trailing one day",
+			current:   3600 * 24 * 2,
+			days:      1,
+			expectAPR: types.NewDecFromRat(3, 1000).Mul(daysPerYear),
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.current, 0)})
+		apr, err := gm.GetStakeAPR(ctx, tc.days)
+		assert.Nil(t, err)
+		if !apr.Equal(tc.expectAPR) {
+			t.Errorf("%s: diff apr, got %v, want %v", tc.testName, apr, tc.expectAPR)
+		}
+	}
+
+	// estimation doesn't claim interest from lino stake statistic
+	stake := types.NewCoinFromInt64(100 * types.Decimals)
+	estimated, err := gm.EstimateInterestSince(ctx, 0, stake)
+	assert.Nil(t, err)
+	assert.True(t, estimated.IsEqual(types.NewCoinFromInt64(4*types.Decimals/10)))
+	interest, err := gm.GetInterestSince(ctx, 0, stake)
+	assert.Nil(t, err)
+	assert.True(t, estimated.IsEqual(interest))
+}
+
 func TestRecordConsumptionAndLinoStake(t *testing.T) {
 	ctx, gm := setupTest(t)
 
@@ -1631,3 +1767,94 @@ func TestRegisterParamChangeEvent(t *testing.T) {
 		assert.Equal(t, timeEventList.Events, tc.expectEventList)
 	}
 }
+
+func TestCommunityPool(t *testing.T) {
+	ctx, gm := setupTest(t)
+
+	pool, err := gm.GetCommunityPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, pool.IsZero())
+
+	// part of hourly inflation goes to community pool
+	globalAllocation, err := gm.paramHolder.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, err)
+	globalAllocation.CommunityPoolAllocation = types.NewDecFromRat(10, 100)
+	err = param.ChangeParamEvent{Param: *globalAllocation}.Execute(ctx, gm.paramHolder)
+	assert.Nil(t, err)
+
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	hourlyInflation :=
+		types.DecToCoin(globalMeta.LastYearTotalLinoCoin.ToDec().
+			Mul(globalAllocation.GlobalGrowthRate).
+			Mul(types.NewDecFromRat(1, int64(types.HoursPerYear))))
+	expectCommunityPool := types.DecToCoin(hourlyInflation.ToDec().Mul(globalAllocation.CommunityPoolAllocation))
+	expectInfraInflation := types.DecToCoin(
+		hourlyInflation.Minus(expectCommunityPool).ToDec().Mul(globalAllocation.InfraAllocation))
+
+	err = gm.DistributeHourlyInflation(ctx)
+	assert.Nil(t, err)
+	pool, err = gm.GetCommunityPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, expectCommunityPool.IsEqual(pool))
+	inflationPool, err := gm.storage.GetInflationPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, expectInfraInflation.IsEqual(inflationPool.InfraInflationPool))
+
+	// slashed coin goes to community pool
+	slashed := types.NewCoinFromInt64(100 * types.Decimals)
+	err = gm.AddToCommunityPool(ctx, slashed)
+	assert.Nil(t, err)
+	expectCommunityPool = expectCommunityPool.Plus(slashed)
+
+	err = gm.SpendFromCommunityPool(
+		ctx, types.ProposalKey("1"), types.AccountKey("user1"), expectCommunityPool.Plus(types.NewCoinFromInt64(1)), "")
+	assert.Equal(t, ErrCommunityPoolNotEnough(), err)
+
+	spendAmount := types.NewCoinFromInt64(50 * types.Decimals)
+	err = gm.SpendFromCommunityPool(ctx, types.ProposalKey("2"), types.AccountKey("user1"), spendAmount, "grant")
+	assert.Nil(t, err)
+	pool, err = gm.GetCommunityPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, expectCommunityPool.Minus(spendAmount).IsEqual(pool))
+
+	spends, err := gm.GetCommunityPoolSpends(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.CommunityPoolSpend{
+		{
+			ProposalID: types.ProposalKey("2"),
+			Recipient:  types.AccountKey("user1"),
+			Amount:     spendAmount,
+			Reason:     "grant",
+			SpentAt:    ctx.BlockHeader().Time.Unix(),
+		},
+	}, spends)
+
+	newGlobalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	assert.True(t, globalMeta.TotalLinoCoin.Plus(spendAmount).IsEqual(newGlobalMeta.TotalLinoCoin))
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, gm := setupTest(t)
+
+	err := gm.ScheduleUpgrade(ctx, types.ProposalKey("1"), "upgrade1", 1000, "link1")
+	assert.Nil(t, err)
+
+	// pending upgrade plan isn't overwritten
+	err = gm.ScheduleUpgrade(ctx, types.ProposalKey("2"), "upgrade2", 2000, "link2")
+	assert.Equal(t, ErrUpgradePlanAlreadyExist(), err)
+	plan, err := gm.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.UpgradePlan{
+		ProposalID: types.ProposalKey("1"),
+		Name:       "upgrade1",
+		Height:     1000,
+		Link:       "link1",
+	}, plan)
+
+	// next upgrade can be scheduled after pending one is applied
+	gm.ClearUpgradePlan(ctx)
+	err = gm.ScheduleUpgrade(ctx, types.ProposalKey("2"), "upgrade2", 2000, "link2")
+	assert.Nil(t, err)
+}
@@ -67,6 +67,21 @@ func ErrFailedToMarshalTime(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalTime, fmt.Sprintf("failed to marshal time: %s", err.Error()))
 }
 
+// ErrFailedToMarshalCoinReturn - error if marshal pending coin return failed
+func ErrFailedToMarshalCoinReturn(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCoinReturn, fmt.Sprintf("failed to marshal pending coin return: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCommunityPool - error if marshal community pool failed
+func ErrFailedToMarshalCommunityPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCommunityPool, fmt.Sprintf("failed to marshal community pool: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCommunityPoolSpend - error if marshal community pool spend failed
+func ErrFailedToMarshalCommunityPoolSpend(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCommunityPoolSpend, fmt.Sprintf("failed to marshal community pool spend: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalTimeEventList - error if unmarshal time event list failed
 func ErrFailedToUnmarshalTimeEventList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalTimeEventList, fmt.Sprintf("failed to unmarshal time event list: %s", err.Error()))
@@ -101,3 +116,33 @@ func ErrFailedToUnmarshalTPS(err error) sdk.Error {
 func ErrFailedToUnmarshalTime(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalTime, fmt.Sprintf("failed to unmarshal time: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalCoinReturn - error if unmarshal pending coin return failed
+func ErrFailedToUnmarshalCoinReturn(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCoinReturn, fmt.Sprintf("failed to unmarshal pending coin return: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCommunityPool - error if unmarshal community pool failed
+func ErrFailedToUnmarshalCommunityPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCommunityPool, fmt.Sprintf("failed to unmarshal community pool: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCommunityPoolSpend - error if unmarshal community pool spend failed
+func ErrFailedToUnmarshalCommunityPoolSpend(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCommunityPoolSpend, fmt.Sprintf("failed to unmarshal community pool spend: %s", err.Error()))
+}
+
+// ErrUpgradePlanNotFound - error if there is no pending upgrade plan
+func ErrUpgradePlanNotFound() sdk.Error {
+	return types.NewError(types.CodeUpgradePlanNotFound, fmt.Sprintf("upgrade plan not found"))
+}
+
+// ErrFailedToMarshalUpgradePlan - error if marshal upgrade plan failed
+func ErrFailedToMarshalUpgradePlan(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUpgradePlan, fmt.Sprintf("failed to marshal upgrade plan: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalUpgradePlan - error if unmarshal upgrade plan failed
+func ErrFailedToUnmarshalUpgradePlan(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUpgradePlan, fmt.Sprintf("failed to unmarshal upgrade plan: %s", err.Error()))
+}
@@ -50,10 +50,12 @@ func (t *TPS) ToIR() TPSIR {
 // DistributedContentCreatorInflationPool inflation alrady distributed
 // DeveloperInflationPool inflation pool for developer
 // ValidatorInflationPool inflation pool for validator
+// CensorshipEscalationPool pool to fund deposit of escalated content censorship proposal
 type InflationPool struct {
-	InfraInflationPool     types.Coin `json:"infra_inflation_pool"`
-	DeveloperInflationPool types.Coin `json:"developer_inflation_pool"`
-	ValidatorInflationPool types.Coin `json:"validator_inflation_pool"`
+	InfraInflationPool       types.Coin `json:"infra_inflation_pool"`
+	DeveloperInflationPool   types.Coin `json:"developer_inflation_pool"`
+	ValidatorInflationPool   types.Coin `json:"validator_inflation_pool"`
+	CensorshipEscalationPool types.Coin `json:"censorship_escalation_pool"`
 }
 
 // ConsumptionMeta
@@ -88,3 +90,46 @@ type EventCache struct {
 	UnixTime  int64         `json:"unix_time"`
 	EventList []types.Event `json:"event_list"`
 }
+
+// PendingCoinReturn - coin scheduled to be returned to user at given time
+type PendingCoinReturn struct {
+	ReturnAt   int64                    `json:"return_at"`
+	Amount     types.Coin               `json:"amount"`
+	ReturnType types.TransferDetailType `json:"return_type"`
+}
+
+// CommunityPool - community treasury funded by inflation and slashed coins, spent by governance
+type CommunityPool struct {
+	Balance types.Coin `json:"balance"`
+}
+
+// CommunityPoolSpend - coin paid from community pool by a passed spend proposal
+type CommunityPoolSpend struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Recipient  types.AccountKey  `json:"recipient"`
+	Amount     types.Coin        `json:"amount"`
+	Reason     string            `json:"reason"`
+	SpentAt    int64             `json:"spent_at"`
+}
+
+// UpgradePlan - software upgrade scheduled by a passed protocol upgrade proposal,
+// node halts at the height unless the binary has a handler for the upgrade name
+type UpgradePlan struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Name       string            `json:"name"`
+	Height     int64             `json:"height"`
+	Link       string            `json:"link"`
+}
+
+// StakeInterestInfo - stake interest rate, unclaimed interest and interest projection of a user
+type StakeInterestInfo struct {
+	Username                     types.AccountKey `json:"username"`
+	LinoStake                    types.Coin       `json:"lino_stake"`
+	APR7Day                      sdk.Dec          `json:"apr_7_day"`
+	APR30Day                     sdk.Dec          `json:"apr_30_day"`
+	UnclaimedInterest            types.Coin       `json:"unclaimed_interest"`
+	UnsettledInterest            types.Coin       `json:"unsettled_interest"`
+	ProjectedStake               types.Coin       `json:"projected_stake"`
+	ProjectedAnnualInterest7Day  types.Coin       `json:"projected_annual_interest_7_day"`
+	ProjectedAnnualInterest30Day types.Coin       `json:"projected_annual_interest_30_day"`
+}
@@ -25,11 +25,14 @@ type GlobalMiscIR struct {
 	ConsumptionMeta ConsumptionMetaIR `json:"consumption_meta"`
 	TPS             TPSIR             `json:"tps"`
 	Time            GlobalTime        `json:"time"`
+	CommunityPool   CommunityPool     `json:"community_pool"`
 }
 
 // GlobalTablesIR - GlobalMisc changed.
 type GlobalTablesIR struct {
-	GlobalTimeEventLists []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
-	GlobalStakeStats     []GlobalStakeStatDayRow  `json:"global_stake_stats"`
-	GlobalMisc           GlobalMiscIR             `json:"global_misc"`
+	GlobalTimeEventLists      []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
+	GlobalStakeStats          []GlobalStakeStatDayRow  `json:"global_stake_stats"`
+	GlobalMisc                GlobalMiscIR             `json:"global_misc"`
+	GlobalCommunityPoolSpends []CommunityPoolSpend     `json:"global_community_pool_spends"`
+	GlobalUpgradePlan         *UpgradePlan             `json:"global_upgrade_plan"`
 }
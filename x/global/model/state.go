@@ -23,6 +23,7 @@ type GlobalMisc struct {
 	ConsumptionMeta ConsumptionMeta `json:"consumption_meta"`
 	TPS             TPS             `json:"tps"`
 	Time            GlobalTime      `json:"time"`
+	CommunityPool   CommunityPool   `json:"community_pool"`
 }
 
 // ToIR -
@@ -33,21 +34,26 @@ func (g GlobalMisc) ToIR() GlobalMiscIR {
 		ConsumptionMeta: g.ConsumptionMeta.ToIR(),
 		TPS:             g.TPS.ToIR(),
 		Time:            g.Time,
+		CommunityPool:   g.CommunityPool,
 	}
 }
 
 // GlobalTables state of global.
 type GlobalTables struct {
-	GlobalTimeEventLists []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
-	GlobalStakeStats     []GlobalStakeStatDayRow  `json:"global_stake_stats"`
-	GlobalMisc           GlobalMisc               `json:"global_misc"`
+	GlobalTimeEventLists      []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
+	GlobalStakeStats          []GlobalStakeStatDayRow  `json:"global_stake_stats"`
+	GlobalMisc                GlobalMisc               `json:"global_misc"`
+	GlobalCommunityPoolSpends []CommunityPoolSpend     `json:"global_community_pool_spends"`
+	GlobalUpgradePlan         *UpgradePlan             `json:"global_upgrade_plan"`
 }
 
 // ToIR -
 func (g GlobalTables) ToIR() GlobalTablesIR {
 	return GlobalTablesIR{
-		GlobalTimeEventLists: g.GlobalTimeEventLists,
-		GlobalStakeStats:     g.GlobalStakeStats,
-		GlobalMisc:           g.GlobalMisc.ToIR(),
+		GlobalTimeEventLists:      g.GlobalTimeEventLists,
+		GlobalStakeStats:          g.GlobalStakeStats,
+		GlobalMisc:                g.GlobalMisc.ToIR(),
+		GlobalCommunityPoolSpends: g.GlobalCommunityPoolSpends,
+		GlobalUpgradePlan:         g.GlobalUpgradePlan,
 	}
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"strconv"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +19,10 @@ var (
 	tpsSubStore             = []byte{0x04} // SubStore for tps
 	timeSubStore            = []byte{0x05} // SubStore for time
 	linoStakeStatSubStore   = []byte{0x06} // SubStore for lino power statistic
+	coinReturnSubStore      = []byte{0x07} // SubStore for pending coin return
+	communityPoolSubStore   = []byte{0x08} // SubStore for community pool
+	poolSpendSubStore       = []byte{0x09} // SubStore for community pool spend history
+	upgradePlanSubStore     = []byte{0x0a} // SubStore for pending upgrade plan
 )
 
 // GlobalStorage - global storage
@@ -41,6 +46,7 @@ func NewGlobalStorage(key sdk.StoreKey) GlobalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "param/bandwidth", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "param/account", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "param/post", nil)
+	cdc.RegisterConcrete(param.ReputationParam{}, "param/reputation", nil)
 
 	wire.RegisterCrypto(cdc)
 	return GlobalStorage{
@@ -149,6 +155,162 @@ func (gs GlobalStorage) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk
 	return nil
 }
 
+// GetPendingCoinReturns - get coin returns of user at given unix time
+func (gs GlobalStorage) GetPendingCoinReturns(
+	ctx sdk.Context, username types.AccountKey, unixTime int64) ([]PendingCoinReturn, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	returnsByte := store.Get(GetPendingCoinReturnKey(username, unixTime))
+	if returnsByte == nil {
+		return nil, nil
+	}
+	returns := []PendingCoinReturn{}
+	if err := gs.cdc.UnmarshalBinaryLengthPrefixed(returnsByte, &returns); err != nil {
+		return nil, ErrFailedToUnmarshalCoinReturn(err)
+	}
+	return returns, nil
+}
+
+// SetPendingCoinReturns - set coin returns of user at given unix time
+func (gs GlobalStorage) SetPendingCoinReturns(
+	ctx sdk.Context, username types.AccountKey, unixTime int64, returns []PendingCoinReturn) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	returnsByte, err := gs.cdc.MarshalBinaryLengthPrefixed(returns)
+	if err != nil {
+		return ErrFailedToMarshalCoinReturn(err)
+	}
+	store.Set(GetPendingCoinReturnKey(username, unixTime), returnsByte)
+	return nil
+}
+
+// AddPendingCoinReturn - append coin return event to user's pending coin returns
+func (gs GlobalStorage) AddPendingCoinReturn(
+	ctx sdk.Context, unixTime int64, event types.CoinReturnEvent) sdk.Error {
+	returns, err := gs.GetPendingCoinReturns(ctx, event.GetUsername(), unixTime)
+	if err != nil {
+		return err
+	}
+	returns = append(returns, PendingCoinReturn{
+		ReturnAt:   unixTime,
+		Amount:     event.GetAmount(),
+		ReturnType: event.GetReturnType(),
+	})
+	return gs.SetPendingCoinReturns(ctx, event.GetUsername(), unixTime, returns)
+}
+
+// RemovePendingCoinReturns - remove coin returns of user at given unix time
+func (gs GlobalStorage) RemovePendingCoinReturns(
+	ctx sdk.Context, username types.AccountKey, unixTime int64) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	store.Delete(GetPendingCoinReturnKey(username, unixTime))
+	return nil
+}
+
+// GetAllPendingCoinReturns - get all coin returns of user, ordered by return time
+func (gs GlobalStorage) GetAllPendingCoinReturns(
+	ctx sdk.Context, username types.AccountKey) ([]PendingCoinReturn, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, GetPendingCoinReturnPrefix(username))
+	defer itr.Close()
+	returns := []PendingCoinReturn{}
+	for ; itr.Valid(); itr.Next() {
+		lst := []PendingCoinReturn{}
+		if err := gs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &lst); err != nil {
+			return nil, ErrFailedToUnmarshalCoinReturn(err)
+		}
+		returns = append(returns, lst...)
+	}
+	return returns, nil
+}
+
+// GetCommunityPool - get community pool from KVStore, pool is empty before first deposit
+func (gs GlobalStorage) GetCommunityPool(ctx sdk.Context) (*CommunityPool, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	poolBytes := store.Get(GetCommunityPoolKey())
+	if poolBytes == nil {
+		return &CommunityPool{Balance: types.NewCoinFromInt64(0)}, nil
+	}
+	pool := new(CommunityPool)
+	if err := gs.cdc.UnmarshalBinaryLengthPrefixed(poolBytes, pool); err != nil {
+		return nil, ErrFailedToUnmarshalCommunityPool(err)
+	}
+	return pool, nil
+}
+
+// SetCommunityPool - set community pool to KVStore
+func (gs GlobalStorage) SetCommunityPool(ctx sdk.Context, pool *CommunityPool) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	poolBytes, err := gs.cdc.MarshalBinaryLengthPrefixed(*pool)
+	if err != nil {
+		return ErrFailedToMarshalCommunityPool(err)
+	}
+	store.Set(GetCommunityPoolKey(), poolBytes)
+	return nil
+}
+
+// AddCommunityPoolSpend - record a spend from community pool
+func (gs GlobalStorage) AddCommunityPoolSpend(ctx sdk.Context, spend *CommunityPoolSpend) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	spendBytes, err := gs.cdc.MarshalBinaryLengthPrefixed(*spend)
+	if err != nil {
+		return ErrFailedToMarshalCommunityPoolSpend(err)
+	}
+	store.Set(GetCommunityPoolSpendKey(spend.SpentAt, spend.ProposalID), spendBytes)
+	return nil
+}
+
+// GetCommunityPoolSpends - get all spends from community pool, ordered by spend time
+func (gs GlobalStorage) GetCommunityPoolSpends(ctx sdk.Context) ([]CommunityPoolSpend, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, poolSpendSubStore)
+	defer itr.Close()
+	spends := []CommunityPoolSpend{}
+	for ; itr.Valid(); itr.Next() {
+		spend := CommunityPoolSpend{}
+		if err := gs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &spend); err != nil {
+			return nil, ErrFailedToUnmarshalCommunityPoolSpend(err)
+		}
+		spends = append(spends, spend)
+	}
+	return spends, nil
+}
+
+// DoesUpgradePlanExist - check if there is a pending upgrade plan
+func (gs GlobalStorage) DoesUpgradePlanExist(ctx sdk.Context) bool {
+	store := ctx.KVStore(gs.key)
+	return store.Has(GetUpgradePlanKey())
+}
+
+// GetUpgradePlan - get pending upgrade plan from KVStore
+func (gs GlobalStorage) GetUpgradePlan(ctx sdk.Context) (*UpgradePlan, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	planBytes := store.Get(GetUpgradePlanKey())
+	if planBytes == nil {
+		return nil, ErrUpgradePlanNotFound()
+	}
+	plan := new(UpgradePlan)
+	if err := gs.cdc.UnmarshalBinaryLengthPrefixed(planBytes, plan); err != nil {
+		return nil, ErrFailedToUnmarshalUpgradePlan(err)
+	}
+	return plan, nil
+}
+
+// SetUpgradePlan - set pending upgrade plan to KVStore
+func (gs GlobalStorage) SetUpgradePlan(ctx sdk.Context, plan *UpgradePlan) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	planBytes, err := gs.cdc.MarshalBinaryLengthPrefixed(*plan)
+	if err != nil {
+		return ErrFailedToMarshalUpgradePlan(err)
+	}
+	store.Set(GetUpgradePlanKey(), planBytes)
+	return nil
+}
+
+// DeleteUpgradePlan - remove pending upgrade plan from KVStore
+func (gs GlobalStorage) DeleteUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(gs.key)
+	store.Delete(GetUpgradePlanKey())
+}
+
 // SetLinoStakeStat - set lino power statistic at given day
 func (gs GlobalStorage) SetLinoStakeStat(ctx sdk.Context, day int64, lps *LinoStakeStat) sdk.Error {
 	store := ctx.KVStore(gs.key)
@@ -368,14 +530,33 @@ func (gs GlobalStorage) Export(ctx sdk.Context) *GlobalTables {
 	if err != nil {
 		panic("failed to get global time")
 	}
+	communityPool, err := gs.GetCommunityPool(ctx)
+	if err != nil {
+		panic("failed to get community pool")
+	}
 	misc := GlobalMisc{
 		Meta:            *meta,
 		InflationPool:   *pool,
 		ConsumptionMeta: *consumptionMeta,
 		TPS:             *tps,
 		Time:            *time,
+		CommunityPool:   *communityPool,
 	}
 	tables.GlobalMisc = misc
+	// export tables.CommunityPoolSpends
+	spends, err := gs.GetCommunityPoolSpends(ctx)
+	if err != nil {
+		panic("failed to get community pool spends: " + err.Error())
+	}
+	tables.GlobalCommunityPoolSpends = spends
+	// export tables.UpgradePlan, nil if no upgrade is pending
+	if gs.DoesUpgradePlanExist(ctx) {
+		plan, err := gs.GetUpgradePlan(ctx)
+		if err != nil {
+			panic("failed to get upgrade plan: " + err.Error())
+		}
+		tables.GlobalUpgradePlan = plan
+	}
 	return tables
 }
 
@@ -390,6 +571,13 @@ func (gs GlobalStorage) Import(ctx sdk.Context, tb *GlobalTablesIR) {
 	for _, v := range tb.GlobalTimeEventLists {
 		err := gs.SetTimeEventList(ctx, v.UnixTime, &v.TimeEventList)
 		check(err)
+		// rebuild pending coin return index from coin return events
+		for _, event := range v.TimeEventList.Events {
+			if coinReturn, ok := event.(types.CoinReturnEvent); ok {
+				err := gs.AddPendingCoinReturn(ctx, v.UnixTime, coinReturn)
+				check(err)
+			}
+		}
 	}
 	// import table.GlobalStakeStats
 	for _, v := range tb.GlobalStakeStats {
@@ -407,6 +595,21 @@ func (gs GlobalStorage) Import(ctx sdk.Context, tb *GlobalTablesIR) {
 	err = gs.SetGlobalTime(ctx, &misc.Time)
 	check(err)
 
+	err = gs.SetCommunityPool(ctx, &misc.CommunityPool)
+	check(err)
+
+	// import table.GlobalCommunityPoolSpends
+	for _, v := range tb.GlobalCommunityPoolSpends {
+		err := gs.AddCommunityPoolSpend(ctx, &v)
+		check(err)
+	}
+
+	// import table.GlobalUpgradePlan
+	if tb.GlobalUpgradePlan != nil {
+		err := gs.SetUpgradePlan(ctx, tb.GlobalUpgradePlan)
+		check(err)
+	}
+
 	// type diff in IR
 	err = gs.SetConsumptionMeta(ctx, &ConsumptionMeta{
 		ConsumptionFrictionRate: sdk.MustNewDecFromStr(
@@ -434,6 +637,37 @@ func GetTimeEventListKey(unixTime int64) []byte {
 	return append(timeEventListSubStore, strconv.FormatInt(unixTime, 10)...)
 }
 
+// GetPendingCoinReturnPrefix - "coin return substore" + "username" + "/"
+func GetPendingCoinReturnPrefix(username types.AccountKey) []byte {
+	return append(append(coinReturnSubStore, username...), types.KeySeparator...)
+}
+
+// GetPendingCoinReturnKey - "coin return substore" + "username" + "/" + "padded unix time"
+// unix time is zero padded so that returns of a user are iterated in time order
+func GetPendingCoinReturnKey(username types.AccountKey, unixTime int64) []byte {
+	return append(GetPendingCoinReturnPrefix(username), fmt.Sprintf("%020d", unixTime)...)
+}
+
+// GetCommunityPoolKey - "community pool substore"
+func GetCommunityPoolKey() []byte {
+	return communityPoolSubStore
+}
+
+// GetCommunityPoolSpendPrefix - "community pool spend substore"
+func GetCommunityPoolSpendPrefix() []byte {
+	return poolSpendSubStore
+}
+
+// GetCommunityPoolSpendKey - "community pool spend substore" + "padded unix time" + "/" + "proposal id"
+func GetCommunityPoolSpendKey(unixTime int64, proposalID types.ProposalKey) []byte {
+	return append(append(GetCommunityPoolSpendPrefix(), fmt.Sprintf("%020d", unixTime)...), types.KeySeparator+string(proposalID)...)
+}
+
+// GetUpgradePlanKey - "upgrade plan substore"
+func GetUpgradePlanKey() []byte {
+	return upgradePlanSubStore
+}
+
 // GetGlobalMetaKey - "global meta substore"
 func GetGlobalMetaKey() []byte {
 	return globalMetaSubStore
@@ -76,3 +76,32 @@ func TestGlobalStorageGenesis(t *testing.T) {
 	}
 	checkGlobalStorage(t, ctx, gm, globalMeta, consumptionMeta, inflationPool)
 }
+
+func TestExportImportUpgradePlan(t *testing.T) {
+	gs := NewGlobalStorage(TestGlobalKVStoreKey)
+	ctx := getContext()
+	err := InitGlobalStorage(t, ctx, gs)
+	assert.Nil(t, err)
+
+	// no upgrade plan is exported if there isn't one pending
+	tables := gs.Export(ctx)
+	assert.Nil(t, tables.GlobalUpgradePlan)
+
+	plan := UpgradePlan{
+		ProposalID: types.ProposalKey("1"),
+		Name:       "upgrade1",
+		Height:     1000,
+		Link:       "link",
+	}
+	err = gs.SetUpgradePlan(ctx, &plan)
+	assert.Nil(t, err)
+	tables = gs.Export(ctx)
+	assert.Equal(t, &plan, tables.GlobalUpgradePlan)
+
+	ctx = getContext()
+	ir := tables.ToIR()
+	gs.Import(ctx, &ir)
+	planPtr, err := gs.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, plan, *planPtr)
+}
@@ -6,6 +6,7 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global/model"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -23,10 +24,23 @@ const (
 	QueryTPS             = "tps"
 	QueryLinoStakeStat   = "linoStakeStat"
 	QueryGlobalTime      = "globalTime"
+	QueryCoinReturns     = "coinReturns"
+	QueryCommunityPool   = "communityPool"
+	QueryPoolSpends      = "communityPoolSpends"
+	QueryUpgradePlan     = "upgradePlan"
+	QueryStakeInterest   = "stakeInterest"
 )
 
+// StakeKeeper - lino stake of user needed by stake interest query, implemented by vote manager
+type StakeKeeper interface {
+	DoesVoterExist(ctx sdk.Context, username types.AccountKey) bool
+	GetLinoStake(ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error)
+	GetLinoStakeLastChangedAt(ctx sdk.Context, username types.AccountKey) (int64, sdk.Error)
+	GetUnclaimedInterest(ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error)
+}
+
 // creates a querier for global REST endpoints
-func NewQuerier(gm GlobalManager) sdk.Querier {
+func NewQuerier(gm GlobalManager, sk StakeKeeper) sdk.Querier {
 	cdc := wire.New()
 	wire.RegisterCrypto(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
@@ -35,6 +49,8 @@ func NewQuerier(gm GlobalManager) sdk.Querier {
 			return queryTimeEventList(ctx, cdc, path[1:], req, gm)
 		case QueryGlobalMeta:
 			return queryGlobalMeta(ctx, cdc, path[1:], req, gm)
+		case QueryInflationPool:
+			return queryInflationPool(ctx, cdc, path[1:], req, gm)
 		case QueryConsumptionMeta:
 			return queryConsumptionMeta(ctx, cdc, path[1:], req, gm)
 		case QueryTPS:
@@ -43,6 +59,16 @@ func NewQuerier(gm GlobalManager) sdk.Querier {
 			return queryGlobalTime(ctx, cdc, path[1:], req, gm)
 		case QueryLinoStakeStat:
 			return queryLinoStakeStat(ctx, cdc, path[1:], req, gm)
+		case QueryCoinReturns:
+			return queryCoinReturns(ctx, cdc, path[1:], req, gm)
+		case QueryCommunityPool:
+			return queryCommunityPool(ctx, cdc, path[1:], req, gm)
+		case QueryPoolSpends:
+			return queryCommunityPoolSpends(ctx, cdc, path[1:], req, gm)
+		case QueryUpgradePlan:
+			return queryUpgradePlan(ctx, cdc, path[1:], req, gm)
+		case QueryStakeInterest:
+			return queryStakeInterest(ctx, cdc, path[1:], req, gm, sk)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown global query endpoint")
 		}
@@ -146,3 +172,120 @@ func queryLinoStakeStat(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryCoinReturns(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	coinReturns, err := gm.GetPendingCoinReturns(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(coinReturns)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryCommunityPool(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	communityPool, err := gm.storage.GetCommunityPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(communityPool)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryCommunityPoolSpends(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	spends, err := gm.GetCommunityPoolSpends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(spends)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryUpgradePlan(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	plan, err := gm.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(plan)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryStakeInterest - path: username[/extra stake in LNO], interest rate is the trailing
+// 7 days and 30 days APR of lino stake, projection is based on current stake plus extra stake
+func queryStakeInterest(
+	ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery,
+	gm GlobalManager, sk StakeKeeper) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	username := types.AccountKey(path[0])
+	extraStake := types.NewCoinFromInt64(0)
+	if len(path) > 1 {
+		coin, err := types.LinoToCoin(types.LNO(path[1]))
+		if err != nil {
+			return nil, err
+		}
+		extraStake = coin
+	}
+	apr7Day, err := gm.GetStakeAPR(ctx, 7)
+	if err != nil {
+		return nil, err
+	}
+	apr30Day, err := gm.GetStakeAPR(ctx, 30)
+	if err != nil {
+		return nil, err
+	}
+	info := model.StakeInterestInfo{
+		Username:          username,
+		LinoStake:         types.NewCoinFromInt64(0),
+		APR7Day:           apr7Day,
+		APR30Day:          apr30Day,
+		UnclaimedInterest: types.NewCoinFromInt64(0),
+		UnsettledInterest: types.NewCoinFromInt64(0),
+	}
+	if sk.DoesVoterExist(ctx, username) {
+		linoStake, err := sk.GetLinoStake(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		lastChangedAt, err := sk.GetLinoStakeLastChangedAt(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		unclaimed, err := sk.GetUnclaimedInterest(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		// interest generated since last stake change, commission is not deducted yet
+		unsettled, err := gm.EstimateInterestSince(ctx, lastChangedAt, linoStake)
+		if err != nil {
+			return nil, err
+		}
+		info.LinoStake = linoStake
+		info.UnclaimedInterest = unclaimed
+		info.UnsettledInterest = unsettled
+	}
+	info.ProjectedStake = info.LinoStake.Plus(extraStake)
+	info.ProjectedAnnualInterest7Day = types.DecToCoin(info.ProjectedStake.ToDec().Mul(apr7Day))
+	info.ProjectedAnnualInterest30Day = types.DecToCoin(info.ProjectedStake.ToDec().Mul(apr30Day))
+
+	res, marshalErr := cdc.MarshalJSON(info)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
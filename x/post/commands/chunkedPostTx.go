@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// ChunkedPostTxCmd will create a long-form post tx and upload its content chunk by chunk
+func ChunkedPostTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-long",
+		Short: "public a long-form post to blockchain, content is read from file",
+		RunE:  sendChunkedPostTx(cdc),
+	}
+	cmd.Flags().String(client.FlagAuthor, "", "author of this post")
+	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
+	cmd.Flags().String(client.FlagTitle, "", "title for the post")
+	cmd.Flags().String(client.FlagContentFile, "", "file contains content for the post")
+	cmd.Flags().String(client.FlagParentAuthor, "", "parent post author name")
+	cmd.Flags().String(client.FlagParentPostID, "", "parent post id")
+	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
+	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
+	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	return cmd
+}
+
+// send create chunked post and upload chunk transactions to the blockchain
+func sendChunkedPostTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		author := viper.GetString(client.FlagAuthor)
+		postID := viper.GetString(client.FlagPostID)
+		content, err := ioutil.ReadFile(viper.GetString(client.FlagContentFile))
+		if err != nil {
+			return err
+		}
+		chunks := splitContent(string(content), types.MaxPostChunkLength)
+		contentHash := sha256.Sum256([]byte(strings.Join(chunks, "")))
+
+		msg := post.NewCreateChunkedPostMsg(
+			author, postID, viper.GetString(client.FlagTitle),
+			hex.EncodeToString(contentHash[:]), int64(len(chunks)),
+			viper.GetString(client.FlagParentAuthor), viper.GetString(client.FlagParentPostID),
+			viper.GetString(client.FlagSourceAuthor), viper.GetString(client.FlagSourcePostID),
+			viper.GetString(client.FlagRedistributionSplitRate), nil)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+
+		for i, chunk := range chunks {
+			ctx = ctx.WithSequence(ctx.Sequence + 1)
+			chunkMsg := post.NewUploadPostChunkMsg(author, postID, int64(i), chunk)
+			res, err := ctx.SignBuildBroadcast([]sdk.Msg{chunkMsg}, cdc)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Chunk %d committed at block %d. Hash: %s\n", i, res.Height, res.Hash.String())
+		}
+		return nil
+	}
+}
+
+// splitContent - split content into chunks with at most @p size characters
+func splitContent(content string, size int) []string {
+	runes := []rune(content)
+	chunks := []string{}
+	for len(runes) > size {
+		chunks = append(chunks, string(runes[:size]))
+		runes = runes[size:]
+	}
+	return append(chunks, string(runes))
+}
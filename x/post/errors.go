@@ -152,3 +152,38 @@ func ErrInvalidMemo() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodePostQueryFailed, fmt.Sprintf("query post store failed"))
 }
+
+// ErrPostDraftNotFound - error when long-form post draft is not found
+func ErrPostDraftNotFound(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostDraftNotFound, fmt.Sprintf("post draft %v doesn't exist", permlink))
+}
+
+// ErrPostDraftAlreadyExist - error when long-form post draft is already exist
+func ErrPostDraftAlreadyExist(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostDraftAlreadyExist, fmt.Sprintf("post draft %v already exist", permlink))
+}
+
+// ErrInvalidPostContentHash - error when long-form post content hash is invalid
+func ErrInvalidPostContentHash() sdk.Error {
+	return types.NewError(types.CodeInvalidPostContentHash, fmt.Sprintf("post content hash must be hex encoded sha256"))
+}
+
+// ErrInvalidNumOfPostChunks - error when number of chunks of long-form post is invalid
+func ErrInvalidNumOfPostChunks() sdk.Error {
+	return types.NewError(types.CodeInvalidNumOfPostChunks, fmt.Sprintf("number of post chunks is invalid"))
+}
+
+// ErrPostChunkExceedMaxLength - error when post content chunk is too long
+func ErrPostChunkExceedMaxLength() sdk.Error {
+	return types.NewError(types.CodePostChunkExceedMaxLength, fmt.Sprintf("post chunk exceeds max length limitation"))
+}
+
+// ErrInvalidPostChunkIndex - error when post content chunk index is out of range
+func ErrInvalidPostChunkIndex(index int64) sdk.Error {
+	return types.NewError(types.CodeInvalidPostChunkIndex, fmt.Sprintf("post chunk index %v is invalid", index))
+}
+
+// ErrPostContentHashMismatch - error when assembled content doesn't match the content hash
+func ErrPostContentHashMismatch(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostContentHashMismatch, fmt.Sprintf("post %v content doesn't match content hash", permlink))
+}
@@ -17,6 +17,19 @@ func init() {
 
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(ExpirePostDraftEvent{}, "event/expirePostDraft", nil)
+}
+
+// ExpirePostDraftEvent - remove long-form post draft which didn't receive
+// all content chunks before it expired.
+type ExpirePostDraftEvent struct {
+	Author types.AccountKey `json:"author"`
+	PostID string           `json:"post_id"`
+}
+
+// Execute - remove expired post draft and its chunks
+func (event ExpirePostDraftEvent) Execute(ctx sdk.Context, pm PostManager) sdk.Error {
+	return pm.RemoveExpiredPostDraft(ctx, types.GetPermlink(event.Author, event.PostID))
 }
 
 // RewardEvent - when donation occurred, a reward event will be register
@@ -51,13 +64,20 @@ func (event RewardEvent) Execute(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		paneltyScore = sdk.OneDec()
 	}
+	// post waiting for content censorship result is hidden from reward
+	if isPending, err := pm.IsCensorshipPending(ctx, permlink); isPending || err != nil {
+		paneltyScore = sdk.OneDec()
+	}
 	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, paneltyScore)
 	if err != nil {
 		return err
 	}
-	// if developer exist, add to developer consumption
+	// if developer exist, add to developer consumption and reward pool donations
 	if dm.DoesDeveloperExist(ctx, event.FromApp) {
 		dm.ReportConsumption(ctx, event.FromApp, reward)
+		if err := dm.RecordUserDonation(ctx, event.FromApp, event.Consumer, event.Original); err != nil {
+			return err
+		}
 	}
 	if !am.DoesAccountExist(ctx, event.PostAuthor) {
 		return ErrAccountNotFound(event.PostAuthor)
@@ -31,6 +31,10 @@ func NewHandler(
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
 			return handleDeletePostMsg(ctx, msg, pm, am)
+		case CreateChunkedPostMsg:
+			return handleCreateChunkedPostMsg(ctx, msg, pm, am, gm)
+		case UploadPostChunkMsg:
+			return handleUploadPostChunkMsg(ctx, msg, pm)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -47,6 +51,10 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if pm.DoesPostExist(ctx, permlink) {
 		return ErrPostAlreadyExist(permlink).Result()
 	}
+	// pending chunked post would overwrite the post once all chunks are received
+	if pm.DoesPostDraftExist(ctx, permlink) {
+		return ErrPostDraftAlreadyExist(permlink).Result()
+	}
 	postParam, err := pm.paramHolder.GetPostParam(ctx)
 	if err != nil {
 		return err.Result()
@@ -86,6 +94,74 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	return sdk.Result{}
 }
 
+// Handle CreateChunkedPostMsg
+func handleCreateChunkedPostMsg(
+	ctx sdk.Context, msg CreateChunkedPostMsg, pm PostManager, am acc.AccountManager,
+	gm *global.GlobalManager) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Author) {
+		return ErrAccountNotFound(msg.Author).Result()
+	}
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if pm.DoesPostExist(ctx, permlink) {
+		return ErrPostAlreadyExist(permlink).Result()
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	lastPostAt, err := am.GetLastPostAt(ctx, msg.Author)
+	if err != nil {
+		return err.Result()
+	}
+	if lastPostAt+postParam.PostIntervalSec > ctx.BlockHeader().Time.Unix() {
+		return ErrPostTooOften(msg.Author).Result()
+	}
+	if len(msg.ParentAuthor) > 0 || len(msg.ParentPostID) > 0 {
+		parentPostKey := types.GetPermlink(msg.ParentAuthor, msg.ParentPostID)
+		if !pm.DoesPostExist(ctx, parentPostKey) {
+			return ErrPostNotFound(parentPostKey).Result()
+		}
+	}
+
+	splitRate, err := sdk.NewDecFromStr(msg.RedistributionSplitRate)
+	if err != nil {
+		return ErrInvalidPostRedistributionSplitRate().Result()
+	}
+
+	// comment is added to parent post after all chunks are received
+	if err := pm.CreatePostDraft(
+		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
+		msg.ParentAuthor, msg.ParentPostID, msg.Title, msg.ContentHash,
+		msg.NumOfChunks, splitRate, msg.Links); err != nil {
+		return err.Result()
+	}
+	expiredAt, err := pm.GetPostDraftExpiredAt(ctx, permlink)
+	if err != nil {
+		return err.Result()
+	}
+	if err := gm.RegisterPostDraftExpireEvent(
+		ctx, expiredAt, ExpirePostDraftEvent{Author: msg.Author, PostID: msg.PostID}); err != nil {
+		return err.Result()
+	}
+
+	if err := am.UpdateLastPostAt(ctx, msg.Author); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle UploadPostChunkMsg
+func handleUploadPostChunkMsg(ctx sdk.Context, msg UploadPostChunkMsg, pm PostManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if !pm.DoesPostDraftExist(ctx, permlink) {
+		return ErrPostDraftNotFound(permlink).Result()
+	}
+	if err := pm.AddPostChunk(ctx, permlink, msg.Index, msg.Chunk); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 // Handle ViewMsg
 func handleViewMsg(ctx sdk.Context, msg ViewMsg, pm PostManager, am acc.AccountManager, gm *global.GlobalManager) sdk.Result {
 	if !am.DoesAccountExist(ctx, msg.Username) {
@@ -134,6 +210,18 @@ func handleDonateMsg(
 		return err.Result()
 	}
 
+	// donation of sponsored user is attributed to sponsoring app
+	fromApp := msg.FromApp
+	if fromApp == "" {
+		sponsor, err := am.GetSponsor(ctx, msg.Username)
+		if err != nil {
+			return err.Result()
+		}
+		if sponsor != "" && dm.DoesDeveloperExist(ctx, sponsor) {
+			fromApp = sponsor
+		}
+	}
+
 	// sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
 	// if err != nil {
 	// 	return err.Result()
@@ -155,7 +243,7 @@ func handleDonateMsg(
 	// 	}
 	// }
 	if err := processDonationFriction(
-		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, msg.Memo, am, pm, gm, rm); err != nil {
+		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, fromApp, msg.Memo, am, pm, gm, rm); err != nil {
 		return ErrProcessDonation(permlink).Result()
 	}
 	return sdk.Result{}
@@ -254,6 +342,26 @@ func handleReportOrUpvoteMsg(
 			return err.Result()
 		}
 	}
+
+	coinDay, err := am.GetCoinDay(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if err := pm.ReportOrUpvoteToPost(ctx, permlink, msg.Username, coinDay, msg.IsReport); err != nil {
+		return err.Result()
+	}
+	// open content censorship proposal automatically if reports exceed the threshold
+	if msg.IsReport {
+		required, err := pm.IsCensorshipEscalationRequired(ctx, permlink)
+		if err != nil {
+			return err.Result()
+		}
+		if required {
+			if err := pm.MarkCensorshipPending(ctx, permlink); err != nil {
+				return err.Result()
+			}
+		}
+	}
 	if err := pm.UpdateLastActivityAt(ctx, permlink); err != nil {
 		return err.Result()
 	}
@@ -1,6 +1,9 @@
 package post
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
@@ -61,6 +64,128 @@ func TestHandlerCreatePost(t *testing.T) {
 	assert.Equal(t, result, ErrPostTooOften(msg.Author).Result())
 }
 
+func TestHandlerCreateChunkedPost(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, _ := ph.GetPostParam(ctx)
+
+	parent, parentPostID := createTestPost(t, ctx, "parent", "parentPostID", am, pm, "0")
+	user := createTestAccount(t, ctx, am, "user1")
+	parentPermlink := types.GetPermlink(parent, parentPostID)
+
+	chunks := []string{
+		"12345 67890 你好👌" + strings.Repeat("a", types.MaxPostContentLength),
+		strings.Repeat("b", types.MaxPostChunkLength),
+	}
+	content := strings.Join(chunks, "")
+	contentHash := sha256.Sum256([]byte(content))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	msg := NewCreateChunkedPostMsg(
+		string(user), "TestPostID", "title", hex.EncodeToString(contentHash[:]), int64(len(chunks)),
+		string(parent), parentPostID, "", "", "0", nil)
+	permlink := types.GetPermlink(user, msg.PostID)
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, pm.DoesPostDraftExist(ctx, permlink))
+	assert.False(t, pm.DoesPostExist(ctx, permlink))
+
+	// test post too often
+	result = handler(ctx, msg)
+	assert.Equal(t, ErrPostTooOften(user).Result(), result)
+
+	// test duplicate post draft
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(2*postParam.PostIntervalSec, 0)})
+	result = handler(ctx, msg)
+	assert.Equal(t, ErrPostDraftAlreadyExist(permlink).Result(), result)
+
+	// test create post with permlink of pending post draft
+	result = handler(ctx, NewCreatePostMsg(
+		string(user), msg.PostID, "title", "content", "", "", "", "", "0", nil))
+	assert.Equal(t, ErrPostDraftAlreadyExist(permlink).Result(), result)
+
+	// test upload chunk to post draft doesn't exist
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), "invalid", 0, chunks[0]))
+	assert.Equal(t, ErrPostDraftNotFound(types.GetPermlink(user, "invalid")).Result(), result)
+
+	// test chunk index out of range
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 2, chunks[0]))
+	assert.Equal(t, ErrInvalidPostChunkIndex(2).Result(), result)
+
+	// post is not created before all chunks are received
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 1, chunks[1]))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.False(t, pm.DoesPostExist(ctx, permlink))
+
+	// test content hash mismatch
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 0, "invalid"))
+	assert.Equal(t, ErrPostContentHashMismatch(permlink).Result(), result)
+	assert.False(t, pm.DoesPostExist(ctx, permlink))
+
+	// upload chunk again overwrites the invalid one
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 0, chunks[0]))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, pm.DoesPostExist(ctx, permlink))
+	assert.False(t, pm.DoesPostDraftExist(ctx, permlink))
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, content, postInfo.Content)
+	assert.Equal(t, parent, postInfo.ParentAuthor)
+	_, err = pm.postStorage.GetPostComment(ctx, parentPermlink, permlink)
+	assert.Nil(t, err)
+	assert.False(t, pm.postStorage.DoesPostChunkExist(ctx, permlink, 0))
+	assert.False(t, pm.postStorage.DoesPostChunkExist(ctx, permlink, 1))
+}
+
+func TestHandlerExpirePostDraft(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, _ := ph.GetPostParam(ctx)
+	user := createTestAccount(t, ctx, am, "user1")
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+
+	chunks := []string{"chunk0", "chunk1"}
+	contentHash := sha256.Sum256([]byte(strings.Join(chunks, "")))
+	curTime := ctx.BlockHeader().Time.Unix()
+	msg := NewCreateChunkedPostMsg(
+		string(user), "TestPostID", "title", hex.EncodeToString(contentHash[:]), int64(len(chunks)),
+		"", "", "", "", "0", nil)
+	permlink := types.GetPermlink(user, msg.PostID)
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 0, chunks[0]))
+	assert.Equal(t, sdk.Result{}, result)
+
+	err := gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	event := ExpirePostDraftEvent{Author: user, PostID: msg.PostID}
+	eventList := gm.GetTimeEventListAtTime(ctx, curTime+types.PostDraftTimeoutSec)
+	assert.Equal(t, types.TimeEventList{Events: []types.Event{event}}, *eventList)
+
+	// draft is kept before it expires
+	err = event.Execute(ctx, pm)
+	assert.Nil(t, err)
+	assert.True(t, pm.DoesPostDraftExist(ctx, permlink))
+	assert.True(t, pm.postStorage.DoesPostChunkExist(ctx, permlink, 0))
+
+	// expired draft and its chunks are removed
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(curTime+types.PostDraftTimeoutSec, 0)})
+	err = event.Execute(ctx, pm)
+	assert.Nil(t, err)
+	assert.False(t, pm.DoesPostDraftExist(ctx, permlink))
+	assert.False(t, pm.postStorage.DoesPostChunkExist(ctx, permlink, 0))
+	assert.False(t, pm.DoesPostExist(ctx, permlink))
+
+	// upload chunk after draft expired
+	result = handler(ctx, NewUploadPostChunkMsg(string(user), msg.PostID, 1, chunks[1]))
+	assert.Equal(t, ErrPostDraftNotFound(permlink).Result(), result)
+
+	// event of removed draft is no-op
+	err = event.Execute(ctx, pm)
+	assert.Nil(t, err)
+}
+
 func TestHandlerUpdatePost(t *testing.T) {
 	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
 	handler := NewHandler(pm, am, &gm, dm, rm)
@@ -880,6 +1005,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 		targetPostID         string
 		lastReportOrUpvoteAt int64
 		expectResult         sdk.Result
+		expectReportCoinDay  types.Coin
+		expectUpvoteCoinDay  types.Coin
 	}{
 		{
 			testName:             "user1 report",
@@ -889,6 +1016,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
 			expectResult:         sdk.Result{},
+			expectReportCoinDay:  initCoin,
+			expectUpvoteCoinDay:  types.NewCoinFromInt64(0),
 		},
 		{
 			testName:             "user2 report",
@@ -898,6 +1027,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
 			expectResult:         sdk.Result{},
+			expectReportCoinDay:  initCoin.Plus(initCoin),
+			expectUpvoteCoinDay:  types.NewCoinFromInt64(0),
 		},
 		{
 			testName:             "user3 upvote",
@@ -907,6 +1038,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
 			expectResult:         sdk.Result{},
+			expectReportCoinDay:  initCoin.Plus(initCoin),
+			expectUpvoteCoinDay:  initCoin,
 		},
 		{
 			testName:             "user1 wanna change report to upvote",
@@ -916,6 +1049,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
 			expectResult:         sdk.Result{},
+			expectReportCoinDay:  initCoin,
+			expectUpvoteCoinDay:  initCoin.Plus(initCoin),
 		},
 		{
 			testName:             "user1 report too often",
@@ -958,8 +1093,8 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			LastActivityAt:          newCtx.BlockHeader().Time.Unix(),
 			AllowReplies:            true,
 			RedistributionSplitRate: sdk.ZeroDec(),
-			TotalReportCoinDay:      types.NewCoinFromInt64(0),
-			TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
+			TotalReportCoinDay:      tc.expectReportCoinDay,
+			TotalUpvoteCoinDay:      tc.expectUpvoteCoinDay,
 			TotalReward:             types.NewCoinFromInt64(0),
 		}
 		targetPost := types.GetPermlink(types.AccountKey(tc.targetPostAuthor), tc.targetPostID)
@@ -973,6 +1108,83 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 	}
 }
 
+func TestHandlerReportEscalation(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	coinDayParam, _ := ph.GetCoinDayParam(ctx)
+	postParam, _ := ph.GetPostParam(ctx)
+	proposalParam, _ := ph.GetProposalParam(ctx)
+	proposalParam.ContentCensorshipEscalationRatio = sdk.OneDec()
+	proposalParam.ContentCensorshipEscalationMinReport = initCoin.Plus(initCoin)
+	err := param.ChangeParamEvent{Param: *proposalParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	user4 := createTestAccount(t, ctx, am, "user4")
+	permlink := types.GetPermlink(user1, postID)
+
+	baseTime := ctx.BlockHeader().Time.Unix() + coinDayParam.SecondsToRecoverCoinDay
+
+	testCases := []struct {
+		testName           string
+		reportOrUpvoteUser types.AccountKey
+		isReport           bool
+		expectPending      bool
+	}{
+		{
+			testName:           "user2 upvote",
+			reportOrUpvoteUser: user2,
+			isReport:           false,
+			expectPending:      false,
+		},
+		{
+			testName:           "user3 report doesn't reach min report",
+			reportOrUpvoteUser: user3,
+			isReport:           true,
+			expectPending:      false,
+		},
+		{
+			testName:           "user4 report exceeds escalation threshold",
+			reportOrUpvoteUser: user4,
+			isReport:           true,
+			expectPending:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		lastReportOrUpvoteAtCtx := ctx.WithBlockHeader(
+			abci.Header{ChainID: "Lino", Time: time.Unix(baseTime-postParam.ReportOrUpvoteIntervalSec, 0)})
+		am.UpdateLastReportOrUpvoteAt(lastReportOrUpvoteAtCtx, tc.reportOrUpvoteUser)
+
+		newCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+		msg := NewReportOrUpvoteMsg(string(tc.reportOrUpvoteUser), string(user1), postID, tc.isReport)
+		result := handler(newCtx, msg)
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+
+		isPending, err := pm.IsCensorshipPending(ctx, permlink)
+		if err != nil {
+			t.Errorf("%s: failed to get censorship pending, got err %v", tc.testName, err)
+		}
+		if isPending != tc.expectPending {
+			t.Errorf("%s: diff pending, got %v, want %v", tc.testName, isPending, tc.expectPending)
+		}
+
+		escalationList, err := pm.GetCensorshipEscalationList(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get censorship escalation list, got err %v", tc.testName, err)
+		}
+		if tc.expectPending {
+			assert.Equal(t, []types.Permlink{permlink}, escalationList)
+		} else {
+			assert.Equal(t, 0, len(escalationList))
+		}
+	}
+}
+
 func TestHandlerView(t *testing.T) {
 	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
 	handler := NewHandler(pm, am, &gm, dm, rm)
@@ -1,6 +1,10 @@
 package post
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/post/model"
@@ -119,6 +123,142 @@ func (pm PostManager) CreatePost(
 	return nil
 }
 
+// CreatePostDraft - create a long-form post draft, the post is created
+// after all content chunks are received.
+func (pm PostManager) CreatePostDraft(
+	ctx sdk.Context, author types.AccountKey, postID string,
+	sourceAuthor types.AccountKey, sourcePostID string,
+	parentAuthor types.AccountKey, parentPostID string,
+	title string, contentHash string, numOfChunks int64,
+	redistributionSplitRate sdk.Dec, links []types.IDToURLMapping) sdk.Error {
+	permlink := types.GetPermlink(author, postID)
+	if pm.DoesPostExist(ctx, permlink) {
+		return ErrPostAlreadyExist(permlink)
+	}
+	if pm.DoesPostDraftExist(ctx, permlink) {
+		return ErrPostDraftAlreadyExist(permlink)
+	}
+	draft := &model.PostDraft{
+		Info: model.PostInfo{
+			PostID:       postID,
+			Title:        title,
+			Author:       author,
+			ParentAuthor: parentAuthor,
+			ParentPostID: parentPostID,
+			SourceAuthor: sourceAuthor,
+			SourcePostID: sourcePostID,
+			Links:        links,
+		},
+		ContentHash:             strings.ToLower(contentHash),
+		NumOfChunks:             numOfChunks,
+		ReceivedChunks:          0,
+		RedistributionSplitRate: redistributionSplitRate,
+		ExpiredAt:               ctx.BlockHeader().Time.Unix() + types.PostDraftTimeoutSec,
+	}
+	return pm.postStorage.SetPostDraft(ctx, draft)
+}
+
+// GetPostDraftExpiredAt - get time when incomplete post draft is removed
+func (pm PostManager) GetPostDraftExpiredAt(ctx sdk.Context, permlink types.Permlink) (int64, sdk.Error) {
+	draft, err := pm.postStorage.GetPostDraft(ctx, permlink)
+	if err != nil {
+		return 0, err
+	}
+	return draft.ExpiredAt, nil
+}
+
+// RemoveExpiredPostDraft - remove post draft and its chunks if it is still incomplete
+// after expired, published draft is already removed.
+func (pm PostManager) RemoveExpiredPostDraft(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	if !pm.postStorage.DoesPostDraftExist(ctx, permlink) {
+		return nil
+	}
+	draft, err := pm.postStorage.GetPostDraft(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if draft.ExpiredAt > ctx.BlockHeader().Time.Unix() {
+		return nil
+	}
+	for i := int64(0); i < draft.NumOfChunks; i++ {
+		pm.postStorage.DeletePostChunk(ctx, permlink, i)
+	}
+	pm.postStorage.DeletePostDraft(ctx, permlink)
+	return nil
+}
+
+// DoesPostDraftExist - check if long-form post draft exist
+func (pm PostManager) DoesPostDraftExist(ctx sdk.Context, permlink types.Permlink) bool {
+	return pm.postStorage.DoesPostDraftExist(ctx, permlink)
+}
+
+// AddPostChunk - add content chunk to post draft, uploading the same index again
+// overwrites the previous chunk. Once all chunks are received the post is published.
+func (pm PostManager) AddPostChunk(
+	ctx sdk.Context, permlink types.Permlink, index int64, chunk string) sdk.Error {
+	draft, err := pm.postStorage.GetPostDraft(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= draft.NumOfChunks {
+		return ErrInvalidPostChunkIndex(index)
+	}
+	if !pm.postStorage.DoesPostChunkExist(ctx, permlink, index) {
+		draft.ReceivedChunks++
+	}
+	if err := pm.postStorage.SetPostChunk(ctx, permlink, index, chunk); err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetPostDraft(ctx, draft); err != nil {
+		return err
+	}
+	if draft.ReceivedChunks < draft.NumOfChunks {
+		return nil
+	}
+	return pm.publishPostDraft(ctx, permlink, draft)
+}
+
+// publishPostDraft - assemble content chunks, check content hash and create the post
+func (pm PostManager) publishPostDraft(
+	ctx sdk.Context, permlink types.Permlink, draft *model.PostDraft) sdk.Error {
+	chunks := make([]string, 0, draft.NumOfChunks)
+	for i := int64(0); i < draft.NumOfChunks; i++ {
+		chunk, err := pm.postStorage.GetPostChunk(ctx, permlink, i)
+		if err != nil {
+			return err
+		}
+		chunks = append(chunks, chunk)
+	}
+	content := strings.Join(chunks, "")
+	contentHash := sha256.Sum256([]byte(content))
+	if hex.EncodeToString(contentHash[:]) != draft.ContentHash {
+		return ErrPostContentHashMismatch(permlink)
+	}
+
+	info := draft.Info
+	if err := pm.CreatePost(
+		ctx, info.Author, info.PostID, info.SourceAuthor, info.SourcePostID,
+		info.ParentAuthor, info.ParentPostID, content, info.Title,
+		draft.RedistributionSplitRate, info.Links); err != nil {
+		return err
+	}
+	if len(info.ParentAuthor) > 0 || len(info.ParentPostID) > 0 {
+		parentPostKey := types.GetPermlink(info.ParentAuthor, info.ParentPostID)
+		if !pm.DoesPostExist(ctx, parentPostKey) {
+			return ErrPostNotFound(parentPostKey)
+		}
+		if err := pm.AddComment(ctx, parentPostKey, info.Author, info.PostID); err != nil {
+			return err
+		}
+	}
+
+	for i := int64(0); i < draft.NumOfChunks; i++ {
+		pm.postStorage.DeletePostChunk(ctx, permlink, i)
+	}
+	pm.postStorage.DeletePostDraft(ctx, permlink)
+	return nil
+}
+
 // UpdatePost - update post title, content and links. Can't update a deleted post
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
@@ -237,6 +377,68 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	return nil
 }
 
+// CensorPost - delete post by content censorship, a copy of the post is retained for appeal
+func (pm PostManager) CensorPost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	censored := &model.CensoredPost{
+		Info:                    *postInfo,
+		RedistributionSplitRate: postMeta.RedistributionSplitRate,
+	}
+	if err := pm.postStorage.SetCensoredPost(ctx, censored); err != nil {
+		return err
+	}
+	return pm.DeletePost(ctx, permlink)
+}
+
+// IsCensored - check if a post is deleted by content censorship and can be restored
+func (pm PostManager) IsCensored(ctx sdk.Context, permlink types.Permlink) bool {
+	return pm.postStorage.DoesCensoredPostExist(ctx, permlink)
+}
+
+// GetCensoredPostAuthor - get author of post deleted by content censorship
+func (pm PostManager) GetCensoredPostAuthor(ctx sdk.Context, permlink types.Permlink) (types.AccountKey, sdk.Error) {
+	censored, err := pm.postStorage.GetCensoredPost(ctx, permlink)
+	if err != nil {
+		return types.AccountKey(""), err
+	}
+	return censored.Info.Author, nil
+}
+
+// RestoreCensoredPost - restore post content from retained copy after appeal passed
+func (pm PostManager) RestoreCensoredPost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	censored, err := pm.postStorage.GetCensoredPost(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetPostInfo(ctx, &censored.Info); err != nil {
+		return err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.IsDeleted = false
+	postMeta.RedistributionSplitRate = censored.RedistributionSplitRate
+	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	pm.postStorage.DeleteCensoredPost(ctx, permlink)
+	return nil
+}
+
+// DiscardCensoredPost - censorship is final, retained copy is no longer needed
+func (pm PostManager) DiscardCensoredPost(ctx sdk.Context, permlink types.Permlink) {
+	pm.postStorage.DeleteCensoredPost(ctx, permlink)
+}
+
 // IsDeleted - check if a post is deleted or not
 func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -259,6 +461,135 @@ func (pm PostManager) UpdateLastActivityAt(ctx sdk.Context, permlink types.Perml
 	return nil
 }
 
+// ReportOrUpvoteToPost - record report or upvote coin day from user to post,
+// previous report or upvote from the same user will be replaced
+func (pm PostManager) ReportOrUpvoteToPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey,
+	coinDay types.Coin, isReport bool) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if reportOrUpvote, err := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user); err == nil {
+		if reportOrUpvote.IsReport {
+			postMeta.TotalReportCoinDay = postMeta.TotalReportCoinDay.Minus(reportOrUpvote.CoinDay)
+		} else {
+			postMeta.TotalUpvoteCoinDay = postMeta.TotalUpvoteCoinDay.Minus(reportOrUpvote.CoinDay)
+		}
+	}
+	if isReport {
+		postMeta.TotalReportCoinDay = postMeta.TotalReportCoinDay.Plus(coinDay)
+	} else {
+		postMeta.TotalUpvoteCoinDay = postMeta.TotalUpvoteCoinDay.Plus(coinDay)
+	}
+	reportOrUpvote := &model.ReportOrUpvote{
+		Username:  user,
+		CoinDay:   coinDay,
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+		IsReport:  isReport,
+	}
+	if err := pm.postStorage.SetPostReportOrUpvote(ctx, permlink, reportOrUpvote); err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsCensorshipEscalationRequired - check if report coin day of a post
+// exceeds the threshold to open a content censorship proposal automatically
+func (pm PostManager) IsCensorshipEscalationRequired(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	proposalParam, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return false, err
+	}
+	// zero ratio means auto escalation is disabled
+	if !proposalParam.ContentCensorshipEscalationRatio.GT(sdk.ZeroDec()) {
+		return false, nil
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	if postMeta.IsDeleted || postMeta.IsCensorshipPending {
+		return false, nil
+	}
+	if !postMeta.TotalReportCoinDay.IsGTE(proposalParam.ContentCensorshipEscalationMinReport) {
+		return false, nil
+	}
+	threshold := types.DecToCoin(
+		postMeta.TotalUpvoteCoinDay.ToDec().Mul(proposalParam.ContentCensorshipEscalationRatio))
+	return postMeta.TotalReportCoinDay.IsGT(threshold), nil
+}
+
+// MarkCensorshipPending - hide post from reward and add it to censorship escalation queue
+func (pm PostManager) MarkCensorshipPending(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.IsCensorshipPending = true
+	postMeta.CensorshipProposalID = ""
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return pm.postStorage.SetCensorshipEscalation(ctx, permlink)
+}
+
+// SetCensorshipPendingProposal - record content censorship proposal opened by escalation of pending post
+func (pm PostManager) SetCensorshipPendingProposal(
+	ctx sdk.Context, permlink types.Permlink, proposalID types.ProposalKey) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.CensorshipProposalID = proposalID
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClearCensorshipPending - post is visible to reward again after escalated censorship proposal is decided,
+// pending mark is kept if proposal ID doesn't match the escalated one. Empty proposal ID releases
+// post which is not escalated yet.
+func (pm PostManager) ClearCensorshipPending(
+	ctx sdk.Context, permlink types.Permlink, proposalID types.ProposalKey) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if !postMeta.IsCensorshipPending || postMeta.CensorshipProposalID != proposalID {
+		return nil
+	}
+	postMeta.IsCensorshipPending = false
+	postMeta.CensorshipProposalID = ""
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsCensorshipPending - check if a post is waiting for content censorship result
+func (pm PostManager) IsCensorshipPending(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	return postMeta.IsCensorshipPending, nil
+}
+
+// GetCensorshipEscalationList - get all posts waiting to be escalated
+func (pm PostManager) GetCensorshipEscalationList(ctx sdk.Context) ([]types.Permlink, sdk.Error) {
+	return pm.postStorage.GetCensorshipEscalationList(ctx)
+}
+
+// RemoveCensorshipEscalation - remove post from censorship escalation queue
+func (pm PostManager) RemoveCensorshipEscalation(ctx sdk.Context, permlink types.Permlink) {
+	pm.postStorage.DeleteCensorshipEscalation(ctx, permlink)
+}
+
 // GetPenaltyScore - get penalty score from report and upvote
 func (pm PostManager) GetPenaltyScore(ctx sdk.Context, reputation types.Coin) (sdk.Dec, sdk.Error) {
 	if reputation.IsNotNegative() {
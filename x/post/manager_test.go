@@ -546,3 +546,74 @@ func TestDeletePost(t *testing.T) {
 	assert.Nil(t, err)
 	checkIsDelete(t, ctx, pm, types.GetPermlink(user, postID))
 }
+
+func TestCensorPost(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0.5")
+	permlink := types.GetPermlink(user, postID)
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	splitRate, err := pm.GetRedistributionSplitRate(ctx, permlink)
+	assert.Nil(t, err)
+
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	checkIsDelete(t, ctx, pm, permlink)
+	assert.True(t, pm.IsCensored(ctx, permlink))
+	author, err := pm.GetCensoredPostAuthor(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, user, author)
+
+	err = pm.RestoreCensoredPost(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, pm.IsCensored(ctx, permlink))
+	restoredInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, *postInfo, *restoredInfo)
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, postMeta.IsDeleted)
+	assert.True(t, splitRate.Equal(postMeta.RedistributionSplitRate))
+
+	// retained copy is discarded once censorship is final
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	pm.DiscardCensoredPost(ctx, permlink)
+	assert.False(t, pm.IsCensored(ctx, permlink))
+	_, err = pm.GetCensoredPostAuthor(ctx, permlink)
+	assert.NotNil(t, err)
+}
+
+func TestAppealCensoredPostAfterImport(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0.5")
+	permlink := types.GetPermlink(user, postID)
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	splitRate, err := pm.GetRedistributionSplitRate(ctx, permlink)
+	assert.Nil(t, err)
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+
+	tables := pm.Export(ctx)
+	assert.Equal(t, 1, len(tables.CensoredPosts))
+
+	// retained copy survives export and import, appeal can still restore the post
+	newCtx := getContext(1)
+	pm.Import(newCtx, tables.ToIR())
+	assert.True(t, pm.IsCensored(newCtx, permlink))
+	author, err := pm.GetCensoredPostAuthor(newCtx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, user, author)
+
+	err = pm.RestoreCensoredPost(newCtx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, pm.IsCensored(newCtx, permlink))
+	restoredInfo, err := pm.postStorage.GetPostInfo(newCtx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, *postInfo, *restoredInfo)
+	postMeta, err := pm.postStorage.GetPostMeta(newCtx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, postMeta.IsDeleted)
+	assert.True(t, splitRate.Equal(postMeta.RedistributionSplitRate))
+}
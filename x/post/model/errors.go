@@ -97,3 +97,58 @@ func ErrFailedToUnmarshalPostView(err error) sdk.Error {
 func ErrFailedToUnmarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostDonations, fmt.Sprintf("failed to unmarshal post donations: %s", err.Error()))
 }
+
+// ErrFailedToMarshalEscalationQueue - error if marshal censorship escalation queue failed
+func ErrFailedToMarshalEscalationQueue(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalEscalationQueue, fmt.Sprintf("failed to marshal censorship escalation queue: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalEscalationQueue - error if unmarshal censorship escalation queue failed
+func ErrFailedToUnmarshalEscalationQueue(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalEscalationQueue, fmt.Sprintf("failed to unmarshal censorship escalation queue: %s", err.Error()))
+}
+
+// ErrPostDraftNotFound - error if post draft is not found in KVStore
+func ErrPostDraftNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostDraftNotFound, fmt.Sprintf("post draft is not found for key: %s", key))
+}
+
+// ErrFailedToMarshalPostDraft - error if marshal post draft failed
+func ErrFailedToMarshalPostDraft(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostDraft, fmt.Sprintf("failed to marshal post draft: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostDraft - error if unmarshal post draft failed
+func ErrFailedToUnmarshalPostDraft(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostDraft, fmt.Sprintf("failed to unmarshal post draft: %s", err.Error()))
+}
+
+// ErrPostChunkNotFound - error if post chunk is not found in KVStore
+func ErrPostChunkNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostChunkNotFound, fmt.Sprintf("post chunk is not found for key: %s", key))
+}
+
+// ErrFailedToMarshalPostChunk - error if marshal post chunk failed
+func ErrFailedToMarshalPostChunk(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostChunk, fmt.Sprintf("failed to marshal post chunk: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostChunk - error if unmarshal post chunk failed
+func ErrFailedToUnmarshalPostChunk(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostChunk, fmt.Sprintf("failed to unmarshal post chunk: %s", err.Error()))
+}
+
+// ErrCensoredPostNotFound - error if retained copy of censored post is not found in KVStore
+func ErrCensoredPostNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodeCensoredPostNotFound, fmt.Sprintf("censored post is not found for key: %s", key))
+}
+
+// ErrFailedToMarshalCensoredPost - error if marshal censored post failed
+func ErrFailedToMarshalCensoredPost(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCensoredPost, fmt.Sprintf("failed to marshal censored post: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCensoredPost - error if unmarshal censored post failed
+func ErrFailedToUnmarshalCensoredPost(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCensoredPost, fmt.Sprintf("failed to unmarshal censored post: %s", err.Error()))
+}
@@ -6,17 +6,19 @@ import (
 
 // PostMetaIR RedistributionSplitRate rat -> string
 type PostMetaIR struct {
-	CreatedAt               int64      `json:"created_at"`
-	LastUpdatedAt           int64      `json:"last_updated_at"`
-	LastActivityAt          int64      `json:"last_activity_at"`
-	AllowReplies            bool       `json:"allow_replies"`
-	IsDeleted               bool       `json:"is_deleted"`
-	TotalDonateCount        int64      `json:"total_donate_count"`
-	TotalReportCoinDay      types.Coin `json:"total_report_coin_day"`
-	TotalUpvoteCoinDay      types.Coin `json:"total_upvote_coin_day"`
-	TotalViewCount          int64      `json:"total_view_count"`
-	TotalReward             types.Coin `json:"total_reward"`
-	RedistributionSplitRate string     `json:"redistribution_split_rate"`
+	CreatedAt               int64             `json:"created_at"`
+	LastUpdatedAt           int64             `json:"last_updated_at"`
+	LastActivityAt          int64             `json:"last_activity_at"`
+	AllowReplies            bool              `json:"allow_replies"`
+	IsDeleted               bool              `json:"is_deleted"`
+	TotalDonateCount        int64             `json:"total_donate_count"`
+	TotalReportCoinDay      types.Coin        `json:"total_report_coin_day"`
+	TotalUpvoteCoinDay      types.Coin        `json:"total_upvote_coin_day"`
+	TotalViewCount          int64             `json:"total_view_count"`
+	TotalReward             types.Coin        `json:"total_reward"`
+	RedistributionSplitRate string            `json:"redistribution_split_rate"`
+	IsCensorshipPending     bool              `json:"is_censorship_pending"`
+	CensorshipProposalID    types.ProposalKey `json:"censorship_proposal_id"`
 }
 
 // PostRowIR - Meta changed
@@ -26,8 +28,39 @@ type PostRowIR struct {
 	Meta     PostMetaIR     `json:"meta"`
 }
 
-// PostTablesIR - PostRow changed.
+// PostDraftIR RedistributionSplitRate dec -> string
+type PostDraftIR struct {
+	Info                    PostInfo `json:"info"`
+	ContentHash             string   `json:"content_hash"`
+	NumOfChunks             int64    `json:"num_of_chunks"`
+	ReceivedChunks          int64    `json:"received_chunks"`
+	RedistributionSplitRate string   `json:"redistribution_split_rate"`
+	ExpiredAt               int64    `json:"expired_at"`
+}
+
+// PostDraftRowIR - Draft changed
+type PostDraftRowIR struct {
+	Permlink types.Permlink `json:"permlink"`
+	Draft    PostDraftIR    `json:"draft"`
+}
+
+// CensoredPostIR RedistributionSplitRate dec -> string
+type CensoredPostIR struct {
+	Info                    PostInfo `json:"info"`
+	RedistributionSplitRate string   `json:"redistribution_split_rate"`
+}
+
+// CensoredPostRowIR - Censored changed
+type CensoredPostRowIR struct {
+	Permlink types.Permlink `json:"permlink"`
+	Censored CensoredPostIR `json:"censored"`
+}
+
+// PostTablesIR - PostRow, PostDraftRow and CensoredPostRow changed.
 type PostTablesIR struct {
-	Posts     []PostRowIR   `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts         []PostRowIR         `json:"posts"`
+	PostUsers     []PostUserRow       `json:"post_users"`
+	PostDrafts    []PostDraftRowIR    `json:"post_drafts"`
+	PostChunks    []PostChunkRow      `json:"post_chunks"`
+	CensoredPosts []CensoredPostRowIR `json:"censored_posts"`
 }
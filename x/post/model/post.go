@@ -27,17 +27,19 @@ type PostInfo struct {
 
 // PostMeta - stores tiny and frequently updated fields.
 type PostMeta struct {
-	CreatedAt               int64      `json:"created_at"`
-	LastUpdatedAt           int64      `json:"last_updated_at"`
-	LastActivityAt          int64      `json:"last_activity_at"`
-	AllowReplies            bool       `json:"allow_replies"`
-	IsDeleted               bool       `json:"is_deleted"`
-	TotalDonateCount        int64      `json:"total_donate_count"`
-	TotalReportCoinDay      types.Coin `json:"total_report_coin_day"`
-	TotalUpvoteCoinDay      types.Coin `json:"total_upvote_coin_day"`
-	TotalViewCount          int64      `json:"total_view_count"`
-	TotalReward             types.Coin `json:"total_reward"`
-	RedistributionSplitRate sdk.Dec    `json:"redistribution_split_rate"`
+	CreatedAt               int64             `json:"created_at"`
+	LastUpdatedAt           int64             `json:"last_updated_at"`
+	LastActivityAt          int64             `json:"last_activity_at"`
+	AllowReplies            bool              `json:"allow_replies"`
+	IsDeleted               bool              `json:"is_deleted"`
+	TotalDonateCount        int64             `json:"total_donate_count"`
+	TotalReportCoinDay      types.Coin        `json:"total_report_coin_day"`
+	TotalUpvoteCoinDay      types.Coin        `json:"total_upvote_coin_day"`
+	TotalViewCount          int64             `json:"total_view_count"`
+	TotalReward             types.Coin        `json:"total_reward"`
+	RedistributionSplitRate sdk.Dec           `json:"redistribution_split_rate"`
+	IsCensorshipPending     bool              `json:"is_censorship_pending"`
+	CensorshipProposalID    types.ProposalKey `json:"censorship_proposal_id"`
 }
 
 // ToIR -
@@ -54,6 +56,47 @@ func (pm PostMeta) ToIR() PostMetaIR {
 		TotalViewCount:          pm.TotalViewCount,
 		TotalReward:             pm.TotalReward,
 		RedistributionSplitRate: pm.RedistributionSplitRate.String(), // XXX(yumin): rat to dec
+		IsCensorshipPending:     pm.IsCensorshipPending,
+		CensorshipProposalID:    pm.CensorshipProposalID,
+	}
+}
+
+// CensoredPost - copy of post deleted by content censorship, retained
+// so that the post can be restored if the author's appeal passes.
+type CensoredPost struct {
+	Info                    PostInfo `json:"info"`
+	RedistributionSplitRate sdk.Dec  `json:"redistribution_split_rate"`
+}
+
+// ToIR -
+func (cp CensoredPost) ToIR() CensoredPostIR {
+	return CensoredPostIR{
+		Info:                    cp.Info,
+		RedistributionSplitRate: cp.RedistributionSplitRate.String(),
+	}
+}
+
+// PostDraft - long-form post waiting for its content chunks,
+// Info.Content is empty until all chunks are received.
+// Draft is removed at ExpiredAt if it is still incomplete.
+type PostDraft struct {
+	Info                    PostInfo `json:"info"`
+	ContentHash             string   `json:"content_hash"`
+	NumOfChunks             int64    `json:"num_of_chunks"`
+	ReceivedChunks          int64    `json:"received_chunks"`
+	RedistributionSplitRate sdk.Dec  `json:"redistribution_split_rate"`
+	ExpiredAt               int64    `json:"expired_at"`
+}
+
+// ToIR -
+func (pd PostDraft) ToIR() PostDraftIR {
+	return PostDraftIR{
+		Info:                    pd.Info,
+		ContentHash:             pd.ContentHash,
+		NumOfChunks:             pd.NumOfChunks,
+		ReceivedChunks:          pd.ReceivedChunks,
+		RedistributionSplitRate: pd.RedistributionSplitRate.String(),
+		ExpiredAt:               pd.ExpiredAt,
 	}
 }
 
@@ -38,10 +38,48 @@ type PostUserRow struct {
 // 	Comment         Comment        `json:"comment"`
 // }
 
+// PostDraftRow - pk: permlink
+type PostDraftRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Draft    PostDraft      `json:"draft"`
+}
+
+// ToIR -
+func (p PostDraftRow) ToIR() PostDraftRowIR {
+	return PostDraftRowIR{
+		Permlink: p.Permlink,
+		Draft:    p.Draft.ToIR(),
+	}
+}
+
+// PostChunkRow - pk: (permlink, index)
+type PostChunkRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Index    int64          `json:"index"`
+	Chunk    string         `json:"chunk"`
+}
+
+// CensoredPostRow - pk: permlink
+type CensoredPostRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Censored CensoredPost   `json:"censored"`
+}
+
+// ToIR -
+func (p CensoredPostRow) ToIR() CensoredPostRowIR {
+	return CensoredPostRowIR{
+		Permlink: p.Permlink,
+		Censored: p.Censored.ToIR(),
+	}
+}
+
 // PostTables - state of post store.
 type PostTables struct {
-	Posts     []PostRow     `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts         []PostRow         `json:"posts"`
+	PostUsers     []PostUserRow     `json:"post_users"`
+	PostDrafts    []PostDraftRow    `json:"post_drafts"`
+	PostChunks    []PostChunkRow    `json:"post_chunks"`
+	CensoredPosts []CensoredPostRow `json:"censored_posts"`
 	// not exported for upgrade-1
 	// PostComments []PostCommentRow `json:"post_comments"`
 }
@@ -53,5 +91,12 @@ func (p PostTables) ToIR() *PostTablesIR {
 		rst.Posts = append(rst.Posts, v.ToIR())
 	}
 	rst.PostUsers = p.PostUsers
+	for _, v := range p.PostDrafts {
+		rst.PostDrafts = append(rst.PostDrafts, v.ToIR())
+	}
+	rst.PostChunks = p.PostChunks
+	for _, v := range p.CensoredPosts {
+		rst.CensoredPosts = append(rst.CensoredPosts, v.ToIR())
+	}
 	return rst
 }
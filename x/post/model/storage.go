@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strconv"
 	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +18,10 @@ var (
 	postViewsSubStore          = []byte{0x04} // SubStore for all views
 	// XXX(yukai): deprecated.
 	// postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	censorshipEscalationSubStore = []byte{0x06} // SubStore for posts waiting for censorship escalation
+	postDraftSubStore            = []byte{0x07} // SubStore for long-form posts waiting for content chunks
+	postChunkSubStore            = []byte{0x08} // SubStore for content chunks of long-form posts
+	censoredPostSubStore         = []byte{0x09} // SubStore for retained copy of censored posts
 )
 
 // PostStorage - post storage
@@ -178,6 +183,150 @@ func (ps PostStorage) SetPostView(ctx sdk.Context, permlink types.Permlink, post
 	return nil
 }
 
+// SetCensorshipEscalation - add post to censorship escalation queue
+func (ps PostStorage) SetCensorshipEscalation(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	permlinkBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(permlink)
+	if err != nil {
+		return ErrFailedToMarshalEscalationQueue(err)
+	}
+	store.Set(getCensorshipEscalationKey(permlink), permlinkBytes)
+	return nil
+}
+
+// DeleteCensorshipEscalation - remove post from censorship escalation queue
+func (ps PostStorage) DeleteCensorshipEscalation(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getCensorshipEscalationKey(permlink))
+}
+
+// GetCensorshipEscalationList - get all posts in censorship escalation queue
+func (ps PostStorage) GetCensorshipEscalationList(ctx sdk.Context) ([]types.Permlink, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, censorshipEscalationSubStore)
+	defer itr.Close()
+	permlinks := []types.Permlink{}
+	for ; itr.Valid(); itr.Next() {
+		var permlink types.Permlink
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &permlink); err != nil {
+			return nil, ErrFailedToUnmarshalEscalationQueue(err)
+		}
+		permlinks = append(permlinks, permlink)
+	}
+	return permlinks, nil
+}
+
+// DoesPostDraftExist - check if a post draft exists in KVStore or not
+func (ps PostStorage) DoesPostDraftExist(ctx sdk.Context, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getPostDraftKey(permlink))
+}
+
+// GetPostDraft - get post draft from KVStore
+func (ps PostStorage) GetPostDraft(ctx sdk.Context, permlink types.Permlink) (*PostDraft, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	draftBytes := store.Get(getPostDraftKey(permlink))
+	if draftBytes == nil {
+		return nil, ErrPostDraftNotFound(getPostDraftKey(permlink))
+	}
+	draft := new(PostDraft)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(draftBytes, draft); err != nil {
+		return nil, ErrFailedToUnmarshalPostDraft(err)
+	}
+	return draft, nil
+}
+
+// SetPostDraft - set post draft to KVStore
+func (ps PostStorage) SetPostDraft(ctx sdk.Context, draft *PostDraft) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	draftBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*draft)
+	if err != nil {
+		return ErrFailedToMarshalPostDraft(err)
+	}
+	store.Set(getPostDraftKey(types.GetPermlink(draft.Info.Author, draft.Info.PostID)), draftBytes)
+	return nil
+}
+
+// DeletePostDraft - delete post draft from KVStore
+func (ps PostStorage) DeletePostDraft(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostDraftKey(permlink))
+}
+
+// DoesCensoredPostExist - check if a censored post copy exists in KVStore or not
+func (ps PostStorage) DoesCensoredPostExist(ctx sdk.Context, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getCensoredPostKey(permlink))
+}
+
+// GetCensoredPost - get censored post copy from KVStore
+func (ps PostStorage) GetCensoredPost(ctx sdk.Context, permlink types.Permlink) (*CensoredPost, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	censoredBytes := store.Get(getCensoredPostKey(permlink))
+	if censoredBytes == nil {
+		return nil, ErrCensoredPostNotFound(getCensoredPostKey(permlink))
+	}
+	censored := new(CensoredPost)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(censoredBytes, censored); err != nil {
+		return nil, ErrFailedToUnmarshalCensoredPost(err)
+	}
+	return censored, nil
+}
+
+// SetCensoredPost - set censored post copy to KVStore
+func (ps PostStorage) SetCensoredPost(ctx sdk.Context, censored *CensoredPost) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	censoredBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*censored)
+	if err != nil {
+		return ErrFailedToMarshalCensoredPost(err)
+	}
+	store.Set(getCensoredPostKey(types.GetPermlink(censored.Info.Author, censored.Info.PostID)), censoredBytes)
+	return nil
+}
+
+// DeleteCensoredPost - delete censored post copy from KVStore
+func (ps PostStorage) DeleteCensoredPost(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getCensoredPostKey(permlink))
+}
+
+// DoesPostChunkExist - check if a content chunk of post draft exists in KVStore or not
+func (ps PostStorage) DoesPostChunkExist(ctx sdk.Context, permlink types.Permlink, index int64) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getPostChunkKey(permlink, index))
+}
+
+// GetPostChunk - get content chunk of post draft from KVStore
+func (ps PostStorage) GetPostChunk(ctx sdk.Context, permlink types.Permlink, index int64) (string, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	chunkBytes := store.Get(getPostChunkKey(permlink, index))
+	if chunkBytes == nil {
+		return "", ErrPostChunkNotFound(getPostChunkKey(permlink, index))
+	}
+	var chunk string
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(chunkBytes, &chunk); err != nil {
+		return "", ErrFailedToUnmarshalPostChunk(err)
+	}
+	return chunk, nil
+}
+
+// SetPostChunk - set content chunk of post draft to KVStore
+func (ps PostStorage) SetPostChunk(ctx sdk.Context, permlink types.Permlink, index int64, chunk string) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	chunkBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(chunk)
+	if err != nil {
+		return ErrFailedToMarshalPostChunk(err)
+	}
+	store.Set(getPostChunkKey(permlink, index), chunkBytes)
+	return nil
+}
+
+// DeletePostChunk - delete content chunk of post draft from KVStore
+func (ps PostStorage) DeletePostChunk(ctx sdk.Context, permlink types.Permlink, index int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostChunkKey(permlink, index))
+}
+
 // Export post storage state.
 func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
 	tables := &PostTables{}
@@ -229,6 +378,70 @@ func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
 			tables.PostUsers = append(tables.PostUsers, row)
 		}
 	}()
+	// export tables.PostDrafts
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, postDraftSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlink := types.Permlink(k[1:])
+			draft, err := ps.GetPostDraft(ctx, permlink)
+			if err != nil {
+				panic("failed to read post draft: " + err.Error())
+			}
+			row := PostDraftRow{
+				Permlink: permlink,
+				Draft:    *draft,
+			}
+			tables.PostDrafts = append(tables.PostDrafts, row)
+		}
+	}()
+	// export tables.PostChunks
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, postChunkSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlinkIndex := string(k[1:])
+			sep := strings.LastIndex(permlinkIndex, types.KeySeparator)
+			if sep < 0 {
+				panic("failed to split out permlink index: " + permlinkIndex)
+			}
+			permlink := types.Permlink(permlinkIndex[:sep])
+			index, err := strconv.ParseInt(permlinkIndex[sep+len(types.KeySeparator):], 10, 64)
+			if err != nil {
+				panic("failed to parse chunk index: " + err.Error())
+			}
+			chunk, getErr := ps.GetPostChunk(ctx, permlink, index)
+			if getErr != nil {
+				panic("failed to read post chunk: " + getErr.Error())
+			}
+			row := PostChunkRow{
+				Permlink: permlink,
+				Index:    index,
+				Chunk:    chunk,
+			}
+			tables.PostChunks = append(tables.PostChunks, row)
+		}
+	}()
+	// export tables.CensoredPosts
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, censoredPostSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlink := types.Permlink(k[1:])
+			censored, err := ps.GetCensoredPost(ctx, permlink)
+			if err != nil {
+				panic("failed to read censored post: " + err.Error())
+			}
+			row := CensoredPostRow{
+				Permlink: permlink,
+				Censored: *censored,
+			}
+			tables.CensoredPosts = append(tables.CensoredPosts, row)
+		}
+	}()
 	return tables
 }
 
@@ -255,6 +468,8 @@ func (ps PostStorage) Import(ctx sdk.Context, tb *PostTablesIR) {
 			TotalViewCount:          v.Meta.TotalViewCount,
 			TotalReward:             v.Meta.TotalReward,
 			RedistributionSplitRate: sdk.MustNewDecFromStr(v.Meta.RedistributionSplitRate),
+			IsCensorshipPending:     v.Meta.IsCensorshipPending,
+			CensorshipProposalID:    v.Meta.CensorshipProposalID,
 		})
 		check(err)
 	}
@@ -263,6 +478,31 @@ func (ps PostStorage) Import(ctx sdk.Context, tb *PostTablesIR) {
 		err := ps.SetPostReportOrUpvote(ctx, v.Permlink, &v.ReportOrUpvote)
 		check(err)
 	}
+	// import PostDrafts
+	for _, v := range tb.PostDrafts {
+		err := ps.SetPostDraft(ctx, &PostDraft{
+			Info:                    v.Draft.Info,
+			ContentHash:             v.Draft.ContentHash,
+			NumOfChunks:             v.Draft.NumOfChunks,
+			ReceivedChunks:          v.Draft.ReceivedChunks,
+			RedistributionSplitRate: sdk.MustNewDecFromStr(v.Draft.RedistributionSplitRate),
+			ExpiredAt:               v.Draft.ExpiredAt,
+		})
+		check(err)
+	}
+	// import PostChunks
+	for _, v := range tb.PostChunks {
+		err := ps.SetPostChunk(ctx, v.Permlink, v.Index, v.Chunk)
+		check(err)
+	}
+	// import CensoredPosts
+	for _, v := range tb.CensoredPosts {
+		err := ps.SetCensoredPost(ctx, &CensoredPost{
+			Info:                    v.Censored.Info,
+			RedistributionSplitRate: sdk.MustNewDecFromStr(v.Censored.RedistributionSplitRate),
+		})
+		check(err)
+	}
 }
 
 // GetPostInfoPrefix - "post info substore" + "author"
@@ -312,3 +552,23 @@ func getPostCommentPrefix(permlink types.Permlink) []byte {
 func getPostCommentKey(permlink types.Permlink, commentPermlink types.Permlink) []byte {
 	return append(getPostCommentPrefix(permlink), commentPermlink...)
 }
+
+// getCensorshipEscalationKey - "censorship escalation substore" + "permlink"
+func getCensorshipEscalationKey(permlink types.Permlink) []byte {
+	return append(censorshipEscalationSubStore, permlink...)
+}
+
+// getPostDraftKey - "post draft substore" + "permlink"
+func getPostDraftKey(permlink types.Permlink) []byte {
+	return append(postDraftSubStore, permlink...)
+}
+
+// getCensoredPostKey - "censored post substore" + "permlink"
+func getCensoredPostKey(permlink types.Permlink) []byte {
+	return append(censoredPostSubStore, permlink...)
+}
+
+// getPostChunkKey - "post chunk substore" + "permlink" + "index"
+func getPostChunkKey(permlink types.Permlink, index int64) []byte {
+	return append(append(append(postChunkSubStore, permlink...), types.KeySeparator...), strconv.FormatInt(index, 10)...)
+}
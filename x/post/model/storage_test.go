@@ -110,6 +110,87 @@ func TestPostView(t *testing.T) {
 	})
 }
 
+func TestPostDraftAndChunk(t *testing.T) {
+	draft := PostDraft{
+		Info: PostInfo{
+			PostID: "long",
+			Title:  "Long Post",
+			Author: types.AccountKey("author"),
+			Links:  nil,
+		},
+		ContentHash:             "hash",
+		NumOfChunks:             2,
+		ReceivedChunks:          1,
+		RedistributionSplitRate: sdk.ZeroDec(),
+	}
+	permlink := types.GetPermlink(draft.Info.Author, draft.Info.PostID)
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ps.DoesPostDraftExist(env.ctx, permlink))
+		err := env.ps.SetPostDraft(env.ctx, &draft)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesPostDraftExist(env.ctx, permlink))
+
+		resultPtr, err := env.ps.GetPostDraft(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, draft, *resultPtr, "Post draft should be equal")
+
+		err = env.ps.SetPostChunk(env.ctx, permlink, 1, "你好👌")
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesPostChunkExist(env.ctx, permlink, 1))
+		assert.False(t, env.ps.DoesPostChunkExist(env.ctx, permlink, 0))
+		chunk, err := env.ps.GetPostChunk(env.ctx, permlink, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "你好👌", chunk)
+
+		env.ps.DeletePostChunk(env.ctx, permlink, 1)
+		assert.False(t, env.ps.DoesPostChunkExist(env.ctx, permlink, 1))
+		env.ps.DeletePostDraft(env.ctx, permlink)
+		assert.False(t, env.ps.DoesPostDraftExist(env.ctx, permlink))
+	})
+}
+
+func TestExportImportPostDraftAndChunk(t *testing.T) {
+	draft := PostDraft{
+		Info: PostInfo{
+			PostID: "long/post",
+			Title:  "Long Post",
+			Author: types.AccountKey("author"),
+			Links:  nil,
+		},
+		ContentHash:             "hash",
+		NumOfChunks:             12,
+		ReceivedChunks:          2,
+		RedistributionSplitRate: types.NewDecFromRat(1, 10),
+		ExpiredAt:               100,
+	}
+	permlink := types.GetPermlink(draft.Info.Author, draft.Info.PostID)
+
+	runTest(t, func(env TestEnv) {
+		assert.Nil(t, env.ps.SetPostDraft(env.ctx, &draft))
+		assert.Nil(t, env.ps.SetPostChunk(env.ctx, permlink, 1, "first"))
+		assert.Nil(t, env.ps.SetPostChunk(env.ctx, permlink, 10, "tenth"))
+
+		tables := env.ps.Export(env.ctx)
+		assert.Equal(t, []PostDraftRow{{Permlink: permlink, Draft: draft}}, tables.PostDrafts)
+		assert.Equal(t, []PostChunkRow{
+			{Permlink: permlink, Index: 1, Chunk: "first"},
+			{Permlink: permlink, Index: 10, Chunk: "tenth"},
+		}, tables.PostChunks)
+
+		ctx := getContext()
+		env.ps.Import(ctx, tables.ToIR())
+		draftPtr, err := env.ps.GetPostDraft(ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, draft, *draftPtr)
+		for _, row := range tables.PostChunks {
+			chunk, err := env.ps.GetPostChunk(ctx, permlink, row.Index)
+			assert.Nil(t, err)
+			assert.Equal(t, row.Chunk, chunk)
+		}
+	})
+}
+
 //
 // Test Environment setup
 //
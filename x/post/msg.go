@@ -1,6 +1,8 @@
 package post
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"unicode/utf8"
 
@@ -15,6 +17,8 @@ var _ types.Msg = DeletePostMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = CreateChunkedPostMsg{}
+var _ types.Msg = UploadPostChunkMsg{}
 
 // CreatePostMsg contains information to create a post
 type CreatePostMsg struct {
@@ -30,6 +34,30 @@ type CreatePostMsg struct {
 	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
 }
 
+// CreateChunkedPostMsg - create a long-form post, content is uploaded
+// by UploadPostChunkMsg and must match the sha256 hex content hash.
+type CreateChunkedPostMsg struct {
+	Author                  types.AccountKey       `json:"author"`
+	PostID                  string                 `json:"post_id"`
+	Title                   string                 `json:"title"`
+	ContentHash             string                 `json:"content_hash"`
+	NumOfChunks             int64                  `json:"num_of_chunks"`
+	ParentAuthor            types.AccountKey       `json:"parent_author"`
+	ParentPostID            string                 `json:"parent_postID"`
+	SourceAuthor            types.AccountKey       `json:"source_author"`
+	SourcePostID            string                 `json:"source_postID"`
+	Links                   []types.IDToURLMapping `json:"links"`
+	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+}
+
+// UploadPostChunkMsg - upload one content chunk of long-form post
+type UploadPostChunkMsg struct {
+	Author types.AccountKey `json:"author"`
+	PostID string           `json:"post_id"`
+	Index  int64            `json:"index"`
+	Chunk  string           `json:"chunk"`
+}
+
 // UpdatePostMsg - update post
 type UpdatePostMsg struct {
 	Author  types.AccountKey       `json:"author"`
@@ -89,6 +117,36 @@ func NewCreatePostMsg(
 	}
 }
 
+// NewCreateChunkedPostMsg - constructs a long-form post msg
+func NewCreateChunkedPostMsg(
+	author, postID, title, contentHash string, numOfChunks int64,
+	parentAuthor, parentPostID, sourceAuthor, sourcePostID, redistributionSplitRate string,
+	links []types.IDToURLMapping) CreateChunkedPostMsg {
+	return CreateChunkedPostMsg{
+		Author:                  types.AccountKey(author),
+		PostID:                  postID,
+		Title:                   title,
+		ContentHash:             contentHash,
+		NumOfChunks:             numOfChunks,
+		ParentAuthor:            types.AccountKey(parentAuthor),
+		ParentPostID:            parentPostID,
+		SourceAuthor:            types.AccountKey(sourceAuthor),
+		SourcePostID:            sourcePostID,
+		Links:                   links,
+		RedistributionSplitRate: redistributionSplitRate,
+	}
+}
+
+// NewUploadPostChunkMsg - constructs a upload post chunk msg
+func NewUploadPostChunkMsg(author, postID string, index int64, chunk string) UploadPostChunkMsg {
+	return UploadPostChunkMsg{
+		Author: types.AccountKey(author),
+		PostID: postID,
+		Index:  index,
+		Chunk:  chunk,
+	}
+}
+
 // NewUpdatePostMsg - constructs a UpdatePost msg
 func NewUpdatePostMsg(
 	author, postID, title, content string, links []types.IDToURLMapping) UpdatePostMsg {
@@ -179,6 +237,18 @@ func (msg ViewMsg) Route() string { return RouterKey }
 // Type - implements sdk.Msg
 func (msg ViewMsg) Type() string { return "ViewMsg" }
 
+// Route - implements sdk.Msg
+func (msg CreateChunkedPostMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg CreateChunkedPostMsg) Type() string { return "CreateChunkedPostMsg" }
+
+// Route - implements sdk.Msg
+func (msg UploadPostChunkMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg UploadPostChunkMsg) Type() string { return "UploadPostChunkMsg" }
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink exists
@@ -310,6 +380,74 @@ func (msg ViewMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg CreateChunkedPostMsg) ValidateBasic() sdk.Error {
+	if len(msg.PostID) == 0 {
+		return ErrNoPostID()
+	}
+	if len(msg.PostID) > types.MaximumLengthOfPostID {
+		return ErrPostIDTooLong()
+	}
+	if len(msg.Author) == 0 {
+		return ErrNoAuthor()
+	}
+	if (len(msg.ParentAuthor) > 0 || len(msg.ParentPostID) > 0) &&
+		(len(msg.SourceAuthor) > 0 || len(msg.SourcePostID) > 0) {
+		return ErrCommentAndRepostConflict()
+	}
+	if utf8.RuneCountInString(msg.Title) > types.MaxPostTitleLength {
+		return ErrPostTitleExceedMaxLength()
+	}
+	if hash, err := hex.DecodeString(msg.ContentHash); err != nil || len(hash) != sha256.Size {
+		return ErrInvalidPostContentHash()
+	}
+	if msg.NumOfChunks <= 0 || msg.NumOfChunks > types.MaxPostChunks {
+		return ErrInvalidNumOfPostChunks()
+	}
+	if len(msg.RedistributionSplitRate) > types.MaximumSdkRatLength {
+		return ErrRedistributionSplitRateLengthTooLong()
+	}
+
+	if len(msg.Links) > types.MaximumNumOfLinks {
+		return ErrTooManyURL()
+	}
+
+	for _, link := range msg.Links {
+		if len(link.Identifier) > types.MaximumLinkIdentifier {
+			return ErrIdentifierLengthTooLong()
+		}
+		if len(link.URL) > types.MaximumLinkURL {
+			return ErrURLLengthTooLong()
+		}
+	}
+
+	splitRate, err := sdk.NewDecFromStr(msg.RedistributionSplitRate)
+	if err != nil {
+		return err
+	}
+	if splitRate.LT(sdk.ZeroDec()) || splitRate.GT(sdk.OneDec()) {
+		return ErrInvalidPostRedistributionSplitRate()
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg UploadPostChunkMsg) ValidateBasic() sdk.Error {
+	if len(msg.PostID) == 0 {
+		return ErrNoPostID()
+	}
+	if len(msg.Author) == 0 {
+		return ErrNoAuthor()
+	}
+	if msg.Index < 0 || msg.Index >= types.MaxPostChunks {
+		return ErrInvalidPostChunkIndex(msg.Index)
+	}
+	if utf8.RuneCountInString(msg.Chunk) > types.MaxPostChunkLength {
+		return ErrPostChunkExceedMaxLength()
+	}
+	return nil
+}
+
 // GetPermission - implements types.Msg
 func (msg CreatePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -340,6 +478,16 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg CreateChunkedPostMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg UploadPostChunkMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -370,6 +518,16 @@ func (msg ViewMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg CreateChunkedPostMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg UploadPostChunkMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
 	if err != nil {
@@ -408,6 +566,16 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg CreateChunkedPostMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg UploadPostChunkMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
+}
+
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
@@ -443,6 +611,18 @@ func (msg ViewMsg) String() string {
 		msg.Username, msg.Author, msg.PostID)
 }
 
+func (msg CreateChunkedPostMsg) String() string {
+	return fmt.Sprintf("Post.CreateChunkedPostMsg{author:%v, postID:%v, title:%v, content hash:%v, chunks:%v,"+
+		"parentAuthor:%v, parentPostID:%v, sourceAuthor:%v, sourcePostID:%v, links:%v, redistribution split rate:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.ContentHash, msg.NumOfChunks, msg.ParentAuthor, msg.ParentPostID,
+		msg.SourceAuthor, msg.SourcePostID, msg.Links, msg.RedistributionSplitRate)
+}
+
+func (msg UploadPostChunkMsg) String() string {
+	return fmt.Sprintf("Post.UploadPostChunkMsg{author:%v, postID:%v, index:%v, chunk length:%v}",
+		msg.Author, msg.PostID, msg.Index, utf8.RuneCountInString(msg.Chunk))
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -473,3 +653,13 @@ func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 func (msg ViewMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// GetConsumeAmount - implements types.Msg
+func (msg CreateChunkedPostMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg UploadPostChunkMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -1,6 +1,7 @@
 package post
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/lino-network/lino/types"
@@ -605,6 +606,146 @@ func TestViewMsg(t *testing.T) {
 	}
 }
 
+func TestCreateChunkedPostMsg(t *testing.T) {
+	contentHash := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	testCases := []struct {
+		testName      string
+		msg           CreateChunkedPostMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName: "normal case",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, 2, "", "", "", "", "0", nil),
+			expectedError: nil,
+		},
+		{
+			testName: "upper case content hash",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", strings.ToUpper(contentHash), 2, "", "", "", "", "0", nil),
+			expectedError: nil,
+		},
+		{
+			testName: "max number of chunks",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, types.MaxPostChunks, "", "", "", "", "0", nil),
+			expectedError: nil,
+		},
+		{
+			testName: "no post id",
+			msg: NewCreateChunkedPostMsg(
+				"author", "", "title", contentHash, 2, "", "", "", "", "0", nil),
+			expectedError: ErrNoPostID(),
+		},
+		{
+			testName: "no author",
+			msg: NewCreateChunkedPostMsg(
+				"", "postID", "title", contentHash, 2, "", "", "", "", "0", nil),
+			expectedError: ErrNoAuthor(),
+		},
+		{
+			testName: "comment and repost conflict",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, 2, "parent", "parentPostID", "source", "sourcePostID", "0", nil),
+			expectedError: ErrCommentAndRepostConflict(),
+		},
+		{
+			testName: "title exceeds max length",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", string(make([]byte, types.MaxPostTitleLength+1)), contentHash, 2, "", "", "", "", "0", nil),
+			expectedError: ErrPostTitleExceedMaxLength(),
+		},
+		{
+			testName: "content hash is not hex",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", strings.Repeat("z", 64), 2, "", "", "", "", "0", nil),
+			expectedError: ErrInvalidPostContentHash(),
+		},
+		{
+			testName: "content hash is too short",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash[:62], 2, "", "", "", "", "0", nil),
+			expectedError: ErrInvalidPostContentHash(),
+		},
+		{
+			testName: "no chunks",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, 0, "", "", "", "", "0", nil),
+			expectedError: ErrInvalidNumOfPostChunks(),
+		},
+		{
+			testName: "too many chunks",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, types.MaxPostChunks+1, "", "", "", "", "0", nil),
+			expectedError: ErrInvalidNumOfPostChunks(),
+		},
+		{
+			testName: "invalid redistribution split rate",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", contentHash, 2, "", "", "", "", "1.1", nil),
+			expectedError: ErrInvalidPostRedistributionSplitRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestUploadPostChunkMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           UploadPostChunkMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewUploadPostChunkMsg("author", "postID", 0, "chunk"),
+			expectedError: nil,
+		},
+		{
+			testName:      "chunk of max length",
+			msg:           NewUploadPostChunkMsg("author", "postID", types.MaxPostChunks-1, strings.Repeat("好", types.MaxPostChunkLength)),
+			expectedError: nil,
+		},
+		{
+			testName:      "no post id",
+			msg:           NewUploadPostChunkMsg("author", "", 0, "chunk"),
+			expectedError: ErrNoPostID(),
+		},
+		{
+			testName:      "no author",
+			msg:           NewUploadPostChunkMsg("", "postID", 0, "chunk"),
+			expectedError: ErrNoAuthor(),
+		},
+		{
+			testName:      "negative index",
+			msg:           NewUploadPostChunkMsg("author", "postID", -1, "chunk"),
+			expectedError: ErrInvalidPostChunkIndex(-1),
+		},
+		{
+			testName:      "index exceeds max number of chunks",
+			msg:           NewUploadPostChunkMsg("author", "postID", types.MaxPostChunks, "chunk"),
+			expectedError: ErrInvalidPostChunkIndex(types.MaxPostChunks),
+		},
+		{
+			testName:      "chunk exceeds max length",
+			msg:           NewUploadPostChunkMsg("author", "postID", 0, strings.Repeat("a", types.MaxPostChunkLength+1)),
+			expectedError: ErrPostChunkExceedMaxLength(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -663,6 +804,17 @@ func TestMsgPermission(t *testing.T) {
 				"author", "postID", "title", "content", []types.IDToURLMapping{}),
 			expectedPermission: types.AppPermission,
 		},
+		{
+			testName: "create chunked post",
+			msg: NewCreateChunkedPostMsg(
+				"author", "postID", "title", "hash", 2, "", "", "", "", "0", nil),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName:           "upload post chunk",
+			msg:                NewUploadPostChunkMsg("author", "postID", 0, "chunk"),
+			expectedPermission: types.AppPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -60,6 +60,7 @@ func setupTest(
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(ExpirePostDraftEvent{}, "event/expirePostDraft", nil)
 
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
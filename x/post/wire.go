@@ -12,6 +12,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DonateMsg{}, "lino/donate", nil)
 	cdc.RegisterConcrete(ViewMsg{}, "lino/view", nil)
 	cdc.RegisterConcrete(ReportOrUpvoteMsg{}, "lino/reportOrUpvote", nil)
+	cdc.RegisterConcrete(CreateChunkedPostMsg{}, "lino/createChunkedPost", nil)
+	cdc.RegisterConcrete(UploadPostChunkMsg{}, "lino/uploadPostChunk", nil)
 }
 
 var msgCdc = wire.New()
@@ -0,0 +1,48 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelProposalTxCmd will create a cancelProposal tx and sign it with the given key
+func CancelProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal",
+		Short: "cancel a proposal before voting starts",
+		RunE:  sendCancelProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "creator of the proposal")
+	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
+	return cmd
+}
+
+func sendCancelProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagUser)
+		id := viper.GetInt64(client.FlagProposalID)
+
+		// create the message
+		msg := proposal.NewCancelProposalMsg(creator, id)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChangeCoinDayParamTxCmd will create a changeCoinDayParam tx and sign it with the given key
+func ChangeCoinDayParamTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-coin-day-param",
+		Short: "propose to change coin day parameter",
+		RunE:  sendChangeCoinDayParamTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "creator of the proposal")
+	cmd.Flags().Int64(client.FlagSeconds, 0, "seconds for incoming coin day to be fully charged")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	cmd.Flags().String(client.FlagDeposit, "", "initial deposit, omit to pay full minimum deposit")
+	return cmd
+}
+
+func sendChangeCoinDayParamTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagUser)
+		parameter := param.CoinDayParam{
+			SecondsToRecoverCoinDay: viper.GetInt64(client.FlagSeconds),
+		}
+
+		// create the message
+		msg := proposal.NewChangeCoinDayParamMsg(creator, parameter, viper.GetString(client.FlagReason))
+		msg.Deposit = viper.GetString(client.FlagDeposit)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChangeReputationParamTxCmd will create a changeReputationParam tx and sign it with the given key
+func ChangeReputationParamTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-reputation-param",
+		Short: "propose to change reputation parameter",
+		RunE:  sendChangeReputationParamTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "creator of the proposal")
+	cmd.Flags().Int(client.FlagBestContentIndexN, 0, "number of contents indexed every round")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	cmd.Flags().String(client.FlagDeposit, "", "initial deposit, omit to pay full minimum deposit")
+	return cmd
+}
+
+func sendChangeReputationParamTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagUser)
+		parameter := param.ReputationParam{
+			BestContentIndexN: viper.GetInt(client.FlagBestContentIndexN),
+		}
+
+		// create the message
+		msg := proposal.NewChangeReputationParamMsg(creator, parameter, viper.GetString(client.FlagReason))
+		msg.Deposit = viper.GetString(client.FlagDeposit)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
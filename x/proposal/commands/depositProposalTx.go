@@ -0,0 +1,50 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepositProposalTxCmd will create a depositProposal tx and sign it with the given key
+func DepositProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-proposal",
+		Short: "top up deposit of a proposal waiting for deposit",
+		RunE:  sendDepositProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "depositor of the proposal")
+	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
+	cmd.Flags().String(client.FlagDeposit, "", "amount of LNO to deposit")
+	return cmd
+}
+
+func sendDepositProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		depositor := viper.GetString(client.FlagUser)
+		id := viper.GetInt64(client.FlagProposalID)
+		deposit := viper.GetString(client.FlagDeposit)
+
+		// create the message
+		msg := proposal.NewDepositProposalMsg(depositor, id, deposit)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -9,7 +9,9 @@ import (
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
 	"github.com/lino-network/lino/x/proposal/model"
 )
 
@@ -39,6 +41,19 @@ func GetExpiredProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetProposalCmd returns a proposal with its tally and projected result
+func GetProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "proposal <proposal-id>",
+		Short: "Query a proposal with its tally and projected result",
+		RunE:  cmdr.getProposalCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -95,3 +110,62 @@ func (c commander) getExpiredProposalCmd(cmd *cobra.Command, args []string) erro
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getProposalCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide proposal ID")
+	}
+
+	proposalID := types.ProposalKey(args[0])
+	// proposal is stored with proposal storage codec
+	proposalCdc := wire.New()
+	model.RegisterWire(proposalCdc)
+
+	var p model.Proposal
+	status := ""
+	for _, s := range []struct {
+		status string
+		key    []byte
+	}{
+		{model.ProposalStatusOngoing, model.GetOngoingProposalKey(proposalID)},
+		{model.ProposalStatusPending, model.GetPendingProposalKey(proposalID)},
+		{model.ProposalStatusExpired, model.GetExpiredProposalKey(proposalID)},
+	} {
+		res, err := ctx.Query(s.key, c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			continue
+		}
+		if err := proposalCdc.UnmarshalBinaryLengthPrefixed(res, &p); err != nil {
+			return err
+		}
+		status = s.status
+		break
+	}
+	if p == nil {
+		return errors.New("proposal not found")
+	}
+
+	res, err := ctx.Query(param.GetProposalParamKey(), types.ParamKVStoreKey)
+	if err != nil {
+		return err
+	}
+	proposalParam := new(param.ProposalParam)
+	if err := wire.New().UnmarshalBinaryLengthPrefixed(res, proposalParam); err != nil {
+		return err
+	}
+
+	detail, err := proposal.NewProposalDetail(p, status, proposalParam)
+	if err != nil {
+		return err
+	}
+	output, err := wire.MarshalJSONIndent(proposalCdc, detail)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
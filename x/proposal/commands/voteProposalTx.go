@@ -24,6 +24,7 @@ func VoteProposalTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagVoter, "", "voter for the proposal")
 	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
 	cmd.Flags().Bool(client.FlagResult, true, "vote result")
+	cmd.Flags().Int64(client.FlagConviction, 0, "lock stake longer after proposal ends to multiply voting power")
 	return cmd
 }
 
@@ -33,9 +34,10 @@ func sendVoteProposalTx(cdc *wire.Codec) client.CommandTxCallback {
 		voter := viper.GetString(client.FlagVoter)
 		id := viper.GetInt64(client.FlagProposalID)
 		result := viper.GetBool(client.FlagResult)
+		conviction := viper.GetInt64(client.FlagConviction)
 
 		// create the message
-		msg := proposal.NewVoteProposalMsg(voter, id, result)
+		msg := proposal.NewVoteProposalMsg(voter, id, result, conviction)
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -17,6 +17,11 @@ func ErrPostNotFound() sdk.Error {
 	return types.NewError(types.CodePostNotFound, fmt.Sprintf("post is not found"))
 }
 
+// ErrDeveloperNotFound - error when developer is not found
+func ErrDeveloperNotFound() sdk.Error {
+	return types.NewError(types.CodeDeveloperNotFound, fmt.Sprintf("developer is not found"))
+}
+
 // ErrCensorshipPostIsDeleted - error when censorship post is already deleted
 func ErrCensorshipPostIsDeleted(permlink types.Permlink) sdk.Error {
 	return types.NewError(types.CodeCensorshipPostIsDeleted, fmt.Sprintf("censorship post %v is deleted", permlink))
@@ -76,3 +81,68 @@ func ErrIllegalParameter() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeProposalQueryFailed, fmt.Sprintf("query proposal store failed"))
 }
+
+// ErrIllegalVoteWeight - error if weights of a split vote are illegal
+func ErrIllegalVoteWeight() sdk.Error {
+	return types.NewError(types.CodeIllegalVoteWeight, fmt.Sprintf("vote weights must be non-negative and sum up to %v", types.VoteWeightBase))
+}
+
+// ErrInvalidProposalTitle - error if text proposal title is empty or too long
+func ErrInvalidProposalTitle() sdk.Error {
+	return types.NewError(types.CodeInvalidProposalTitle, fmt.Sprintf("invalid proposal title"))
+}
+
+// ErrProposalDescriptionTooLong - error if text proposal description is too long
+func ErrProposalDescriptionTooLong() sdk.Error {
+	return types.NewError(types.CodeProposalDescriptionTooLong, fmt.Sprintf("proposal description is too long"))
+}
+
+// ErrNotPendingProposal - error if proposal is not waiting for deposit
+func ErrNotPendingProposal() sdk.Error {
+	return types.NewError(types.CodeNotPendingProposal, fmt.Sprintf("proposal is not waiting for deposit"))
+}
+
+// ErrNotProposalCreator - error if user is not the creator of proposal
+func ErrNotProposalCreator() sdk.Error {
+	return types.NewError(types.CodeNotProposalCreator, fmt.Sprintf("only creator can cancel the proposal"))
+}
+
+// ErrInvalidUpgradePlan - error if upgrade name or height of protocol upgrade is invalid
+func ErrInvalidUpgradePlan() sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradePlan, fmt.Sprintf("invalid upgrade plan"))
+}
+
+// ErrInvalidParamBundle - error if bundled parameters are empty or contain same parameter twice
+func ErrInvalidParamBundle() sdk.Error {
+	return types.NewError(types.CodeInvalidParamBundle, fmt.Sprintf("invalid parameter bundle"))
+}
+
+// ErrCensorshipNotAppealable - error if proposal is not a passed content censorship or is appealed already
+func ErrCensorshipNotAppealable() sdk.Error {
+	return types.NewError(types.CodeCensorshipNotAppealable, fmt.Sprintf("content censorship is not appealable"))
+}
+
+// ErrAppealWindowClosed - error if appeal is filed too long after content censorship is decided
+func ErrAppealWindowClosed() sdk.Error {
+	return types.NewError(types.CodeAppealWindowClosed, fmt.Sprintf("censorship appeal window is closed"))
+}
+
+// ErrNotCensoredPostAuthor - error if appeal is not filed by author of censored post
+func ErrNotCensoredPostAuthor() sdk.Error {
+	return types.NewError(types.CodeNotCensoredPostAuthor, fmt.Sprintf("only author of censored post can appeal"))
+}
+
+// ErrIllegalConviction - error if conviction of a vote is negative
+func ErrIllegalConviction() sdk.Error {
+	return types.NewError(types.CodeIllegalConviction, fmt.Sprintf("conviction can't be negative"))
+}
+
+// ErrIllegalSlashRatio - error if slash ratio of developer punish proposal is out of range
+func ErrIllegalSlashRatio() sdk.Error {
+	return types.NewError(types.CodeIllegalSlashRatio, fmt.Sprintf("slash ratio must be between 0 and 1, and positive if developer isn't revoked"))
+}
+
+// ErrUpgradePlanPending - error if propose named upgrade while another upgrade plan is pending
+func ErrUpgradePlanPending() sdk.Error {
+	return types.NewError(types.CodeUpgradePlanPending, fmt.Sprintf("another upgrade plan is pending"))
+}
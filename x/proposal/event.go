@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 	val "github.com/lino-network/lino/x/validator"
 )
 
@@ -17,11 +18,31 @@ type DecideProposalEvent struct {
 	ProposalID   types.ProposalKey  `json:"proposal_id"`
 }
 
+// ExpirePendingProposalEvent - event at the end of deposit period of pending proposal,
+// refunds all contributors if minimum deposit is not reached
+type ExpirePendingProposalEvent struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
+// Execute - expire proposal still pending, proposal activated or cancelled is ignored
+func (epe ExpirePendingProposalEvent) Execute(
+	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
+	dm dev.DeveloperManager, gm *global.GlobalManager) sdk.Error {
+	if !proposalManager.IsPendingProposal(ctx, epe.ProposalID) {
+		return nil
+	}
+	funding, err := proposalManager.ExpirePendingProposal(ctx, epe.ProposalID)
+	if err != nil {
+		return err
+	}
+	return refundProposalFunding(ctx, am, proposalManager, dm, gm, epe.ProposalID, funding)
+}
+
 // Execute - execute proposal event, check vote and update status
 func (dpe DecideProposalEvent) Execute(
 	ctx sdk.Context, voteManager vote.VoteManager, valManager val.ValidatorManager,
 	am acc.AccountManager, proposalManager ProposalManager, postManager post.PostManager,
-	gm *global.GlobalManager) sdk.Error {
+	dm dev.DeveloperManager, gm *global.GlobalManager) sdk.Error {
 	// check it is ongoing proposal
 	if !proposalManager.IsOngoingProposal(ctx, dpe.ProposalID) {
 		return ErrOngoingProposalNotFound()
@@ -41,13 +62,17 @@ func (dpe DecideProposalEvent) Execute(
 	}
 
 	// punish validators who didn't vote
-	actualPenalty, err := valManager.PunishValidatorsDidntVote(ctx, penaltyList.PenaltyList)
+	actualPenalty, releasedBackings, err := valManager.PunishValidatorsDidntVote(ctx, penaltyList.PenaltyList)
 	if err != nil {
 		return err
 	}
 
-	// add coins back to inflation pool
-	if err := gm.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
+	// slashed coins go to community pool
+	if err := gm.AddToCommunityPool(ctx, actualPenalty); err != nil {
+		return err
+	}
+	// backings of fired validators are returned to backers
+	if err := val.ReturnBackings(ctx, valManager, gm, am, releasedBackings); err != nil {
 		return err
 	}
 
@@ -57,6 +82,23 @@ func (dpe DecideProposalEvent) Execute(
 	if err != nil {
 		return err
 	}
+	if dpe.ProposalType == types.ContentCensorship {
+		if err := dpe.ReleaseCensorshipEscalation(ctx, dpe.ProposalID, proposalManager, postManager, gm); err != nil {
+			return err
+		}
+	}
+	if dpe.ProposalType == types.DeveloperPunish {
+		punish, err := proposalManager.GetDeveloperPunish(ctx, dpe.ProposalID)
+		if err != nil {
+			return err
+		}
+		// developer can revoke again once punish proposal is decided
+		dm.ReleasePendingPunish(ctx, punish.Developer, dpe.ProposalID)
+	}
+	if dpe.ProposalType == types.CensorshipAppeal {
+		return dpe.SettleCensorshipAppeal(
+			ctx, dpe.ProposalID, proposalRes, proposalManager, postManager, am, gm)
+	}
 	// majority disagree this proposal
 	if proposalRes == types.ProposalNotPass {
 		return nil
@@ -73,7 +115,17 @@ func (dpe DecideProposalEvent) Execute(
 			return err
 		}
 	case types.ProtocolUpgrade:
-		if err := dpe.ExecuteProtocolUpgrade(ctx, dpe.ProposalID, proposalManager); err != nil {
+		if err := dpe.ExecuteProtocolUpgrade(ctx, dpe.ProposalID, proposalManager, gm); err != nil {
+			return err
+		}
+	case types.TextProposal:
+		// text proposal is non-binding, only the result is recorded
+	case types.CommunitySpend:
+		if err := dpe.ExecuteCommunityPoolSpend(ctx, dpe.ProposalID, proposalManager, am, gm); err != nil {
+			return err
+		}
+	case types.DeveloperPunish:
+		if err := dpe.ExecuteDeveloperPunish(ctx, dpe.ProposalID, proposalManager, am, dm, gm); err != nil {
 			return err
 		}
 	}
@@ -94,7 +146,7 @@ func (dpe DecideProposalEvent) ExecuteChangeParam(
 	return nil
 }
 
-// ExecuteContentCensorship - delete target post
+// ExecuteContentCensorship - delete target post, a copy is retained in case author appeals
 func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
 	postManager post.PostManager) sdk.Error {
@@ -107,14 +159,142 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
 		return ErrCensorshipPostNotFound()
 	}
-	if err := postManager.DeletePost(ctx, permlink); err != nil {
+	if err := postManager.CensorPost(ctx, permlink); err != nil {
 		return err
 	}
 	return nil
 }
 
-// ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
+// ReleaseCensorshipEscalation - target post is no longer pending after its escalated proposal is decided,
+// deposit of escalated proposal is returned to censorship escalation pool
+func (dpe DecideProposalEvent) ReleaseCensorshipEscalation(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager) sdk.Error {
+	permlink, err := proposalManager.GetPermlink(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if postManager.DoesPostExist(ctx, permlink) {
+		if err := postManager.ClearCensorshipPending(ctx, permlink, curID); err != nil {
+			return err
+		}
+	}
+	deposit, err := proposalManager.ReleaseEscalationDeposit(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if deposit.IsZero() {
+		return nil
+	}
+	return gm.AddToCensorshipEscalationPool(ctx, deposit)
+}
+
+// SettleCensorshipAppeal - passed appeal restores the post and refunds deposit to author,
+// deposit of failed appeal goes to community pool and the censorship becomes final
+func (dpe DecideProposalEvent) SettleCensorshipAppeal(
+	ctx sdk.Context, curID types.ProposalKey, proposalRes types.ProposalResult,
+	proposalManager ProposalManager, postManager post.PostManager,
+	am acc.AccountManager, gm *global.GlobalManager) sdk.Error {
+	appeal, err := proposalManager.GetCensorshipAppeal(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if proposalRes != types.ProposalPass {
+		postManager.DiscardCensoredPost(ctx, appeal.Permlink)
+		return gm.AddToCommunityPool(ctx, appeal.Deposit)
+	}
+	if postManager.IsCensored(ctx, appeal.Permlink) {
+		if err := postManager.RestoreCensoredPost(ctx, appeal.Permlink); err != nil {
+			return err
+		}
+	}
+	return returnCoinTo(ctx, appeal.Creator, gm, am, int64(1), 0, appeal.Deposit)
+}
+
+// ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, upgrade without
+// name need to be done manually. Named upgrade is scheduled at its height. Height is checked
+// when proposal is created, upgrade is only dropped if its height has passed or another
+// proposal passed before it has scheduled an upgrade.
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
-	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
-	return nil
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	gm *global.GlobalManager) sdk.Error {
+	upgrade, err := proposalManager.GetProtocolUpgrade(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if len(upgrade.Name) == 0 || upgrade.Height <= ctx.BlockHeight() || gm.DoesUpgradePlanExist(ctx) {
+		return nil
+	}
+	return gm.ScheduleUpgrade(ctx, curID, upgrade.Name, upgrade.Height, upgrade.Link)
+}
+
+// ExecuteCommunityPoolSpend - pay recipient from community pool,
+// spend is skipped if community pool can't afford it when proposal passed
+func (dpe DecideProposalEvent) ExecuteCommunityPoolSpend(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	am acc.AccountManager, gm *global.GlobalManager) sdk.Error {
+	spend, err := proposalManager.GetCommunityPoolSpend(ctx, curID)
+	if err != nil {
+		return err
+	}
+	pool, err := gm.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.IsGTE(spend.Amount) {
+		return nil
+	}
+	if err := gm.SpendFromCommunityPool(ctx, curID, spend.Recipient, spend.Amount, spend.Reason); err != nil {
+		return err
+	}
+	return am.AddSavingCoin(
+		ctx, spend.Recipient, spend.Amount, "", string(curID), types.CommunityPoolSpendIn)
+}
+
+// ExecuteDeveloperPunish - slash developer deposit to community pool, force revoked developer
+// can't register again until punish cooldown ends. Punish is skipped if developer has revoked.
+// Reward pool of force revoked developer is paid out to donors instead of returned to developer.
+func (dpe DecideProposalEvent) ExecuteDeveloperPunish(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	am acc.AccountManager, dm dev.DeveloperManager, gm *global.GlobalManager) sdk.Error {
+	punish, err := proposalManager.GetDeveloperPunish(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if !dm.DoesDeveloperExist(ctx, punish.Developer) {
+		return nil
+	}
+	slashed, err := dm.SlashDeposit(ctx, punish.Developer, punish.SlashRatio)
+	if err != nil {
+		return err
+	}
+	if err := gm.AddToCommunityPool(ctx, slashed); err != nil {
+		return err
+	}
+	if !punish.Revoke {
+		return nil
+	}
+	// reward pool is inflation set aside for users, pay it out to recorded donors and
+	// send balance without donation to community pool before the rest is returned
+	payouts, err := dm.DistributeRewardPool(ctx, punish.Developer)
+	if err != nil {
+		return err
+	}
+	for _, payout := range payouts {
+		if err := am.AddSavingCoin(
+			ctx, payout.Username, payout.Amount, punish.Developer, "", types.RewardPoolPayout); err != nil {
+			return err
+		}
+	}
+	undistributed, err := dm.WithdrawRewardPool(ctx, punish.Developer)
+	if err != nil {
+		return err
+	}
+	if err := gm.AddToCommunityPool(ctx, undistributed); err != nil {
+		return err
+	}
+	if err := dev.RevokeDeveloper(ctx, dm, am, gm, punish.Developer); err != nil {
+		return err
+	}
+	return dm.StartPunishCooldown(ctx, punish.Developer)
 }
@@ -2,15 +2,20 @@ package proposal
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestDecideProposal(t *testing.T) {
-	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	ctx, am, pm, postManager, voteManager, valManager, gm, dm := setupTest(t, 0)
 	voteManager.InitGenesis(ctx)
 	valManager.InitGenesis(ctx)
 	pm.InitGenesis(ctx)
@@ -182,7 +187,7 @@ func TestDecideProposal(t *testing.T) {
 
 	for _, cs := range cases {
 		if cs.decideProposal {
-			err := cs.event.Execute(ctx, voteManager, valManager, am, pm, postManager, &gm)
+			err := cs.event.Execute(ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
 			assert.Nil(t, err)
 			proposal, _ := pm.storage.GetExpiredProposal(ctx, cs.proposalID)
 			proposalInfo := proposal.GetProposalInfo()
@@ -194,7 +199,8 @@ func TestDecideProposal(t *testing.T) {
 		} else {
 			voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterRes)
 
-			err := pm.UpdateProposalVotingStatus(ctx, cs.proposalID, cs.voter, cs.voterRes, cs.votingPower)
+			vote := &votemodel.Vote{Voter: cs.voter, VotingPower: cs.votingPower, Result: cs.voterRes}
+			err := pm.UpdateProposalVotingStatus(ctx, cs.proposalID, cs.voter, nil, vote)
 			assert.Nil(t, err)
 		}
 
@@ -217,3 +223,192 @@ func TestDecideProposal(t *testing.T) {
 		assert.Equal(t, expectExpiredProposalList, expiredList)
 	}
 }
+
+func TestCensorshipEscalation(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm, dm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID", c4600, am, postManager, "0")
+	permlink := types.GetPermlink(user1, postID1)
+
+	// pool can't fund the deposit, post is released without proposal
+	err := postManager.MarkCensorshipPending(ctx, permlink)
+	assert.Nil(t, err)
+	EndBlocker(ctx, abci.RequestEndBlock{}, pm, postManager, &gm)
+	ongoingList, _ := pm.GetOngoingProposalList(ctx)
+	assert.Equal(t, 0, len(ongoingList))
+	isPending, _ := postManager.IsCensorshipPending(ctx, permlink)
+	assert.False(t, isPending)
+
+	// pool funds the deposit of escalated proposal
+	err = gm.AddToCensorshipEscalationPool(ctx, proposalParam.ContentCensorshipMinDeposit)
+	assert.Nil(t, err)
+	err = postManager.MarkCensorshipPending(ctx, permlink)
+	assert.Nil(t, err)
+	EndBlocker(ctx, abci.RequestEndBlock{}, pm, postManager, &gm)
+	ongoingList, _ = pm.GetOngoingProposalList(ctx)
+	assert.Equal(t, 1, len(ongoingList))
+	proposalID := ongoingList[0].GetProposalInfo().ProposalID
+	p, ok := ongoingList[0].(*model.ContentCensorshipProposal)
+	assert.True(t, ok)
+	assert.True(t, p.IsEscalated)
+	assert.Equal(t, permlink, p.Permlink)
+	pool, _ := gm.GetCensorshipEscalationPool(ctx)
+	assert.True(t, pool.IsZero())
+	isPending, _ = postManager.IsCensorshipPending(ctx, permlink)
+	assert.True(t, isPending)
+	escalationList, _ := postManager.GetCensorshipEscalationList(ctx)
+	assert.Equal(t, 0, len(escalationList))
+
+	// decision of other censorship proposal on the post doesn't release it
+	manual := pm.CreateContentCensorshipProposal(ctx, permlink, "reason")
+	manualID, err := pm.AddProposal(ctx, user1, manual, proposalParam.ContentCensorshipDecideSec)
+	assert.Nil(t, err)
+	err = DecideProposalEvent{ProposalType: types.ContentCensorship, ProposalID: manualID}.Execute(
+		ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
+	assert.Nil(t, err)
+	isPending, _ = postManager.IsCensorshipPending(ctx, permlink)
+	assert.True(t, isPending)
+	required, _ := postManager.IsCensorshipEscalationRequired(ctx, permlink)
+	assert.False(t, required)
+	pool, _ = gm.GetCensorshipEscalationPool(ctx)
+	assert.True(t, pool.IsZero())
+
+	// deposit is returned to pool and post is released after decided
+	event := DecideProposalEvent{
+		ProposalType: types.ContentCensorship,
+		ProposalID:   proposalID,
+	}
+	err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
+	assert.Nil(t, err)
+	pool, _ = gm.GetCensorshipEscalationPool(ctx)
+	assert.True(t, pool.IsEqual(proposalParam.ContentCensorshipMinDeposit))
+	isPending, _ = postManager.IsCensorshipPending(ctx, permlink)
+	assert.False(t, isPending)
+	isDeleted, _ := postManager.IsDeleted(ctx, permlink)
+	assert.False(t, isDeleted)
+}
+
+func TestCensorshipAppeal(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm, dm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	handler := NewHandler(am, pm, postManager, dm, &gm, voteManager)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", c4600, am, postManager, "0")
+	_, postID2 := createTestPost(t, ctx, "user1", "postID2", c4600, am, postManager, "0")
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	permlink1 := types.GetPermlink(user1, postID1)
+	permlink2 := types.GetPermlink(user1, postID2)
+
+	// censorship of both posts passes
+	censor := func(permlink types.Permlink) types.ProposalKey {
+		proposal := pm.CreateContentCensorshipProposal(ctx, permlink, "reason")
+		proposalID, err := pm.AddProposal(ctx, user2, proposal, proposalParam.ContentCensorshipDecideSec)
+		assert.Nil(t, err)
+		err = addProposalInfo(
+			ctx, pm, proposalID, proposalParam.ContentCensorshipPassVotes.Plus(c46), types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+		event := DecideProposalEvent{ProposalType: types.ContentCensorship, ProposalID: proposalID}
+		err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
+		assert.Nil(t, err)
+		isDeleted, _ := postManager.IsDeleted(ctx, permlink)
+		assert.True(t, isDeleted)
+		assert.True(t, postManager.IsCensored(ctx, permlink))
+		return proposalID
+	}
+	censorshipID1 := censor(permlink1)
+	censorshipID2 := censor(permlink2)
+	censorship1, err := pm.GetAppealableCensorship(ctx, censorshipID1)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName  string
+		ctx       sdk.Context
+		msg       AppealCensorshipMsg
+		wantRes   sdk.Result
+		wantSaved types.Coin
+	}{
+		{
+			testName: "only author can appeal",
+			ctx:      ctx,
+			msg:      NewAppealCensorshipMsg(string(user2), 1, "reason"),
+			wantRes:  ErrNotCensoredPostAuthor().Result(),
+		},
+		{
+			testName: "appeal window closed",
+			ctx: ctx.WithBlockHeader(abci.Header{
+				Time: time.Unix(censorship1.ExpiredAt+proposalParam.CensorshipAppealWindowSec+1, 0)}),
+			msg:     NewAppealCensorshipMsg(string(user1), 1, "reason"),
+			wantRes: ErrAppealWindowClosed().Result(),
+		},
+		{
+			testName:  "author appeals successfully",
+			ctx:       ctx,
+			msg:       NewAppealCensorshipMsg(string(user1), 1, "reason"),
+			wantRes:   sdk.Result{},
+			wantSaved: c4600.Minus(proposalParam.CensorshipAppealMinDeposit),
+		},
+		{
+			testName:  "censorship can only be appealed once",
+			ctx:       ctx,
+			msg:       NewAppealCensorshipMsg(string(user1), 1, "reason"),
+			wantRes:   ErrCensorshipNotAppealable().Result(),
+			wantSaved: c4600.Minus(proposalParam.CensorshipAppealMinDeposit),
+		},
+		{
+			testName: "author appeals another censorship",
+			ctx:      ctx,
+			msg:      NewAppealCensorshipMsg(string(user1), 2, "reason"),
+			wantRes:  sdk.Result{},
+			wantSaved: c4600.Minus(proposalParam.CensorshipAppealMinDeposit).Minus(
+				proposalParam.CensorshipAppealMinDeposit),
+		},
+	}
+	for _, tc := range testCases {
+		res := handler(tc.ctx, tc.msg)
+		assert.Equal(t, tc.wantRes, res, tc.testName)
+		if tc.wantRes.IsOK() {
+			saving, _ := am.GetSavingFromBank(ctx, user1)
+			if !saving.IsEqual(tc.wantSaved) {
+				t.Errorf("%s: diff saving, got %v, want %v", tc.testName, saving, tc.wantSaved)
+			}
+		}
+	}
+	ongoingList, _ := pm.GetOngoingProposalList(ctx)
+	assert.Equal(t, 2, len(ongoingList))
+	appealID1 := ongoingList[0].GetProposalInfo().ProposalID
+	appealID2 := ongoingList[1].GetProposalInfo().ProposalID
+	_, err = pm.GetAppealableCensorship(ctx, censorshipID2)
+	assert.Equal(t, ErrCensorshipNotAppealable(), err)
+
+	// passed appeal restores post and refunds deposit
+	err = addProposalInfo(
+		ctx, pm, appealID1, proposalParam.CensorshipAppealPassVotes.Plus(c46), types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	event := DecideProposalEvent{ProposalType: types.CensorshipAppeal, ProposalID: appealID1}
+	err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
+	assert.Nil(t, err)
+	isDeleted, _ := postManager.IsDeleted(ctx, permlink1)
+	assert.False(t, isDeleted)
+	assert.False(t, postManager.IsCensored(ctx, permlink1))
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, user1)
+	assert.Equal(t, 1, len(frozenMoneyList))
+	assert.True(t, frozenMoneyList[0].Amount.IsEqual(proposalParam.CensorshipAppealMinDeposit))
+
+	// failed appeal makes censorship final and deposit goes to community pool
+	poolBefore, _ := gm.GetCommunityPool(ctx)
+	event = DecideProposalEvent{ProposalType: types.CensorshipAppeal, ProposalID: appealID2}
+	err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, dm, &gm)
+	assert.Nil(t, err)
+	isDeleted, _ = postManager.IsDeleted(ctx, permlink2)
+	assert.True(t, isDeleted)
+	assert.False(t, postManager.IsCensored(ctx, permlink2))
+	poolAfter, _ := gm.GetCommunityPool(ctx)
+	assert.True(t, poolAfter.IsEqual(poolBefore.Plus(proposalParam.CensorshipAppealMinDeposit)))
+}
@@ -7,26 +7,46 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 )
 
 // NewHandler - Handle all "proposal" type messages.
 func NewHandler(
 	am acc.AccountManager, proposalManager ProposalManager,
-	postManager post.PostManager, gm *global.GlobalManager, vm vote.VoteManager) sdk.Handler {
+	postManager post.PostManager, dm dev.DeveloperManager, gm *global.GlobalManager,
+	vm vote.VoteManager) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case ChangeParamMsg:
 			return handleChangeParamMsg(ctx, am, proposalManager, gm, msg)
+		case ChangeParamsMsg:
+			return handleChangeParamsMsg(ctx, am, proposalManager, gm, msg)
 		case ContentCensorshipMsg:
 			return handleContentCensorshipMsg(ctx, am, proposalManager, postManager, gm, msg)
 		case ProtocolUpgradeMsg:
 			return handleProtocolUpgradeMsg(ctx, am, proposalManager, gm, msg)
+		case CommunityPoolSpendMsg:
+			return handleCommunityPoolSpendMsg(ctx, am, proposalManager, gm, msg)
+		case DeveloperPunishMsg:
+			return handleDeveloperPunishMsg(ctx, am, proposalManager, dm, gm, msg)
+		case TextProposalMsg:
+			return handleTextProposalMsg(ctx, am, proposalManager, gm, msg)
+		case DepositProposalMsg:
+			return handleDepositProposalMsg(ctx, am, proposalManager, gm, msg)
+		case CancelProposalMsg:
+			return handleCancelProposalMsg(ctx, am, proposalManager, dm, gm, msg)
+		case AppealCensorshipMsg:
+			return handleAppealCensorshipMsg(ctx, am, proposalManager, postManager, gm, msg)
 		case VoteProposalMsg:
 			return handleVoteProposalMsg(ctx, proposalManager, vm, msg)
+		case SplitVoteProposalMsg:
+			return handleSplitVoteProposalMsg(ctx, proposalManager, vm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized proposal Msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -40,6 +60,9 @@ func handleChangeParamMsg(
 	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
 		return ErrAccountNotFound().Result()
 	}
+	if err := msg.GetParameter().Validate(); err != nil {
+		return err.Result()
+	}
 
 	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
@@ -47,27 +70,35 @@ func handleChangeParamMsg(
 	}
 
 	proposal := pm.CreateChangeParamProposal(ctx, msg.GetParameter(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ChangeParamDecideSec)
-	if err != nil {
+	if err := startProposal(
+		ctx, am, pm, gm, msg.GetCreator(), proposal, types.ChangeParam,
+		param.ChangeParamDecideSec, param.ChangeParamMinDeposit, msg.GetDeposit()); err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ChangeParam, proposalID)
+	return sdk.Result{}
+}
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ChangeParamDecideSec, event); err != nil {
-		return err.Result()
+func handleChangeParamsMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg ChangeParamsMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound().Result()
+	}
+	for _, parameter := range msg.Parameters {
+		if err := parameter.Validate(); err != nil {
+			return err.Result()
+		}
 	}
 
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ChangeParamMinDeposit, "",
-		string(proposalID), types.ProposalDeposit); err != nil {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
+	proposal := pm.CreateChangeParamsProposal(ctx, msg.Parameters, msg.Reason)
+	if err := startProposal(
+		ctx, am, pm, gm, msg.Creator, proposal, types.ChangeParam,
+		param.ChangeParamDecideSec, param.ChangeParamMinDeposit, msg.Deposit); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -84,31 +115,107 @@ func handleProtocolUpgradeMsg(
 	if err != nil {
 		return err.Result()
 	}
+	if len(msg.GetName()) != 0 {
+		if gm.DoesUpgradePlanExist(ctx) {
+			return ErrUpgradePlanPending().Result()
+		}
+		// block interval is at least one second, upgrade height must not be
+		// reachable before the proposal is decided
+		if msg.GetHeight() <= ctx.BlockHeight()+param.ProtocolUpgradeDecideSec {
+			return ErrInvalidUpgradePlan().Result()
+		}
+	}
+
+	proposal := pm.CreateProtocolUpgradeProposal(
+		ctx, msg.GetLink(), msg.GetReason(), msg.GetName(), msg.GetHeight())
+	if err := startProposal(
+		ctx, am, pm, gm, msg.GetCreator(), proposal, types.ProtocolUpgrade,
+		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit, msg.GetDeposit()); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleTextProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg TextProposalMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound().Result()
+	}
+
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposal := pm.CreateTextProposal(ctx, msg.Title, msg.Description)
+	if err := startProposal(
+		ctx, am, pm, gm, msg.Creator, proposal, types.TextProposal,
+		param.TextProposalDecideSec, param.TextProposalMinDeposit, msg.Deposit); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleCommunityPoolSpendMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg CommunityPoolSpendMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound().Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Recipient) {
+		return ErrAccountNotFound().Result()
+	}
+	amount, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
 
-	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ProtocolUpgradeDecideSec)
+	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ProtocolUpgrade, proposalID)
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ProtocolUpgradeDecideSec, event); err != nil {
+	proposal := pm.CreateCommunityPoolSpendProposal(ctx, msg.Recipient, amount, msg.Reason)
+	if err := startProposal(
+		ctx, am, pm, gm, msg.Creator, proposal, types.CommunitySpend,
+		param.CommunitySpendDecideSec, param.CommunitySpendMinDeposit, msg.Deposit); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ProtocolUpgradeMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+func handleDeveloperPunishMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, dm dev.DeveloperManager,
+	gm *global.GlobalManager, msg DeveloperPunishMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound().Result()
+	}
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+	slashRatio, decErr := sdk.NewDecFromStr(msg.SlashRatio)
+	if decErr != nil {
+		return ErrIllegalSlashRatio().Result()
+	}
+
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit); err != nil {
+	proposalID, err := pm.GetNextProposalID(ctx)
+	if err != nil {
 		return err.Result()
 	}
+	proposal := pm.CreateDeveloperPunishProposal(ctx, msg.Developer, slashRatio, msg.Revoke, msg.Reason)
+	if err := startProposal(
+		ctx, am, pm, gm, msg.Creator, proposal, types.DeveloperPunish,
+		param.DeveloperPunishDecideSec, param.DeveloperPunishMinDeposit, msg.Deposit); err != nil {
+		return err.Result()
+	}
+	// developer can't escape punishment by revoking before proposal is decided
+	dm.MarkPendingPunish(ctx, msg.Developer, proposalID)
 	return sdk.Result{}
 }
 
@@ -135,55 +242,288 @@ func handleContentCensorshipMsg(
 	proposal :=
 		proposalManager.CreateContentCensorshipProposal(
 			ctx, msg.GetPermlink(), msg.GetReason())
-	proposalID, err :=
-		proposalManager.AddProposal(
-			ctx, msg.GetCreator(), proposal, param.ContentCensorshipDecideSec)
+	if err := startProposal(
+		ctx, am, proposalManager, gm, msg.GetCreator(), proposal, types.ContentCensorship,
+		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit, msg.GetDeposit()); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handleAppealCensorshipMsg - author pays full appeal deposit, which is kept
+// until the appeal is decided instead of being returned like other deposits
+func handleAppealCensorshipMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager, msg AppealCensorshipMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Author) {
+		return ErrAccountNotFound().Result()
+	}
+	censorship, err := pm.GetAppealableCensorship(ctx, msg.ProposalID)
+	if err != nil {
+		return err.Result()
+	}
+	if !postManager.IsCensored(ctx, censorship.Permlink) {
+		return ErrCensorshipNotAppealable().Result()
+	}
+	author, err := postManager.GetCensoredPostAuthor(ctx, censorship.Permlink)
+	if err != nil {
+		return err.Result()
+	}
+	if author != msg.Author {
+		return ErrNotCensoredPostAuthor().Result()
+	}
+
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if ctx.BlockHeader().Time.Unix() > censorship.ExpiredAt+param.CensorshipAppealWindowSec {
+		return ErrAppealWindowClosed().Result()
+	}
+
+	proposal := pm.CreateCensorshipAppealProposal(
+		ctx, censorship.Permlink, msg.ProposalID, msg.Reason, param.CensorshipAppealMinDeposit)
+	proposalID, err := pm.AddProposal(ctx, msg.Author, proposal, param.CensorshipAppealDecideSec)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.MinusSavingCoin(
+		ctx, msg.Author, param.CensorshipAppealMinDeposit, "", string(proposalID),
+		types.ProposalDeposit); err != nil {
+		return err.Result()
+	}
+	if err := pm.MarkCensorshipAppealed(ctx, msg.ProposalID); err != nil {
+		return err.Result()
+	}
+	event := pm.CreateDecideProposalEvent(ctx, types.CensorshipAppeal, proposalID)
+	if err := gm.RegisterProposalDecideEvent(ctx, param.CensorshipAppealDecideSec, event); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleDepositProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg DepositProposalMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Depositor) {
+		return ErrAccountNotFound().Result()
+	}
+	if !pm.IsPendingProposal(ctx, msg.ProposalID) {
+		return ErrNotPendingProposal().Result()
+	}
+	deposit, err := types.LinoToCoin(msg.Deposit)
 	if err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := proposalManager.CreateDecideProposalEvent(ctx, types.ContentCensorship, proposalID)
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ContentCensorshipMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+
+	funding, err := pm.GetProposalFunding(ctx, msg.ProposalID)
+	if err != nil {
 		return err.Result()
 	}
+	// only take the amount needed to reach minimum deposit
+	remaining := funding.MinDeposit.Minus(funding.Deposited)
+	if deposit.IsGT(remaining) {
+		deposit = remaining
+	}
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ContentCensorshipDecideSec, event); err != nil {
+	if err := am.MinusSavingCoin(
+		ctx, msg.Depositor, deposit, "", string(msg.ProposalID), types.ProposalDeposit); err != nil {
+		return err.Result()
+	}
+	funding, err = pm.AddProposalDeposit(ctx, msg.ProposalID, msg.Depositor, deposit)
+	if err != nil {
 		return err.Result()
 	}
+	if !funding.Deposited.IsGTE(funding.MinDeposit) {
+		return sdk.Result{}
+	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit); err != nil {
+	funding, err = pm.ActivateProposal(ctx, msg.ProposalID)
+	if err != nil {
+		return err.Result()
+	}
+	if err := startVoting(
+		ctx, am, pm, gm, funding.ProposalType, msg.ProposalID, funding.DecideSec, funding.Deposits); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleCancelProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, dm dev.DeveloperManager,
+	gm *global.GlobalManager, msg CancelProposalMsg) sdk.Result {
+	if !pm.IsPendingProposal(ctx, msg.ProposalID) {
+		return ErrNotPendingProposal().Result()
+	}
+	funding, err := pm.CancelProposal(ctx, msg.ProposalID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+	if err := refundProposalFunding(ctx, am, pm, dm, gm, msg.ProposalID, funding); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
 
+// refundProposalFunding - deposits of closed pending proposal are returned to all
+// contributors from next block
+func refundProposalFunding(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, dm dev.DeveloperManager,
+	gm *global.GlobalManager, proposalID types.ProposalKey, funding *model.ProposalFunding) sdk.Error {
+	if funding.ProposalType == types.DeveloperPunish {
+		punish, err := pm.GetDeveloperPunish(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+		dm.ReleasePendingPunish(ctx, punish.Developer, proposalID)
+	}
+	for _, deposit := range funding.Deposits {
+		if err := returnCoinTo(ctx, deposit.Username, gm, am, int64(1), 0, deposit.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg VoteProposalMsg) sdk.Result {
-	if !vm.DoesVoterExist(ctx, msg.Voter) {
+	yesWeight, noWeight := int64(0), int64(types.VoteWeightBase)
+	if msg.Result {
+		yesWeight, noWeight = types.VoteWeightBase, 0
+	}
+	return castVote(
+		ctx, proposalManager, vm, msg.Voter, msg.ProposalID, yesWeight, noWeight, 0, msg.Conviction)
+}
+
+func handleSplitVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg SplitVoteProposalMsg) sdk.Result {
+	return castVote(
+		ctx, proposalManager, vm, msg.Voter, msg.ProposalID,
+		msg.YesWeight, msg.NoWeight, msg.AbstainWeight, msg.Conviction)
+}
+
+// startProposal - creator pays the initial deposit of new proposal, full minimum deposit
+// is paid if initial deposit is not specified. Proposal is open for voting once minimum
+// deposit is reached, otherwise it waits for other accounts to top up the deposit.
+func startProposal(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	creator types.AccountKey, proposal model.Proposal, proposalType types.ProposalType,
+	decideSec int64, minDeposit types.Coin, initialDeposit types.LNO) sdk.Error {
+	deposit := minDeposit
+	if initialDeposit != "" {
+		coin, err := types.LinoToCoin(initialDeposit)
+		if err != nil {
+			return err
+		}
+		if coin.IsGT(minDeposit) {
+			coin = minDeposit
+		}
+		deposit = coin
+	}
+
+	if !deposit.IsGTE(minDeposit) {
+		proposalID, err := pm.AddPendingProposal(ctx, creator, proposal, proposalType, decideSec, minDeposit)
+		if err != nil {
+			return err
+		}
+		// deposits are refunded if minimum deposit is not reached in deposit period
+		proposalParam, err := pm.paramHolder.GetProposalParam(ctx)
+		if err != nil {
+			return err
+		}
+		if err := gm.RegisterProposalDecideEvent(
+			ctx, proposalParam.ProposalDepositPeriodSec,
+			ExpirePendingProposalEvent{ProposalID: proposalID}); err != nil {
+			return err
+		}
+		if err := am.MinusSavingCoin(
+			ctx, creator, deposit, "", string(proposalID), types.ProposalDeposit); err != nil {
+			return err
+		}
+		_, err = pm.AddProposalDeposit(ctx, proposalID, creator, deposit)
+		return err
+	}
+
+	proposalID, err := pm.AddProposal(ctx, creator, proposal, decideSec)
+	if err != nil {
+		return err
+	}
+	if err := am.MinusSavingCoin(
+		ctx, creator, deposit, "", string(proposalID), types.ProposalDeposit); err != nil {
+		return err
+	}
+	return startVoting(ctx, am, pm, gm, proposalType, proposalID, decideSec,
+		[]model.ProposalDeposit{{Username: creator, Amount: deposit}})
+}
+
+// startVoting - set a time event to decide the proposal, deposits are
+// returned to all contributors when deciding the proposal
+func startVoting(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	proposalType types.ProposalType, proposalID types.ProposalKey, decideSec int64,
+	deposits []model.ProposalDeposit) sdk.Error {
+	event := pm.CreateDecideProposalEvent(ctx, proposalType, proposalID)
+	if err := gm.RegisterProposalDecideEvent(ctx, decideSec, event); err != nil {
+		return err
+	}
+
+	for _, deposit := range deposits {
+		if err := returnCoinTo(
+			ctx, deposit.Username, gm, am, int64(1), decideSec, deposit.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// castVote - add or change vote of voter before proposal is decided and update tally
+func castVote(
+	ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager,
+	voter types.AccountKey, proposalID types.ProposalKey,
+	yesWeight, noWeight, abstainWeight, conviction int64) sdk.Result {
+	if !vm.DoesVoterExist(ctx, voter) {
 		return ErrVoterNotFound().Result()
 	}
 
-	if !proposalManager.IsOngoingProposal(ctx, msg.ProposalID) {
+	if !proposalManager.IsOngoingProposal(ctx, proposalID) {
 		return ErrNotOngoingProposal().Result()
 	}
 
-	if err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result); err != nil {
+	var prevVote *votemodel.Vote
+	if vm.DoesVoteExist(ctx, proposalID, voter) {
+		v, err := vm.GetVote(ctx, proposalID, voter)
+		if err != nil {
+			return err.Result()
+		}
+		prevVote = v
+	}
+
+	if err := vm.AddSplitVote(
+		ctx, proposalID, voter, yesWeight, noWeight, abstainWeight, conviction); err != nil {
+		return err.Result()
+	}
+
+	v, err := vm.GetVote(ctx, proposalID, voter)
+	if err != nil {
 		return err.Result()
 	}
 
-	v, err := vm.GetVote(ctx, msg.ProposalID, msg.Voter)
+	err = proposalManager.UpdateProposalVotingStatus(ctx, proposalID, voter, prevVote, v)
 	if err != nil {
 		return err.Result()
 	}
 
-	err = proposalManager.UpdateProposalVotingStatus(ctx, msg.ProposalID, msg.Voter, v.Result, v.VotingPower)
+	// stake is locked till lock period of conviction passes after proposal ends
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return err.Result()
 	}
+	if err := vm.LockStakeForConviction(
+		ctx, voter, conviction, proposal.GetProposalInfo().ExpiredAt); err != nil {
+		return err.Result()
+	}
+	// voting power includes delegated power, which can't be redelegated to vote again
+	if err := vm.RecordVotedProposalEnd(ctx, voter, proposal.GetProposalInfo().ExpiredAt); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{}
 }
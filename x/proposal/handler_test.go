@@ -3,14 +3,18 @@ package proposal
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	globalmodel "github.com/lino-network/lino/x/global/model"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 var (
@@ -20,17 +24,20 @@ var (
 )
 
 func TestChangeParamProposal(t *testing.T) {
-	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
-	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
 	proposalManager.InitGenesis(ctx)
 
 	allocation := param.GlobalAllocationParam{
 		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
-		DeveloperAllocation:      sdk.ZeroDec(),
+		DeveloperAllocation:      types.NewDecFromRat(5, 10),
 		ValidatorAllocation:      sdk.ZeroDec(),
 		InfraAllocation:          sdk.ZeroDec(),
 		ContentCreatorAllocation: types.NewDecFromRat(5, 10),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
+	invalidAllocation := allocation
+	invalidAllocation.DeveloperAllocation = sdk.ZeroDec()
 	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
 	proposalID2 := types.ProposalKey(strconv.FormatInt(int64(2), 10))
 
@@ -77,6 +84,19 @@ func TestChangeParamProposal(t *testing.T) {
 			wantProposal:        proposal1,
 		},
 
+		{
+			testName: "parameter failed validation is rejected",
+			msg: ChangeGlobalAllocationParamMsg{
+				Creator:   user1,
+				Parameter: invalidAllocation,
+			},
+			proposalID:          proposalID2,
+			wantOK:              false,
+			wantRes:             param.ErrInvalidParamValue("allocation shares don't sum to one").Result(),
+			wantCreatorBalance:  c460000.Minus(proposalParam.ChangeParamMinDeposit),
+			wantOngoingProposal: []model.Proposal{proposal1},
+			wantProposal:        nil,
+		},
 		{
 			testName: "user2 doesn't have enough money to create proposal",
 			msg: ChangeGlobalAllocationParamMsg{
@@ -124,9 +144,80 @@ func TestChangeParamProposal(t *testing.T) {
 	}
 }
 
+func TestChangeParamsProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	allocation := param.GlobalAllocationParam{
+		GlobalGrowthRate:         types.NewDecFromRat(5, 100),
+		InfraAllocation:          types.NewDecFromRat(10, 100),
+		ContentCreatorAllocation: types.NewDecFromRat(60, 100),
+		DeveloperAllocation:      types.NewDecFromRat(20, 100),
+		ValidatorAllocation:      types.NewDecFromRat(10, 100),
+		CommunityPoolAllocation:  types.NewDecFromRat(5, 100),
+	}
+	infraAllocation := param.InfraInternalAllocationParam{
+		StorageAllocation: types.NewDecFromRat(30, 100),
+		CDNAllocation:     types.NewDecFromRat(70, 100),
+	}
+	invalidAllocation := allocation
+	invalidAllocation.ValidatorAllocation = sdk.ZeroDec()
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalID := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+
+	// bundle with invalid parameter can't be proposed
+	result := handler(ctx, NewChangeParamsMsg(
+		"user1", []param.Parameter{invalidAllocation, infraAllocation}, ""))
+	assert.Equal(t, param.ErrInvalidParamValue("allocation shares don't sum to one").Result(), result)
+	assert.False(t, proposalManager.DoesProposalExist(ctx, proposalID))
+
+	result = handler(ctx, NewChangeParamsMsg(
+		"user1", []param.Parameter{allocation, infraAllocation}, ""))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c460000.Minus(proposalParam.ChangeParamMinDeposit)))
+
+	wantProposal := &model.ChangeParamsProposal{
+		ProposalInfo: model.ProposalInfo{
+			Creator:       user1,
+			ProposalID:    proposalID,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
+		},
+		Params: []param.Parameter{allocation, infraAllocation},
+		Reason: "",
+	}
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, wantProposal, proposal)
+
+	// all bundled parameters are changed by one event
+	_, err = proposalManager.UpdateProposalPassStatus(ctx, types.ChangeParam, proposalID)
+	assert.Nil(t, err)
+	event, err := proposalManager.CreateParamChangeEvent(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, param.ChangeParamEvent{Params: []param.Parameter{allocation, infraAllocation}}, event)
+	err = event.(param.ChangeParamEvent).Execute(ctx, proposalManager.paramHolder)
+	assert.Nil(t, err)
+
+	allocationPtr, err := proposalManager.paramHolder.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, allocation, *allocationPtr)
+	infraAllocationPtr, err := proposalManager.paramHolder.GetInfraInternalAllocationParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, infraAllocation, *infraAllocationPtr)
+}
+
 func TestContentCensorshipProposal(t *testing.T) {
-	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
-	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
 	curTime := ctx.BlockHeader().Time.Unix()
 	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
 
@@ -261,7 +352,7 @@ func TestContentCensorshipProposal(t *testing.T) {
 }
 
 func TestAddFrozenMoney(t *testing.T) {
-	ctx, am, proposalManager, _, _, _, gm := setupTest(t, 0)
+	ctx, am, proposalManager, _, _, _, gm, _ := setupTest(t, 0)
 	proposalManager.InitGenesis(ctx)
 
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -326,8 +417,8 @@ func TestAddFrozenMoney(t *testing.T) {
 }
 
 func TestVoteProposalBasic(t *testing.T) {
-	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
-	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
 	curTime := ctx.BlockHeader().Time.Unix()
 	proposalManager.InitGenesis(ctx)
 
@@ -420,20 +511,20 @@ func TestVoteProposalBasic(t *testing.T) {
 				Reason:   censorshipReason},
 		},
 		{
-			testName: "user can't double-vote",
+			testName: "user can change vote before proposal is decided",
 			msg: VoteProposalMsg{
 				Voter:      user1,
 				ProposalID: proposalID1,
 				Result:     false,
 			},
-			wantRes: vote.ErrVoteAlreadyExist().Result(),
+			wantRes: sdk.Result{},
 			wantOK:  true,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
-					AgreeVotes:    c4600,
-					DisagreeVotes: types.NewCoinFromInt64(0),
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: c4600,
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -460,3 +551,919 @@ func TestVoteProposalBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitVoteProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, "user1", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user2, c4600)
+
+	proposal1 := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal1, 100)
+
+	testCases := []struct {
+		testName          string
+		msg               sdk.Msg
+		wantRes           sdk.Result
+		wantAgreeVotes    types.Coin
+		wantDisagreeVotes types.Coin
+	}{
+		{
+			testName:          "split vote with illegal weights",
+			msg:               SplitVoteProposalMsg{Voter: user1, ProposalID: proposalID1, YesWeight: 6000, NoWeight: 3000},
+			wantRes:           vote.ErrInvalidVoteWeight().Result(),
+			wantAgreeVotes:    types.NewCoinFromInt64(0),
+			wantDisagreeVotes: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:          "split vote across yes, no and abstain",
+			msg:               NewSplitVoteProposalMsg("user1", 1, 6000, 3000, 1000, 0),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    types.NewCoinFromInt64(2760 * types.Decimals),
+			wantDisagreeVotes: types.NewCoinFromInt64(1380 * types.Decimals),
+		},
+		{
+			testName:          "full vote from another voter",
+			msg:               NewVoteProposalMsg("user2", 1, true, 0),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    types.NewCoinFromInt64(7360 * types.Decimals),
+			wantDisagreeVotes: types.NewCoinFromInt64(1380 * types.Decimals),
+		},
+		{
+			testName:          "change split vote to abstain",
+			msg:               NewSplitVoteProposalMsg("user1", 1, 0, 0, 10000, 0),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    c4600,
+			wantDisagreeVotes: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:          "change full vote to split vote",
+			msg:               NewSplitVoteProposalMsg("user2", 1, 2500, 7500, 0, 0),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    types.NewCoinFromInt64(1150 * types.Decimals),
+			wantDisagreeVotes: types.NewCoinFromInt64(3450 * types.Decimals),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+
+		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
+		}
+		info := proposal.GetProposalInfo()
+		if !info.AgreeVotes.IsEqual(tc.wantAgreeVotes) {
+			t.Errorf("%s: diff agree votes, got %v, want %v", tc.testName, info.AgreeVotes, tc.wantAgreeVotes)
+		}
+		if !info.DisagreeVotes.IsEqual(tc.wantDisagreeVotes) {
+			t.Errorf("%s: diff disagree votes, got %v, want %v", tc.testName, info.DisagreeVotes, tc.wantDisagreeVotes)
+		}
+	}
+
+	// abstain vote counts as participation
+	penaltyList, err := vm.GetPenaltyList(
+		ctx, proposalID1, types.ChangeParam, []types.AccountKey{user1, user2})
+	if err != nil {
+		t.Errorf("TestSplitVoteProposal: failed to get penalty list, got err %v", err)
+	}
+	if len(penaltyList.PenaltyList) != 0 {
+		t.Errorf("TestSplitVoteProposal: diff penalty list, got %v, want empty", penaltyList.PenaltyList)
+	}
+}
+
+func TestConvictionVoteProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, "user1", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user2, c4600)
+
+	proposal1 := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal1, 100)
+
+	testCases := []struct {
+		testName          string
+		msg               sdk.Msg
+		wantRes           sdk.Result
+		wantAgreeVotes    types.Coin
+		wantDisagreeVotes types.Coin
+		wantLocked        types.Coin
+	}{
+		{
+			testName:          "conviction above max conviction",
+			msg:               NewVoteProposalMsg("user1", 1, true, types.MaxVoteConviction+1),
+			wantRes:           vote.ErrInvalidConviction(types.MaxVoteConviction).Result(),
+			wantAgreeVotes:    types.NewCoinFromInt64(0),
+			wantDisagreeVotes: types.NewCoinFromInt64(0),
+			wantLocked:        types.NewCoinFromInt64(0),
+		},
+		{
+			testName:          "conviction vote is multiplied",
+			msg:               NewVoteProposalMsg("user1", 1, true, 2),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    types.NewCoinFromInt64(13800 * types.Decimals),
+			wantDisagreeVotes: types.NewCoinFromInt64(0),
+			wantLocked:        c4600,
+		},
+		{
+			testName:          "split conviction vote is multiplied",
+			msg:               NewSplitVoteProposalMsg("user2", 1, 5000, 5000, 0, 1),
+			wantRes:           sdk.Result{},
+			wantAgreeVotes:    types.NewCoinFromInt64(18400 * types.Decimals),
+			wantDisagreeVotes: c4600,
+			wantLocked:        c4600,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+
+		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
+		}
+		info := proposal.GetProposalInfo()
+		if !info.AgreeVotes.IsEqual(tc.wantAgreeVotes) {
+			t.Errorf("%s: diff agree votes, got %v, want %v", tc.testName, info.AgreeVotes, tc.wantAgreeVotes)
+		}
+		if !info.DisagreeVotes.IsEqual(tc.wantDisagreeVotes) {
+			t.Errorf("%s: diff disagree votes, got %v, want %v", tc.testName, info.DisagreeVotes, tc.wantDisagreeVotes)
+		}
+
+		voter := tc.msg.GetSigners()[0]
+		locked, err := vm.GetLockedStake(ctx, types.AccountKey(voter))
+		if err != nil {
+			t.Errorf("%s: failed to get locked stake, get err %v", tc.testName, err)
+		}
+		if !locked.IsEqual(tc.wantLocked) {
+			t.Errorf("%s: diff locked stake, got %v, want %v", tc.testName, locked, tc.wantLocked)
+		}
+	}
+
+	// conviction locked stake can't be withdrawn
+	if vm.IsLegalVoterWithdraw(ctx, user1, types.NewCoinFromInt64(1)) {
+		t.Errorf("TestConvictionVoteProposal: locked stake should not be withdrawable")
+	}
+}
+
+func TestConvictionVoteWithDelegatedPower(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, "user1", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user2, c4600)
+	// user2 delegates all stake to user1
+	err := vm.AddDelegation(ctx, user1, user2, c4600)
+	assert.Nil(t, err)
+
+	proposal := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID, _ := proposalManager.AddProposal(ctx, user1, proposal, 100)
+
+	result := handler(ctx, NewVoteProposalMsg("user1", 1, true, 2))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// only own stake is locked and multiplied, delegated power counts once
+	ongoing, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	wantAgreeVotes := c4600.Plus(types.NewCoinFromInt64(3 * 4600 * types.Decimals))
+	if !ongoing.GetProposalInfo().AgreeVotes.IsEqual(wantAgreeVotes) {
+		t.Errorf("TestConvictionVoteWithDelegatedPower: diff agree votes, got %v, want %v",
+			ongoing.GetProposalInfo().AgreeVotes, wantAgreeVotes)
+	}
+	locked, err := vm.GetLockedStake(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, locked.IsEqual(c4600))
+
+	// revote without conviction removes the amplified part
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+	ongoing, err = proposalManager.storage.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.True(t, ongoing.GetProposalInfo().AgreeVotes.IsEqual(c4600.Plus(c4600)))
+}
+
+func TestRedelegateAfterVoting(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, "user1", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user2, c4600)
+	user3 := types.AccountKey("user3")
+	createTestAccount(ctx, am, "user3", c4600)
+	_ = vm.AddVoter(ctx, user3, c4600)
+	// user3 delegates all stake to user1
+	err := vm.AddDelegation(ctx, user1, user3, c4600)
+	assert.Nil(t, err)
+
+	proposal := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID, _ := proposalManager.AddProposal(ctx, user1, proposal, 100)
+
+	result := handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// delegation counted in user1's vote can't be moved to user2 before proposal ends
+	err = vm.CheckRedelegate(ctx, user1, user3, c4600)
+	assert.Equal(t, vote.ErrRedelegateDuringVoting(), err)
+
+	result = handler(ctx, NewVoteProposalMsg("user2", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+	ongoing, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	wantAgreeVotes := c4600.Plus(c4600).Plus(c4600)
+	if !ongoing.GetProposalInfo().AgreeVotes.IsEqual(wantAgreeVotes) {
+		t.Errorf("TestRedelegateAfterVoting: diff agree votes, got %v, want %v",
+			ongoing.GetProposalInfo().AgreeVotes, wantAgreeVotes)
+	}
+
+	// user2 votes on the same proposal, redelegation from user2 is also rejected
+	err = vm.CheckRedelegate(ctx, user2, user3, c4600)
+	assert.Equal(t, vote.ErrRedelegateDuringVoting(), err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(ongoing.GetProposalInfo().ExpiredAt, 0)})
+	err = vm.CheckRedelegate(ctx, user1, user3, c4600)
+	assert.Nil(t, err)
+}
+
+func TestTextProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+
+	initCoin := proposalParam.TextProposalMinDeposit.Plus(c4600)
+	user1 := createTestAccount(ctx, am, "user1", initCoin)
+	votingPower := proposalParam.TextProposalPassVotes.Plus(c46)
+	_ = vm.AddVoter(ctx, user1, votingPower)
+
+	result := handler(ctx, NewTextProposalMsg("user2", "title", "description"))
+	assert.Equal(t, ErrAccountNotFound().Result(), result)
+
+	result = handler(ctx, NewTextProposalMsg("user1", "title", "description"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	wantProposal := &model.TextProposal{
+		ProposalInfo: model.ProposalInfo{
+			Creator:       user1,
+			ProposalID:    proposalID1,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.TextProposalDecideSec,
+		},
+		Title:       "title",
+		Description: "description",
+	}
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, wantProposal, proposal)
+
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c4600))
+
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// text proposal is decided through decide proposal event and only the result is recorded
+	event := DecideProposalEvent{ProposalType: types.TextProposal, ProposalID: proposalID1}
+	err = event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm)
+	assert.Nil(t, err)
+
+	proposal, err = proposalManager.storage.GetExpiredProposal(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalPass, proposal.GetProposalInfo().Result)
+	assert.True(t, proposal.GetProposalInfo().AgreeVotes.IsEqual(votingPower))
+}
+
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", proposalParam.CommunitySpendMinDeposit.Plus(c4600))
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user1, proposalParam.CommunitySpendPassVotes.Plus(c46))
+	err := gm.AddToCommunityPool(ctx, types.NewCoinFromInt64(1000*types.Decimals))
+	assert.Nil(t, err)
+
+	result := handler(ctx, NewCommunityPoolSpendMsg("user1", "user3", "500", "grant"))
+	assert.Equal(t, ErrAccountNotFound().Result(), result)
+
+	// community pool spend uses its own decide time and deposit
+	result = handler(ctx, NewCommunityPoolSpendMsg("user1", "user2", "500", "grant"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c4600))
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t,
+		ctx.BlockHeader().Time.Unix()+proposalParam.CommunitySpendDecideSec, proposal.GetProposalInfo().ExpiredAt)
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, false, 0))
+	assert.Equal(t, sdk.Result{}, result)
+	event := DecideProposalEvent{ProposalType: types.CommunitySpend, ProposalID: types.ProposalKey("1")}
+	err = event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName          string
+		amount            types.LNO
+		wantRecipientCoin types.Coin
+		wantPool          types.Coin
+		wantNumOfSpends   int
+	}{
+		{
+			testName:          "pay recipient from community pool",
+			amount:            "500",
+			wantRecipientCoin: c4600.Plus(types.NewCoinFromInt64(500 * types.Decimals)),
+			wantPool:          types.NewCoinFromInt64(500 * types.Decimals),
+			wantNumOfSpends:   1,
+		},
+		{
+			testName:          "spend is skipped if community pool is not enough",
+			amount:            "1000",
+			wantRecipientCoin: c4600.Plus(types.NewCoinFromInt64(500 * types.Decimals)),
+			wantPool:          types.NewCoinFromInt64(500 * types.Decimals),
+			wantNumOfSpends:   1,
+		},
+	}
+	for i, tc := range testCases {
+		_ = am.AddSavingCoin(ctx, user1, proposalParam.CommunitySpendMinDeposit, "", "", types.TransferIn)
+		result := handler(ctx, NewCommunityPoolSpendMsg("user1", "user2", tc.amount, "grant"))
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+		proposalID := types.ProposalKey(strconv.FormatInt(int64(i+2), 10))
+		result = handler(ctx, NewVoteProposalMsg("user1", int64(i+2), true, 0))
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff vote result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+
+		event := DecideProposalEvent{ProposalType: types.CommunitySpend, ProposalID: proposalID}
+		if err := event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm); err != nil {
+			t.Errorf("%s: failed to execute decide proposal event, got err %v", tc.testName, err)
+		}
+		proposal, _ = proposalManager.storage.GetExpiredProposal(ctx, proposalID)
+		if proposal.GetProposalInfo().Result != types.ProposalPass {
+			t.Errorf("%s: diff proposal result, got %v, want %v",
+				tc.testName, proposal.GetProposalInfo().Result, types.ProposalPass)
+		}
+
+		saving, _ = am.GetSavingFromBank(ctx, user2)
+		if !saving.IsEqual(tc.wantRecipientCoin) {
+			t.Errorf("%s: diff recipient saving, got %v, want %v", tc.testName, saving, tc.wantRecipientCoin)
+		}
+		pool, _ := gm.GetCommunityPool(ctx)
+		if !pool.IsEqual(tc.wantPool) {
+			t.Errorf("%s: diff community pool, got %v, want %v", tc.testName, pool, tc.wantPool)
+		}
+		spends, _ := gm.GetCommunityPoolSpends(ctx)
+		if len(spends) != tc.wantNumOfSpends {
+			t.Errorf("%s: diff number of spends, got %v, want %v", tc.testName, len(spends), tc.wantNumOfSpends)
+		}
+	}
+}
+
+func TestDeveloperPunishProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	devParam, _ := proposalManager.paramHolder.GetDeveloperParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", proposalParam.DeveloperPunishMinDeposit.Plus(c4600))
+	dev1 := createTestAccount(ctx, am, "dev1", c4600)
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user1, proposalParam.DeveloperPunishPassVotes.Plus(c46))
+	err := dm.RegisterDeveloper(ctx, dev1, devParam.DeveloperMinDeposit, "", "", "")
+	assert.Nil(t, err)
+
+	result := handler(ctx, NewDeveloperPunishMsg("user3", "dev1", "0.1", false, "fake donation"))
+	assert.Equal(t, ErrAccountNotFound().Result(), result)
+	result = handler(ctx, NewDeveloperPunishMsg("user1", "user2", "0.1", false, "fake donation"))
+	assert.Equal(t, ErrDeveloperNotFound().Result(), result)
+
+	initPool, _ := gm.GetCommunityPool(ctx)
+	testCases := []struct {
+		testName      string
+		slashRatio    string
+		revoke        bool
+		wantDeveloper bool
+		wantPool      types.Coin
+		wantReturned  types.Coin
+	}{
+		{
+			testName:      "slash part of deposit",
+			slashRatio:    "0.1",
+			revoke:        false,
+			wantDeveloper: true,
+			wantPool:      initPool.Plus(types.NewCoinFromInt64(100000 * types.Decimals)),
+			wantReturned:  types.NewCoinFromInt64(0),
+		},
+		{
+			testName:      "slash and revoke developer, remaining deposit is returned",
+			slashRatio:    "0.5",
+			revoke:        true,
+			wantDeveloper: false,
+			wantPool:      initPool.Plus(types.NewCoinFromInt64(550000 * types.Decimals)),
+			wantReturned:  types.NewCoinFromInt64(450000 * types.Decimals),
+		},
+		{
+			testName:      "punish is skipped if developer has been revoked",
+			slashRatio:    "0.5",
+			revoke:        true,
+			wantDeveloper: false,
+			wantPool:      initPool.Plus(types.NewCoinFromInt64(550000 * types.Decimals)),
+			wantReturned:  types.NewCoinFromInt64(450000 * types.Decimals),
+		},
+	}
+	for i, tc := range testCases {
+		_ = am.AddSavingCoin(ctx, user1, proposalParam.DeveloperPunishMinDeposit, "", "", types.TransferIn)
+		if dm.DoesDeveloperExist(ctx, dev1) {
+			result := handler(ctx, NewDeveloperPunishMsg("user1", "dev1", tc.slashRatio, tc.revoke, "fake donation"))
+			if !assert.Equal(t, sdk.Result{}, result) {
+				t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+			}
+		} else {
+			// proposal against revoked developer is rejected by handler, add it directly
+			slashRatio, _ := sdk.NewDecFromStr(tc.slashRatio)
+			proposal := proposalManager.CreateDeveloperPunishProposal(
+				ctx, dev1, slashRatio, tc.revoke, "fake donation")
+			if _, err := proposalManager.AddProposal(
+				ctx, user1, proposal, proposalParam.DeveloperPunishDecideSec); err != nil {
+				t.Errorf("%s: failed to add proposal, got err %v", tc.testName, err)
+			}
+		}
+		proposalID := types.ProposalKey(strconv.FormatInt(int64(i+1), 10))
+		result = handler(ctx, NewVoteProposalMsg("user1", int64(i+1), true, 0))
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff vote result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+
+		event := DecideProposalEvent{ProposalType: types.DeveloperPunish, ProposalID: proposalID}
+		if err := event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm); err != nil {
+			t.Errorf("%s: failed to execute decide proposal event, got err %v", tc.testName, err)
+		}
+
+		if dm.DoesDeveloperExist(ctx, dev1) != tc.wantDeveloper {
+			t.Errorf("%s: diff developer existence, got %v, want %v",
+				tc.testName, dm.DoesDeveloperExist(ctx, dev1), tc.wantDeveloper)
+		}
+		pool, _ := gm.GetCommunityPool(ctx)
+		if !pool.IsEqual(tc.wantPool) {
+			t.Errorf("%s: diff community pool, got %v, want %v", tc.testName, pool, tc.wantPool)
+		}
+		returned := types.NewCoinFromInt64(0)
+		frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, dev1)
+		for _, frozenMoney := range frozenMoneyList {
+			returned = returned.Plus(frozenMoney.Amount)
+		}
+		if !returned.IsEqual(tc.wantReturned) {
+			t.Errorf("%s: diff returned deposit, got %v, want %v", tc.testName, returned, tc.wantReturned)
+		}
+	}
+
+	// revoked developer can't register again until punish cooldown ends
+	devHandler := dev.NewHandler(dm, am, &gm)
+	result = devHandler(ctx, dev.NewDeveloperRegisterMsg("dev1", "1000000", "", "", ""))
+	assert.Equal(t, dev.ErrDeveloperInPunishCooldown(dev1).Result(), result)
+	ctx = ctx.WithBlockHeader(abci.Header{
+		Time: ctx.BlockHeader().Time.Add(time.Duration(devParam.DeveloperPunishCooldownSec) * time.Second)})
+	assert.False(t, dm.IsInPunishCooldown(ctx, dev1))
+}
+
+func TestRevokeDuringDeveloperPunish(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	devHandler := dev.NewHandler(dm, am, &gm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	devParam, _ := proposalManager.paramHolder.GetDeveloperParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", proposalParam.DeveloperPunishMinDeposit.Plus(c4600))
+	dev1 := createTestAccount(ctx, am, "dev1", c4600)
+	_ = vm.AddVoter(ctx, user1, proposalParam.DeveloperPunishPassVotes.Plus(c46))
+	err := dm.RegisterDeveloper(ctx, dev1, devParam.DeveloperMinDeposit, "", "", "")
+	assert.Nil(t, err)
+	initPool, _ := gm.GetCommunityPool(ctx)
+
+	result := handler(ctx, NewDeveloperPunishMsg("user1", "dev1", "0.5", false, "fake donation"))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, dm.IsUnderPunishment(ctx, dev1))
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c4600))
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t,
+		ctx.BlockHeader().Time.Unix()+proposalParam.DeveloperPunishDecideSec, proposal.GetProposalInfo().ExpiredAt)
+
+	// developer can't take deposit back while punish proposal is undecided
+	result = devHandler(ctx, dev.NewDeveloperRevokeMsg("dev1"))
+	assert.Equal(t, dev.ErrDeveloperUnderPunishment(dev1).Result(), result)
+	assert.True(t, dm.DoesDeveloperExist(ctx, dev1))
+
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+	event := DecideProposalEvent{ProposalType: types.DeveloperPunish, ProposalID: types.ProposalKey("1")}
+	err = event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm)
+	assert.Nil(t, err)
+	assert.False(t, dm.IsUnderPunishment(ctx, dev1))
+	pool, _ := gm.GetCommunityPool(ctx)
+	assert.True(t, pool.IsEqual(initPool.Plus(types.NewCoinFromInt64(500000*types.Decimals))))
+
+	// remaining deposit can be revoked after punishment
+	result = devHandler(ctx, dev.NewDeveloperRevokeMsg("dev1"))
+	assert.Equal(t, sdk.Result{}, result)
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, dev1)
+	returned := types.NewCoinFromInt64(0)
+	for _, frozenMoney := range frozenMoneyList {
+		returned = returned.Plus(frozenMoney.Amount)
+	}
+	assert.True(t, returned.IsEqual(types.NewCoinFromInt64(500000*types.Decimals)))
+}
+
+func TestDeveloperPunishForfeitsRewardPool(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	devParam, _ := proposalManager.paramHolder.GetDeveloperParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+
+	user1 := createTestAccount(
+		ctx, am, "user1", proposalParam.DeveloperPunishMinDeposit.Plus(proposalParam.DeveloperPunishMinDeposit))
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	dev1 := createTestAccount(ctx, am, "dev1", c4600)
+	dev2 := createTestAccount(ctx, am, "dev2", c4600)
+	_ = vm.AddVoter(ctx, user1, proposalParam.DeveloperPunishPassVotes.Plus(c46))
+	c1000 := types.NewCoinFromInt64(1000 * types.Decimals)
+	for _, developer := range []types.AccountKey{dev1, dev2} {
+		err := dm.RegisterDeveloper(ctx, developer, devParam.DeveloperMinDeposit, "", "", "")
+		assert.Nil(t, err)
+		err = dm.SetRewardPoolShare(ctx, developer, sdk.OneDec())
+		assert.Nil(t, err)
+		_, err = dm.FundRewardPool(ctx, developer, c1000)
+		assert.Nil(t, err)
+	}
+	// only dev1 has donation recorded
+	err := dm.RecordUserDonation(ctx, dev1, user2, types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	initPool, _ := gm.GetCommunityPool(ctx)
+
+	testCases := []struct {
+		testName      string
+		developer     types.AccountKey
+		wantUser2Coin types.Coin
+		wantPool      types.Coin
+	}{
+		{
+			testName:      "reward pool is paid out to donors",
+			developer:     dev1,
+			wantUser2Coin: c4600.Plus(c1000),
+			wantPool:      initPool.Plus(types.NewCoinFromInt64(500000 * types.Decimals)),
+		},
+		{
+			testName:      "reward pool without donation goes to community pool",
+			developer:     dev2,
+			wantUser2Coin: c4600.Plus(c1000),
+			wantPool:      initPool.Plus(types.NewCoinFromInt64(1000000 * types.Decimals)).Plus(c1000),
+		},
+	}
+	for i, tc := range testCases {
+		result := handler(ctx, NewDeveloperPunishMsg("user1", string(tc.developer), "0.5", true, "fake donation"))
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+		result = handler(ctx, NewVoteProposalMsg("user1", int64(i+1), true, 0))
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff vote result, got %v, want %v", tc.testName, result, sdk.Result{})
+		}
+		proposalID := types.ProposalKey(strconv.FormatInt(int64(i+1), 10))
+		event := DecideProposalEvent{ProposalType: types.DeveloperPunish, ProposalID: proposalID}
+		if err := event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm); err != nil {
+			t.Errorf("%s: failed to execute decide proposal event, got err %v", tc.testName, err)
+		}
+
+		saving, _ := am.GetSavingFromBank(ctx, user2)
+		if !saving.IsEqual(tc.wantUser2Coin) {
+			t.Errorf("%s: diff donor saving, got %v, want %v", tc.testName, saving, tc.wantUser2Coin)
+		}
+		pool, _ := gm.GetCommunityPool(ctx)
+		if !pool.IsEqual(tc.wantPool) {
+			t.Errorf("%s: diff community pool, got %v, want %v", tc.testName, pool, tc.wantPool)
+		}
+		// only remaining deposit is returned to punished developer
+		returned := types.NewCoinFromInt64(0)
+		frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, tc.developer)
+		for _, frozenMoney := range frozenMoneyList {
+			returned = returned.Plus(frozenMoney.Amount)
+		}
+		if !returned.IsEqual(types.NewCoinFromInt64(500000 * types.Decimals)) {
+			t.Errorf("%s: diff returned deposit, got %v", tc.testName, returned)
+		}
+	}
+}
+
+func TestCrowdFundedProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	user2 := createTestAccount(ctx, am, "user2", c460000)
+	user3 := createTestAccount(ctx, am, "user3", c460000)
+	_ = vm.AddVoter(ctx, user1, c4600)
+
+	c10000 := types.NewCoinFromInt64(10000 * types.Decimals)
+	c30000 := types.NewCoinFromInt64(30000 * types.Decimals)
+	c40000 := types.NewCoinFromInt64(40000 * types.Decimals)
+	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	proposalID2 := types.ProposalKey(strconv.FormatInt(int64(2), 10))
+
+	// creator pays part of minimum deposit, proposal waits for deposit
+	result := handler(ctx, TextProposalMsg{
+		Creator: user1, Title: "title", Description: "description", Deposit: "40000"})
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsPendingProposal(ctx, proposalID1))
+	assert.False(t, proposalManager.IsOngoingProposal(ctx, proposalID1))
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c460000.Minus(c40000)))
+
+	// voting doesn't start before proposal is fully funded
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, ErrNotOngoingProposal().Result(), result)
+
+	result = handler(ctx, NewDepositProposalMsg("user4", 1, "30000"))
+	assert.Equal(t, ErrAccountNotFound().Result(), result)
+
+	result = handler(ctx, NewDepositProposalMsg("user2", 1, "30000"))
+	assert.Equal(t, sdk.Result{}, result)
+	funding, err := proposalManager.GetProposalFunding(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.True(t, funding.Deposited.IsEqual(c40000.Plus(c30000)))
+	assert.Equal(t, 2, len(funding.Deposits))
+
+	// only creator can cancel the proposal
+	result = handler(ctx, NewCancelProposalMsg("user3", 1))
+	assert.Equal(t, ErrNotProposalCreator().Result(), result)
+
+	// deposit exceeding minimum deposit is not taken
+	result = handler(ctx, NewDepositProposalMsg("user3", 1, "50000"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ = am.GetSavingFromBank(ctx, user3)
+	assert.True(t, saving.IsEqual(c460000.Minus(c30000)))
+
+	assert.False(t, proposalManager.IsPendingProposal(ctx, proposalID1))
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, curTime, proposal.GetProposalInfo().CreatedAt)
+	assert.Equal(t, curTime+proposalParam.TextProposalDecideSec, proposal.GetProposalInfo().ExpiredAt)
+	_, err = proposalManager.GetProposalFunding(ctx, proposalID1)
+	assert.Equal(t, model.ErrProposalFundingNotFound(), err)
+
+	// all contributors get their deposit back when proposal is decided
+	for _, tc := range []struct {
+		username types.AccountKey
+		amount   types.Coin
+	}{
+		{user1, c40000},
+		{user2, c30000},
+		{user3, c30000},
+	} {
+		lst, err := am.GetFrozenMoneyList(ctx, tc.username)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(lst))
+		assert.True(t, lst[0].Amount.IsEqual(tc.amount))
+		assert.Equal(t, proposalParam.TextProposalDecideSec, lst[0].Interval)
+	}
+
+	result = handler(ctx, NewVoteProposalMsg("user1", 1, true, 0))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// proposal can't be cancelled after voting starts
+	result = handler(ctx, NewCancelProposalMsg("user1", 1))
+	assert.Equal(t, ErrNotPendingProposal().Result(), result)
+	result = handler(ctx, NewDepositProposalMsg("user2", 1, "1"))
+	assert.Equal(t, ErrNotPendingProposal().Result(), result)
+
+	// creator cancels pending proposal, all contributors are refunded
+	result = handler(ctx, TextProposalMsg{
+		Creator: user1, Title: "title", Description: "description", Deposit: "10000"})
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewDepositProposalMsg("user2", 2, "10000"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	result = handler(ctx, NewCancelProposalMsg("user1", 2))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.False(t, proposalManager.IsPendingProposal(ctx, proposalID2))
+	proposal, err = proposalManager.storage.GetExpiredProposal(ctx, proposalID2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalRevoked, proposal.GetProposalInfo().Result)
+
+	for _, username := range []types.AccountKey{user1, user2} {
+		lst, err := am.GetFrozenMoneyList(ctx, username)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(lst))
+		assert.True(t, lst[1].Amount.IsEqual(c10000))
+	}
+	pendingList, err := proposalManager.GetPendingProposalList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pendingList))
+}
+
+func TestExpirePendingProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm, dm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	user2 := createTestAccount(ctx, am, "user2", c460000)
+
+	c10000 := types.NewCoinFromInt64(10000 * types.Decimals)
+	c20000 := types.NewCoinFromInt64(20000 * types.Decimals)
+	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	proposalID2 := types.ProposalKey(strconv.FormatInt(int64(2), 10))
+
+	result := handler(ctx, TextProposalMsg{
+		Creator: user1, Title: "title", Description: "description", Deposit: "10000"})
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewDepositProposalMsg("user2", 1, "20000"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// expiry event is scheduled at the end of deposit period
+	eventList := gm.GetTimeEventListAtTime(ctx, curTime+proposalParam.ProposalDepositPeriodSec)
+	assert.Equal(t, 1, len(eventList.Events))
+	event := ExpirePendingProposalEvent{ProposalID: proposalID1}
+	assert.Equal(t, event, eventList.Events[0])
+
+	// underfunded proposal expires, all contributors are refunded
+	err := event.Execute(ctx, am, proposalManager, dm, &gm)
+	assert.Nil(t, err)
+	assert.False(t, proposalManager.IsPendingProposal(ctx, proposalID1))
+	proposal, err := proposalManager.storage.GetExpiredProposal(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalNotPass, proposal.GetProposalInfo().Result)
+	_, err = proposalManager.GetProposalFunding(ctx, proposalID1)
+	assert.Equal(t, model.ErrProposalFundingNotFound(), err)
+	for _, tc := range []struct {
+		username types.AccountKey
+		amount   types.Coin
+	}{
+		{user1, c10000},
+		{user2, c20000},
+	} {
+		lst, err := am.GetFrozenMoneyList(ctx, tc.username)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(lst))
+		assert.True(t, lst[0].Amount.IsEqual(tc.amount))
+	}
+
+	// deposit can't be added after proposal expired
+	result = handler(ctx, NewDepositProposalMsg("user2", 1, "1"))
+	assert.Equal(t, ErrNotPendingProposal().Result(), result)
+
+	// proposal funded within deposit period is not affected by expiry event
+	result = handler(ctx, TextProposalMsg{
+		Creator: user1, Title: "title", Description: "description", Deposit: "10000"})
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewDepositProposalMsg("user2", 2, "100000"))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsOngoingProposal(ctx, proposalID2))
+	err = ExpirePendingProposalEvent{ProposalID: proposalID2}.Execute(ctx, am, proposalManager, dm, &gm)
+	assert.Nil(t, err)
+	assert.True(t, proposalManager.IsOngoingProposal(ctx, proposalID2))
+}
+
+func TestProtocolUpgradeProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, valManager, gm, dm := setupTest(t, 10)
+	handler := NewHandler(am, proposalManager, postManager, dm, &gm, vm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+
+	initCoin := proposalParam.ProtocolUpgradeMinDeposit.Plus(
+		proposalParam.ProtocolUpgradeMinDeposit).Plus(proposalParam.ProtocolUpgradeMinDeposit)
+	user1 := createTestAccount(ctx, am, "user1", initCoin)
+	_ = vm.AddVoter(ctx, user1, proposalParam.ProtocolUpgradePassVotes.Plus(c46))
+
+	// upgrade height must not be reachable before proposal is decided
+	minHeight := ctx.BlockHeight() + proposalParam.ProtocolUpgradeDecideSec
+	testCases := []struct {
+		testName    string
+		msg         UpgradeProtocolMsg
+		wantResult  sdk.Result
+		proposalID  types.ProposalKey
+		wantPlan    *globalmodel.UpgradePlan
+		wantPlanErr sdk.Error
+	}{
+		{
+			testName:    "upgrade without name is done manually",
+			msg:         NewUpgradeProtocolMsg("user1", "link1", "", "", 0),
+			wantResult:  sdk.Result{},
+			proposalID:  types.ProposalKey("1"),
+			wantPlan:    nil,
+			wantPlanErr: globalmodel.ErrUpgradePlanNotFound(),
+		},
+		{
+			testName:    "upgrade height can be reached before proposal is decided",
+			msg:         NewUpgradeProtocolMsg("user1", "link2", "", "upgrade2", minHeight),
+			wantResult:  ErrInvalidUpgradePlan().Result(),
+			wantPlan:    nil,
+			wantPlanErr: globalmodel.ErrUpgradePlanNotFound(),
+		},
+		{
+			testName:   "named upgrade is scheduled at height",
+			msg:        NewUpgradeProtocolMsg("user1", "link3", "", "upgrade3", minHeight+1),
+			wantResult: sdk.Result{},
+			proposalID: types.ProposalKey("2"),
+			wantPlan: &globalmodel.UpgradePlan{
+				ProposalID: types.ProposalKey("2"),
+				Name:       "upgrade3",
+				Height:     minHeight + 1,
+				Link:       "link3",
+			},
+			wantPlanErr: nil,
+		},
+		{
+			testName:   "another upgrade plan is pending",
+			msg:        NewUpgradeProtocolMsg("user1", "link4", "", "upgrade4", minHeight+2),
+			wantResult: ErrUpgradePlanPending().Result(),
+			wantPlan: &globalmodel.UpgradePlan{
+				ProposalID: types.ProposalKey("2"),
+				Name:       "upgrade3",
+				Height:     minHeight + 1,
+				Link:       "link3",
+			},
+			wantPlanErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		assert.Equal(t, tc.wantResult, result, tc.testName)
+
+		if result.IsOK() {
+			result = handler(ctx, VoteProposalMsg{Voter: user1, ProposalID: tc.proposalID, Result: true})
+			assert.Equal(t, sdk.Result{}, result, tc.testName)
+
+			event := DecideProposalEvent{ProposalType: types.ProtocolUpgrade, ProposalID: tc.proposalID}
+			err := event.Execute(ctx, vm, valManager, am, proposalManager, postManager, dm, &gm)
+			assert.Nil(t, err, tc.testName)
+		}
+
+		plan, err := gm.GetUpgradePlan(ctx)
+		assert.Equal(t, tc.wantPlanErr, err, tc.testName)
+		assert.Equal(t, tc.wantPlan, plan, tc.testName)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -43,6 +44,12 @@ func (pm ProposalManager) IsOngoingProposal(ctx sdk.Context, proposalID types.Pr
 	return err == nil
 }
 
+// IsPendingProposal - check given proposal ID is waiting for deposit
+func (pm ProposalManager) IsPendingProposal(ctx sdk.Context, proposalID types.ProposalKey) bool {
+	_, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	return err == nil
+}
+
 // CreateContentCensorshipProposal - create a content censorship proposal
 func (pm ProposalManager) CreateContentCensorshipProposal(
 	ctx sdk.Context, permlink types.Permlink, reason string) model.Proposal {
@@ -52,11 +59,65 @@ func (pm ProposalManager) CreateContentCensorshipProposal(
 	}
 }
 
+// CreateEscalatedContentCensorshipProposal - create a content censorship proposal opened by report escalation
+func (pm ProposalManager) CreateEscalatedContentCensorshipProposal(
+	ctx sdk.Context, permlink types.Permlink) model.Proposal {
+	return &model.ContentCensorshipProposal{
+		Permlink:    permlink,
+		Reason:      types.CensorshipEscalationReason,
+		IsEscalated: true,
+	}
+}
+
 // CreateProtocolUpgradeProposal - create a protocol upgrade proposal
-func (pm ProposalManager) CreateProtocolUpgradeProposal(ctx sdk.Context, link string, reason string) model.Proposal {
+func (pm ProposalManager) CreateProtocolUpgradeProposal(
+	ctx sdk.Context, link string, reason string, name string, height int64) model.Proposal {
 	return &model.ProtocolUpgradeProposal{
 		Link:   link,
 		Reason: reason,
+		Name:   name,
+		Height: height,
+	}
+}
+
+// CreateTextProposal - create a text proposal
+func (pm ProposalManager) CreateTextProposal(ctx sdk.Context, title string, description string) model.Proposal {
+	return &model.TextProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// CreateCommunityPoolSpendProposal - create a community pool spend proposal
+func (pm ProposalManager) CreateCommunityPoolSpendProposal(
+	ctx sdk.Context, recipient types.AccountKey, amount types.Coin, reason string) model.Proposal {
+	return &model.CommunityPoolSpendProposal{
+		Recipient: recipient,
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// CreateDeveloperPunishProposal - create a developer punish proposal
+func (pm ProposalManager) CreateDeveloperPunishProposal(
+	ctx sdk.Context, developer types.AccountKey, slashRatio sdk.Dec, revoke bool, reason string) model.Proposal {
+	return &model.DeveloperPunishProposal{
+		Developer:  developer,
+		SlashRatio: slashRatio,
+		Revoke:     revoke,
+		Reason:     reason,
+	}
+}
+
+// CreateCensorshipAppealProposal - create an appeal against passed content censorship
+func (pm ProposalManager) CreateCensorshipAppealProposal(
+	ctx sdk.Context, permlink types.Permlink, censorshipID types.ProposalKey,
+	reason string, deposit types.Coin) model.Proposal {
+	return &model.CensorshipAppealProposal{
+		Permlink:             permlink,
+		CensorshipProposalID: censorshipID,
+		Reason:               reason,
+		Deposit:              deposit,
 	}
 }
 
@@ -69,6 +130,15 @@ func (pm ProposalManager) CreateChangeParamProposal(
 	}
 }
 
+// CreateChangeParamsProposal - create a proposal changing several parameters together
+func (pm ProposalManager) CreateChangeParamsProposal(
+	ctx sdk.Context, parameters []param.Parameter, reason string) model.Proposal {
+	return &model.ChangeParamsProposal{
+		Params: parameters,
+		Reason: reason,
+	}
+}
+
 // GetNextProposalID - get next proposal ID from KV store
 func (pm ProposalManager) GetNextProposalID(ctx sdk.Context) (types.ProposalKey, sdk.Error) {
 	nextProposalID, err := pm.storage.GetNextProposalID(ctx)
@@ -124,39 +194,265 @@ func (pm ProposalManager) AddProposal(
 	return newID, nil
 }
 
+// AddPendingProposal - add a new proposal which is waiting for deposit to reach minimum deposit
+func (pm ProposalManager) AddPendingProposal(
+	ctx sdk.Context, creator types.AccountKey, proposal model.Proposal, proposalType types.ProposalType,
+	decideSec int64, minDeposit types.Coin) (types.ProposalKey, sdk.Error) {
+	newID, err := pm.GetNextProposalID(ctx)
+	if err != nil {
+		return newID, err
+	}
+
+	info := model.ProposalInfo{
+		Creator:       creator,
+		ProposalID:    newID,
+		AgreeVotes:    types.NewCoinFromInt64(0),
+		DisagreeVotes: types.NewCoinFromInt64(0),
+		Result:        types.ProposalNotPass,
+		CreatedAt:     ctx.BlockHeader().Time.Unix(),
+	}
+	proposal.SetProposalInfo(info)
+
+	if err := pm.storage.SetPendingProposal(ctx, newID, proposal); err != nil {
+		return newID, err
+	}
+
+	funding := &model.ProposalFunding{
+		ProposalType: proposalType,
+		DecideSec:    decideSec,
+		MinDeposit:   minDeposit,
+		Deposited:    types.NewCoinFromInt64(0),
+		Deposits:     []model.ProposalDeposit{},
+	}
+	if err := pm.storage.SetProposalFunding(ctx, newID, funding); err != nil {
+		return newID, err
+	}
+
+	if err := pm.IncreaseNextProposalID(ctx); err != nil {
+		return newID, err
+	}
+
+	return newID, nil
+}
+
+// GetProposalFunding - get deposit raised by pending proposal
+func (pm ProposalManager) GetProposalFunding(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProposalFunding, sdk.Error) {
+	return pm.storage.GetProposalFunding(ctx, proposalID)
+}
+
+// AddProposalDeposit - add deposit of depositor to pending proposal,
+// deposits from same account are merged
+func (pm ProposalManager) AddProposalDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, depositor types.AccountKey,
+	amount types.Coin) (*model.ProposalFunding, sdk.Error) {
+	funding, err := pm.storage.GetProposalFunding(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	funding.Deposited = funding.Deposited.Plus(amount)
+	found := false
+	for i, deposit := range funding.Deposits {
+		if deposit.Username == depositor {
+			funding.Deposits[i].Amount = deposit.Amount.Plus(amount)
+			found = true
+			break
+		}
+	}
+	if !found {
+		funding.Deposits = append(funding.Deposits, model.ProposalDeposit{
+			Username: depositor,
+			Amount:   amount,
+		})
+	}
+
+	if err := pm.storage.SetProposalFunding(ctx, proposalID, funding); err != nil {
+		return nil, err
+	}
+	return funding, nil
+}
+
+// ActivateProposal - move fully funded proposal from pending to ongoing list,
+// voting period starts from now
+func (pm ProposalManager) ActivateProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProposalFunding, sdk.Error) {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	funding, err := pm.storage.GetProposalFunding(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.ExpiredAt = ctx.BlockHeader().Time.Unix() + funding.DecideSec
+	proposal.SetProposalInfo(proposalInfo)
+
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return nil, err
+	}
+	if err := pm.storage.DeletePendingProposal(ctx, proposalID); err != nil {
+		return nil, err
+	}
+	pm.storage.DeleteProposalFunding(ctx, proposalID)
+	return funding, nil
+}
+
+// CancelProposal - creator cancels pending proposal, proposal is moved to expired list
+// as revoked and its deposit record is returned for refund
+func (pm ProposalManager) CancelProposal(
+	ctx sdk.Context, proposalID types.ProposalKey, creator types.AccountKey) (*model.ProposalFunding, sdk.Error) {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.GetProposalInfo().Creator != creator {
+		return nil, ErrNotProposalCreator()
+	}
+	return pm.closePendingProposal(ctx, proposalID, proposal, types.ProposalRevoked)
+}
+
+// ExpirePendingProposal - pending proposal which didn't reach minimum deposit in deposit
+// period is moved to expired list as not passed, its deposit record is returned for refund
+func (pm ProposalManager) ExpirePendingProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProposalFunding, sdk.Error) {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	return pm.closePendingProposal(ctx, proposalID, proposal, types.ProposalNotPass)
+}
+
+func (pm ProposalManager) closePendingProposal(
+	ctx sdk.Context, proposalID types.ProposalKey, proposal model.Proposal,
+	result types.ProposalResult) (*model.ProposalFunding, sdk.Error) {
+	funding, err := pm.storage.GetProposalFunding(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.Result = result
+	proposalInfo.ExpiredAt = ctx.BlockHeader().Time.Unix()
+	proposal.SetProposalInfo(proposalInfo)
+
+	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
+		return nil, err
+	}
+	if err := pm.storage.DeletePendingProposal(ctx, proposalID); err != nil {
+		return nil, err
+	}
+	pm.storage.DeleteProposalFunding(ctx, proposalID)
+	return funding, nil
+}
+
 // GetProposalPassParam - based on proposal type, get pass ratio and pass vote requirement
 func (pm ProposalManager) GetProposalPassParam(
 	ctx sdk.Context, proposalType types.ProposalType) (sdk.Dec, types.Coin, sdk.Error) {
-	param, err := pm.paramHolder.GetProposalParam(ctx)
+	proposalParam, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return sdk.NewDec(1), types.NewCoinFromInt64(0), err
 	}
+	return GetPassParam(proposalParam, proposalType)
+}
+
+// GetPassParam - based on proposal type, get pass ratio and pass vote requirement from proposal param
+func GetPassParam(
+	proposalParam *param.ProposalParam, proposalType types.ProposalType) (sdk.Dec, types.Coin, sdk.Error) {
 	switch proposalType {
 	case types.ChangeParam:
-		return param.ChangeParamPassRatio, param.ChangeParamPassVotes, nil
+		return proposalParam.ChangeParamPassRatio, proposalParam.ChangeParamPassVotes, nil
 	case types.ContentCensorship:
-		return param.ContentCensorshipPassRatio, param.ContentCensorshipPassVotes, nil
+		return proposalParam.ContentCensorshipPassRatio, proposalParam.ContentCensorshipPassVotes, nil
 	case types.ProtocolUpgrade:
-		return param.ProtocolUpgradePassRatio, param.ProtocolUpgradePassVotes, nil
+		return proposalParam.ProtocolUpgradePassRatio, proposalParam.ProtocolUpgradePassVotes, nil
+	case types.TextProposal:
+		return proposalParam.TextProposalPassRatio, proposalParam.TextProposalPassVotes, nil
+	case types.CommunitySpend:
+		return proposalParam.CommunitySpendPassRatio, proposalParam.CommunitySpendPassVotes, nil
+	case types.DeveloperPunish:
+		return proposalParam.DeveloperPunishPassRatio, proposalParam.DeveloperPunishPassVotes, nil
+	case types.CensorshipAppeal:
+		return proposalParam.CensorshipAppealPassRatio, proposalParam.CensorshipAppealPassVotes, nil
 	default:
 		return sdk.NewDec(1), types.NewCoinFromInt64(0), ErrIncorrectProposalType()
 	}
 }
 
-// UpdateProposalVotingStatus - update proposal status after voting
+// NewProposalDetail - get tally of proposal and the result if it was decided with current votes
+func NewProposalDetail(
+	proposal model.Proposal, status string, proposalParam *param.ProposalParam) (*model.ProposalDetail, sdk.Error) {
+	proposalType, ok := model.GetProposalType(proposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	passRatio, passVotes, err := GetPassParam(proposalParam, proposalType)
+	if err != nil {
+		return nil, err
+	}
+	info := proposal.GetProposalInfo()
+	result := info.Result
+	if status != model.ProposalStatusExpired {
+		result = info.GetResult(passRatio, passVotes)
+	}
+	return &model.ProposalDetail{
+		Proposal:        proposal,
+		Status:          status,
+		AgreeVotes:      info.AgreeVotes,
+		DisagreeVotes:   info.DisagreeVotes,
+		PassRatio:       passRatio,
+		PassVotes:       passVotes,
+		ProjectedResult: result,
+	}, nil
+}
+
+// GetProposalDetail - get proposal from pending, ongoing or expired list with its tally
+func (pm ProposalManager) GetProposalDetail(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProposalDetail, sdk.Error) {
+	proposalParam, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var proposal model.Proposal
+	var status string
+	switch {
+	case pm.IsOngoingProposal(ctx, proposalID):
+		proposal, err = pm.storage.GetOngoingProposal(ctx, proposalID)
+		status = model.ProposalStatusOngoing
+	case pm.IsPendingProposal(ctx, proposalID):
+		proposal, err = pm.storage.GetPendingProposal(ctx, proposalID)
+		status = model.ProposalStatusPending
+	default:
+		proposal, err = pm.storage.GetExpiredProposal(ctx, proposalID)
+		status = model.ProposalStatusExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewProposalDetail(proposal, status, proposalParam)
+}
+
+// UpdateProposalVotingStatus - update proposal status after voting, if voter changed
+// the vote, voting power of previous vote is removed from tally first.
+// Locked power of vote with conviction n counts n+1 times.
 func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID types.ProposalKey,
-	voter types.AccountKey, voteResult bool, votingPower types.Coin) sdk.Error {
+	voter types.AccountKey, prevVote *votemodel.Vote, vote *votemodel.Vote) sdk.Error {
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 	proposalInfo := proposal.GetProposalInfo()
 
-	if voteResult == true {
-		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Plus(votingPower)
-	} else {
-		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Plus(votingPower)
+	if prevVote != nil {
+		agreeVotes, disagreeVotes := getConvictionVotes(prevVote)
+		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Minus(agreeVotes)
+		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Minus(disagreeVotes)
 	}
+	agreeVotes, disagreeVotes := getConvictionVotes(vote)
+	proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Plus(agreeVotes)
+	proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Plus(disagreeVotes)
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
@@ -166,6 +462,11 @@ func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID
 	return nil
 }
 
+// getConvictionVotes - agree and disagree votes of a vote with its locked power multiplied by conviction
+func getConvictionVotes(vote *votemodel.Vote) (types.Coin, types.Coin) {
+	return vote.GetConvictionAgreeVotes(), vote.GetConvictionDisagreeVotes()
+}
+
 // UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired
 func (pm ProposalManager) UpdateProposalPassStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
@@ -189,14 +490,7 @@ func (pm ProposalManager) UpdateProposalStatus(
 	if err != nil {
 		return types.ProposalNotPass, err
 	}
-	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
-	actualRatio := proposalInfo.AgreeVotes.ToDec().Quo(totalVotes.ToDec())
-
-	if !totalVotes.IsGT(minVotes) || !ratio.LT(actualRatio) {
-		proposalInfo.Result = types.ProposalNotPass
-	} else {
-		proposalInfo.Result = types.ProposalPass
-	}
+	proposalInfo.Result = proposalInfo.GetResult(ratio, minVotes)
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
@@ -227,15 +521,14 @@ func (pm ProposalManager) CreateParamChangeEvent(
 		return nil, err
 	}
 
-	p, ok := proposal.(*model.ChangeParamProposal)
-	if !ok {
+	switch p := proposal.(type) {
+	case *model.ChangeParamProposal:
+		return param.ChangeParamEvent{Param: p.Param}, nil
+	case *model.ChangeParamsProposal:
+		return param.ChangeParamEvent{Params: p.Params}, nil
+	default:
 		return nil, ErrIncorrectProposalType()
 	}
-
-	event := param.ChangeParamEvent{
-		Param: p.Param,
-	}
-	return event, nil
 }
 
 // GetPermlink - get permlink from expired proposal list
@@ -252,7 +545,127 @@ func (pm ProposalManager) GetPermlink(ctx sdk.Context, proposalID types.Proposal
 	return p.Permlink, nil
 }
 
+// GetProtocolUpgrade - get expired protocol upgrade proposal
+func (pm ProposalManager) GetProtocolUpgrade(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProtocolUpgradeProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.ProtocolUpgradeProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// GetCommunityPoolSpend - get recipient, amount and reason of expired community pool spend proposal
+func (pm ProposalManager) GetCommunityPoolSpend(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.CommunityPoolSpendProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.CommunityPoolSpendProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// GetDeveloperPunish - get expired developer punish proposal
+func (pm ProposalManager) GetDeveloperPunish(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.DeveloperPunishProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.DeveloperPunishProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// GetAppealableCensorship - get passed content censorship proposal which hasn't been appealed
+func (pm ProposalManager) GetAppealableCensorship(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ContentCensorshipProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, ErrCensorshipNotAppealable()
+	}
+
+	p, ok := proposal.(*model.ContentCensorshipProposal)
+	if !ok || p.Result != types.ProposalPass || p.IsAppealed {
+		return nil, ErrCensorshipNotAppealable()
+	}
+	return p, nil
+}
+
+// MarkCensorshipAppealed - content censorship can only be appealed once
+func (pm ProposalManager) MarkCensorshipAppealed(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	p, err := pm.GetAppealableCensorship(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	p.IsAppealed = true
+	return pm.storage.SetExpiredProposal(ctx, proposalID, p)
+}
+
+// GetCensorshipAppeal - get censorship appeal from expired proposal list
+func (pm ProposalManager) GetCensorshipAppeal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.CensorshipAppealProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.CensorshipAppealProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// SetEscalationDeposit - record deposit funded by censorship escalation pool
+func (pm ProposalManager) SetEscalationDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, deposit types.Coin) sdk.Error {
+	return pm.storage.SetEscalationDeposit(ctx, proposalID, deposit)
+}
+
+// ReleaseEscalationDeposit - get and remove deposit of expired escalated proposal,
+// zero if content censorship proposal is not opened by report escalation
+func (pm ProposalManager) ReleaseEscalationDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey) (types.Coin, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+
+	p, ok := proposal.(*model.ContentCensorshipProposal)
+	if !ok {
+		return types.NewCoinFromInt64(0), ErrIncorrectProposalType()
+	}
+	if !p.IsEscalated {
+		return types.NewCoinFromInt64(0), nil
+	}
+	deposit, err := pm.storage.GetEscalationDeposit(ctx, proposalID)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	pm.storage.DeleteEscalationDeposit(ctx, proposalID)
+	return deposit, nil
+}
+
 // GetOngoingProposalList - get ongoing proposal list
 func (pm ProposalManager) GetOngoingProposalList(ctx sdk.Context) ([]model.Proposal, sdk.Error) {
 	return pm.storage.GetOngoingProposalList(ctx)
 }
+
+// GetPendingProposalList - get list of proposals waiting for deposit
+func (pm ProposalManager) GetPendingProposalList(ctx sdk.Context) ([]model.Proposal, sdk.Error) {
+	return pm.storage.GetPendingProposalList(ctx)
+}
@@ -6,11 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateProposalVotingStatus(t *testing.T) {
-	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	ctx, _, pm, _, _, _, _, _ := setupTest(t, 0)
 	permlink := types.Permlink("permlink")
 	user1 := types.AccountKey("user1")
 	censorshipReason := "reason"
@@ -88,7 +89,8 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 		},
 	}
 	for _, tc := range testCases {
-		err := pm.UpdateProposalVotingStatus(ctx, tc.proposalID, tc.voter, tc.voteResult, tc.votingPower)
+		vote := &votemodel.Vote{Voter: tc.voter, VotingPower: tc.votingPower, Result: tc.voteResult}
+		err := pm.UpdateProposalVotingStatus(ctx, tc.proposalID, tc.voter, nil, vote)
 		if err != nil {
 			t.Errorf("%s: failed to update proposal voting status, got err %v", tc.testName, err)
 		}
@@ -104,7 +106,7 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 }
 
 func TestUpdateProposalPassStatus(t *testing.T) {
-	ctx, _, pm, _, _, _, _ := setupTest(t, 100000000)
+	ctx, _, pm, _, _, _, _, _ := setupTest(t, 100000000)
 	permlink := types.Permlink("permlink")
 	user1 := types.AccountKey("user1")
 	censorshipReason := "reason"
@@ -233,7 +235,7 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 }
 
 func TestGetProposalPassParam(t *testing.T) {
-	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	ctx, _, pm, _, _, _, _, _ := setupTest(t, 0)
 
 	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
 	testCases := []struct {
@@ -267,6 +269,22 @@ func TestGetProposalPassParam(t *testing.T) {
 			wantPassVotes: proposalParam.ProtocolUpgradePassVotes,
 		},
 
+		{
+			testName:      "test pass param for developerPunishProposal",
+			proposalType:  types.DeveloperPunish,
+			wantError:     nil,
+			wantPassRatio: proposalParam.DeveloperPunishPassRatio,
+			wantPassVotes: proposalParam.DeveloperPunishPassVotes,
+		},
+
+		{
+			testName:      "test pass param for communitySpendProposal",
+			proposalType:  types.CommunitySpend,
+			wantError:     nil,
+			wantPassRatio: proposalParam.CommunitySpendPassRatio,
+			wantPassVotes: proposalParam.CommunitySpendPassVotes,
+		},
+
 		{
 			testName:      "test wrong proposal type",
 			proposalType:  23,
@@ -293,3 +311,46 @@ func TestGetProposalPassParam(t *testing.T) {
 	}
 
 }
+
+func TestGetProposalDetail(t *testing.T) {
+	ctx, _, pm, _, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	user1 := types.AccountKey("user1")
+	decideSec := proposalParam.ContentCensorshipDecideSec
+
+	proposalID1, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink1", "reason"), decideSec)
+	proposalID2, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink2", "reason"), decideSec)
+	passVotes := proposalParam.ContentCensorshipPassVotes.Plus(c46)
+	err := addProposalInfo(ctx, pm, proposalID1, passVotes, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	err = addProposalInfo(ctx, pm, proposalID2, types.NewCoinFromInt64(0), passVotes)
+	assert.Nil(t, err)
+
+	// ongoing proposal result is projected with current votes
+	detail, err := pm.GetProposalDetail(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ProposalStatusOngoing, detail.Status)
+	assert.Equal(t, types.ProposalPass, detail.ProjectedResult)
+	assert.True(t, passVotes.IsEqual(detail.AgreeVotes))
+	assert.True(t, detail.DisagreeVotes.IsZero())
+	assert.True(t, proposalParam.ContentCensorshipPassRatio.Equal(detail.PassRatio))
+	assert.True(t, proposalParam.ContentCensorshipPassVotes.IsEqual(detail.PassVotes))
+
+	detail, err = pm.GetProposalDetail(ctx, proposalID2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalNotPass, detail.ProjectedResult)
+
+	// expired proposal result is final
+	_, err = pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, proposalID1)
+	assert.Nil(t, err)
+	detail, err = pm.GetProposalDetail(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ProposalStatusExpired, detail.Status)
+	assert.Equal(t, types.ProposalPass, detail.ProjectedResult)
+
+	_, err = pm.GetProposalDetail(ctx, types.ProposalKey("100"))
+	assert.NotNil(t, err)
+}
@@ -36,3 +36,33 @@ func ErrFailedToUnmarshalProposal(err error) sdk.Error {
 func ErrFailedToUnmarshalNextProposalID(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalNextProposalID, fmt.Sprintf("failed to unmarshal next proposal id: %s", err.Error()))
 }
+
+// ErrEscalationDepositNotFound - error if escalation deposit is not found in KVStore
+func ErrEscalationDepositNotFound() sdk.Error {
+	return types.NewError(types.CodeEscalationDepositNotFound, fmt.Sprintf("escalation deposit is not found"))
+}
+
+// ErrFailedToMarshalEscalation - error if marshal escalation deposit failed
+func ErrFailedToMarshalEscalation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalEscalation, fmt.Sprintf("failed to marshal escalation deposit: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalEscalation - error if unmarshal escalation deposit failed
+func ErrFailedToUnmarshalEscalation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalEscalation, fmt.Sprintf("failed to unmarshal escalation deposit: %s", err.Error()))
+}
+
+// ErrProposalFundingNotFound - error if deposit funding of proposal is not found in KVStore
+func ErrProposalFundingNotFound() sdk.Error {
+	return types.NewError(types.CodeProposalFundingNotFound, fmt.Sprintf("proposal funding is not found"))
+}
+
+// ErrFailedToMarshalFunding - error if marshal proposal funding failed
+func ErrFailedToMarshalFunding(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalFunding, fmt.Sprintf("failed to marshal proposal funding: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalFunding - error if unmarshal proposal funding failed
+func ErrFailedToUnmarshalFunding(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFunding, fmt.Sprintf("failed to unmarshal proposal funding: %s", err.Error()))
+}
@@ -1,10 +1,18 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	types "github.com/lino-network/lino/types"
 )
 
+// proposal status shown in proposal detail
+const (
+	ProposalStatusPending = "pending"
+	ProposalStatusOngoing = "ongoing"
+	ProposalStatusExpired = "expired"
+)
+
 // Proposal - there are three proposal types
 // 1) change parameter proposal
 // 2) content censorship proposal
@@ -26,6 +34,20 @@ type ProposalInfo struct {
 	Reason        string               `json:"reason"`
 }
 
+// GetResult - proposal passes if total votes exceed pass votes and agree ratio exceeds pass ratio,
+// proposal nobody voted on can't pass
+func (info ProposalInfo) GetResult(passRatio sdk.Dec, passVotes types.Coin) types.ProposalResult {
+	totalVotes := info.AgreeVotes.Plus(info.DisagreeVotes)
+	if !totalVotes.IsGT(passVotes) || totalVotes.IsZero() {
+		return types.ProposalNotPass
+	}
+	actualRatio := info.AgreeVotes.ToDec().Quo(totalVotes.ToDec())
+	if !passRatio.LT(actualRatio) {
+		return types.ProposalNotPass
+	}
+	return types.ProposalPass
+}
+
 // ChangeParamProposal - change parameter proposal
 type ChangeParamProposal struct {
 	ProposalInfo
@@ -39,11 +61,28 @@ func (p *ChangeParamProposal) GetProposalInfo() ProposalInfo { return p.Proposal
 // SetProposalInfo - implements Proposal
 func (p *ChangeParamProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// ChangeParamsProposal - change several parameters together, applied atomically if passed
+type ChangeParamsProposal struct {
+	ProposalInfo
+	Params []param.Parameter `json:"params"`
+	Reason string            `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *ChangeParamsProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *ChangeParamsProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
 // ContentCensorshipProposal - content censorship proposal
+// IsEscalated - proposal is opened automatically when reports exceed the threshold
+// IsAppealed - author has appealed the passed censorship
 type ContentCensorshipProposal struct {
 	ProposalInfo
-	Permlink types.Permlink `json:"permlink"`
-	Reason   string         `json:"reason"`
+	Permlink    types.Permlink `json:"permlink"`
+	Reason      string         `json:"reason"`
+	IsEscalated bool           `json:"is_escalated"`
+	IsAppealed  bool           `json:"is_appealed"`
 }
 
 // GetProposalInfo - implements Proposal
@@ -52,11 +91,30 @@ func (p *ContentCensorshipProposal) GetProposalInfo() ProposalInfo { return p.Pr
 // SetProposalInfo - implements Proposal
 func (p *ContentCensorshipProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
-// ProtocolUpgradeProposal - protocol upgrade proposal
+// CensorshipAppealProposal - author's appeal against passed content censorship,
+// post is restored and deposit is refunded only if appeal passed
+type CensorshipAppealProposal struct {
+	ProposalInfo
+	Permlink             types.Permlink    `json:"permlink"`
+	CensorshipProposalID types.ProposalKey `json:"censorship_proposal_id"`
+	Reason               string            `json:"reason"`
+	Deposit              types.Coin        `json:"deposit"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *CensorshipAppealProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *CensorshipAppealProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// ProtocolUpgradeProposal - protocol upgrade proposal, upgrade is scheduled
+// at height if name is specified, otherwise it needs to be done manually
 type ProtocolUpgradeProposal struct {
 	ProposalInfo
 	Link   string `json:"link"`
 	Reason string `json:"reason"`
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
 }
 
 // GetProposalInfo - implements Proposal
@@ -65,6 +123,98 @@ func (p *ProtocolUpgradeProposal) GetProposalInfo() ProposalInfo { return p.Prop
 // SetProposalInfo - implements Proposal
 func (p *ProtocolUpgradeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// TextProposal - non-binding text proposal, only the result is recorded
+type TextProposal struct {
+	ProposalInfo
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *TextProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *TextProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// CommunityPoolSpendProposal - pay coin from community pool to recipient if passed
+type CommunityPoolSpendProposal struct {
+	ProposalInfo
+	Recipient types.AccountKey `json:"recipient"`
+	Amount    types.Coin       `json:"amount"`
+	Reason    string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *CommunityPoolSpendProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *CommunityPoolSpendProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// DeveloperPunishProposal - slash deposit of developer abusing consumption if passed,
+// developer can also be revoked and can't register again until punish cooldown ends
+type DeveloperPunishProposal struct {
+	ProposalInfo
+	Developer  types.AccountKey `json:"developer"`
+	SlashRatio sdk.Dec          `json:"slash_ratio"`
+	Revoke     bool             `json:"revoke"`
+	Reason     string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *DeveloperPunishProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *DeveloperPunishProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// GetProposalType - get type of proposal, return false if proposal type is unknown
+func GetProposalType(p Proposal) (types.ProposalType, bool) {
+	switch p.(type) {
+	case *ChangeParamProposal, *ChangeParamsProposal:
+		return types.ChangeParam, true
+	case *ContentCensorshipProposal:
+		return types.ContentCensorship, true
+	case *CensorshipAppealProposal:
+		return types.CensorshipAppeal, true
+	case *ProtocolUpgradeProposal:
+		return types.ProtocolUpgrade, true
+	case *TextProposal:
+		return types.TextProposal, true
+	case *CommunityPoolSpendProposal:
+		return types.CommunitySpend, true
+	case *DeveloperPunishProposal:
+		return types.DeveloperPunish, true
+	default:
+		return types.ProposalType(0), false
+	}
+}
+
+// ProposalDetail - proposal with its tally and the result if it was decided with current votes,
+// result of expired proposal is final
+type ProposalDetail struct {
+	Proposal        Proposal             `json:"proposal"`
+	Status          string               `json:"status"`
+	AgreeVotes      types.Coin           `json:"agree_votes"`
+	DisagreeVotes   types.Coin           `json:"disagree_votes"`
+	PassRatio       sdk.Dec              `json:"pass_ratio"`
+	PassVotes       types.Coin           `json:"pass_votes"`
+	ProjectedResult types.ProposalResult `json:"projected_result"`
+}
+
+// ProposalDeposit - deposit contributed by an account to proposal
+type ProposalDeposit struct {
+	Username types.AccountKey `json:"username"`
+	Amount   types.Coin       `json:"amount"`
+}
+
+// ProposalFunding - deposit raised by proposal which is waiting to reach minimum deposit
+type ProposalFunding struct {
+	ProposalType types.ProposalType `json:"proposal_type"`
+	DecideSec    int64              `json:"decide_second"`
+	MinDeposit   types.Coin         `json:"min_deposit"`
+	Deposited    types.Coin         `json:"deposited"`
+	Deposits     []ProposalDeposit  `json:"deposits"`
+}
+
 // NextProposalID - store next proposal ID to KVStore
 type NextProposalID struct {
 	NextProposalID int64 `json:"next_proposal_id"`
@@ -11,6 +11,9 @@ var (
 	nextProposalIDSubstore  = []byte{0x00}
 	ongoingProposalSubStore = []byte{0x01}
 	expiredProposalSubStore = []byte{0x02}
+	escalationSubStore      = []byte{0x03}
+	pendingProposalSubStore = []byte{0x04} // SubStore for proposals waiting for deposit
+	fundingSubStore         = []byte{0x05}
 )
 
 // ProposalStorage - proposal storage
@@ -19,13 +22,17 @@ type ProposalStorage struct {
 	cdc *wire.Codec
 }
 
-func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
-	cdc := wire.New()
-
+// RegisterWire - register proposal and parameter concrete types stored in proposal storage
+func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&ChangeParamProposal{}, "changeParam", nil)
+	cdc.RegisterConcrete(&ChangeParamsProposal{}, "changeParams", nil)
 	cdc.RegisterConcrete(&ProtocolUpgradeProposal{}, "upgrade", nil)
+	cdc.RegisterConcrete(&TextProposal{}, "text", nil)
+	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "communityPoolSpend", nil)
+	cdc.RegisterConcrete(&DeveloperPunishProposal{}, "developerPunish", nil)
 	cdc.RegisterConcrete(&ContentCensorshipProposal{}, "censorship", nil)
+	cdc.RegisterConcrete(&CensorshipAppealProposal{}, "censorshipAppeal", nil)
 
 	cdc.RegisterInterface((*param.Parameter)(nil), nil)
 	cdc.RegisterConcrete(param.GlobalAllocationParam{}, "allocation", nil)
@@ -38,7 +45,13 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "bandwidthParam", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "accountParam", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "postParam", nil)
+	cdc.RegisterConcrete(param.ReputationParam{}, "reputationParam", nil)
+}
 
+func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
+	cdc := wire.New()
+
+	RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
 	vs := ProposalStorage{
 		key: key,
@@ -61,7 +74,8 @@ func (ps ProposalStorage) InitGenesis(ctx sdk.Context) sdk.Error {
 // DoesProposalExist - check if proposal exists in KVStore or not
 func (ps ProposalStorage) DoesProposalExist(ctx sdk.Context, proposalID types.ProposalKey) bool {
 	store := ctx.KVStore(ps.key)
-	return store.Has(GetOngoingProposalKey(proposalID)) || store.Has(GetExpiredProposalKey(proposalID))
+	return store.Has(GetOngoingProposalKey(proposalID)) || store.Has(GetExpiredProposalKey(proposalID)) ||
+		store.Has(GetPendingProposalKey(proposalID))
 }
 
 // GetOngoingProposal - get proposal from ongoing proposal KVStore
@@ -128,6 +142,38 @@ func (ps ProposalStorage) DeleteExpiredProposal(ctx sdk.Context, proposalID type
 	return nil
 }
 
+// GetPendingProposal - get proposal from pending proposal KVStore
+func (ps ProposalStorage) GetPendingProposal(ctx sdk.Context, proposalID types.ProposalKey) (Proposal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	proposalByte := store.Get(GetPendingProposalKey(proposalID))
+	if proposalByte == nil {
+		return nil, ErrProposalNotFound()
+	}
+	proposal := new(Proposal)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalByte, proposal); err != nil {
+		return nil, ErrFailedToUnmarshalProposal(err)
+	}
+	return *proposal, nil
+}
+
+// SetPendingProposal - set proposal to pending proposal KVStore
+func (ps ProposalStorage) SetPendingProposal(ctx sdk.Context, proposalID types.ProposalKey, proposal Proposal) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	proposalByte, err := ps.cdc.MarshalBinaryLengthPrefixed(proposal)
+	if err != nil {
+		return ErrFailedToMarshalProposal(err)
+	}
+	store.Set(GetPendingProposalKey(proposalID), proposalByte)
+	return nil
+}
+
+// DeletePendingProposal - delete proposal from pending proposal KVStore
+func (ps ProposalStorage) DeletePendingProposal(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetPendingProposalKey(proposalID))
+	return nil
+}
+
 // GetOngoingProposalList - get ongoing proposal list from ongoing proposal KVStore
 func (ps ProposalStorage) GetOngoingProposalList(ctx sdk.Context) ([]Proposal, sdk.Error) {
 	store := ctx.KVStore(ps.key)
@@ -168,6 +214,26 @@ func (ps ProposalStorage) GetExpiredProposalList(ctx sdk.Context) ([]Proposal, s
 	return proposalList, nil
 }
 
+// GetPendingProposalList - get pending proposal list from pending proposal KVStore
+func (ps ProposalStorage) GetPendingProposalList(ctx sdk.Context) ([]Proposal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(pendingProposalSubStore))
+	defer iterator.Close()
+
+	var proposalList []Proposal
+	for ; iterator.Valid(); iterator.Next() {
+		proposalBytes := iterator.Value()
+		var p Proposal
+		err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalBytes, &p)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalProposal(err)
+		}
+		proposalList = append(proposalList, p)
+	}
+
+	return proposalList, nil
+}
+
 // GetNextProposalID - get next proposal ID from KVStore
 func (ps ProposalStorage) GetNextProposalID(ctx sdk.Context) (*NextProposalID, sdk.Error) {
 	store := ctx.KVStore(ps.key)
@@ -193,6 +259,71 @@ func (ps ProposalStorage) SetNextProposalID(ctx sdk.Context, nextProposalID *Nex
 	return nil
 }
 
+// GetEscalationDeposit - get deposit funded by censorship escalation pool for escalated proposal
+func (ps ProposalStorage) GetEscalationDeposit(ctx sdk.Context, proposalID types.ProposalKey) (types.Coin, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	depositBytes := store.Get(getEscalationKey(proposalID))
+	if depositBytes == nil {
+		return types.NewCoinFromInt64(0), ErrEscalationDepositNotFound()
+	}
+	deposit := types.NewCoinFromInt64(0)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(depositBytes, &deposit); err != nil {
+		return types.NewCoinFromInt64(0), ErrFailedToUnmarshalEscalation(err)
+	}
+	return deposit, nil
+}
+
+// SetEscalationDeposit - set deposit funded by censorship escalation pool for escalated proposal
+func (ps ProposalStorage) SetEscalationDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, deposit types.Coin) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	depositBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(deposit)
+	if err != nil {
+		return ErrFailedToMarshalEscalation(err)
+	}
+	store.Set(getEscalationKey(proposalID), depositBytes)
+	return nil
+}
+
+// DeleteEscalationDeposit - delete deposit of escalated proposal after returned to pool
+func (ps ProposalStorage) DeleteEscalationDeposit(ctx sdk.Context, proposalID types.ProposalKey) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getEscalationKey(proposalID))
+}
+
+// GetProposalFunding - get deposit raised by pending proposal
+func (ps ProposalStorage) GetProposalFunding(
+	ctx sdk.Context, proposalID types.ProposalKey) (*ProposalFunding, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	fundingBytes := store.Get(getFundingKey(proposalID))
+	if fundingBytes == nil {
+		return nil, ErrProposalFundingNotFound()
+	}
+	funding := new(ProposalFunding)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(fundingBytes, funding); err != nil {
+		return nil, ErrFailedToUnmarshalFunding(err)
+	}
+	return funding, nil
+}
+
+// SetProposalFunding - set deposit raised by pending proposal
+func (ps ProposalStorage) SetProposalFunding(
+	ctx sdk.Context, proposalID types.ProposalKey, funding *ProposalFunding) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	fundingBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*funding)
+	if err != nil {
+		return ErrFailedToMarshalFunding(err)
+	}
+	store.Set(getFundingKey(proposalID), fundingBytes)
+	return nil
+}
+
+// DeleteProposalFunding - delete deposit record after proposal is funded or cancelled
+func (ps ProposalStorage) DeleteProposalFunding(ctx sdk.Context, proposalID types.ProposalKey) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getFundingKey(proposalID))
+}
+
 // GetOngoingProposalKey - "ongoing proposal substore" + "proposal ID"
 func GetOngoingProposalKey(proposalID types.ProposalKey) []byte {
 	return append(ongoingProposalSubStore, proposalID...)
@@ -203,6 +334,19 @@ func GetExpiredProposalKey(proposalID types.ProposalKey) []byte {
 	return append(expiredProposalSubStore, proposalID...)
 }
 
+// GetPendingProposalKey - "pending proposal subStore" + "proposal ID"
+func GetPendingProposalKey(proposalID types.ProposalKey) []byte {
+	return append(pendingProposalSubStore, proposalID...)
+}
+
+func getFundingKey(proposalID types.ProposalKey) []byte {
+	return append(fundingSubStore, proposalID...)
+}
+
+func getEscalationKey(proposalID types.ProposalKey) []byte {
+	return append(escalationSubStore, proposalID...)
+}
+
 func getNextProposalIDKey() []byte {
 	return nextProposalIDSubstore
 }
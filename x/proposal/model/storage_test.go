@@ -48,6 +48,7 @@ func TestProposalList(t *testing.T) {
 			ContentCreatorAllocation: sdk.NewDec(0),
 			DeveloperAllocation:      sdk.NewDec(0),
 			ValidatorAllocation:      sdk.NewDec(0),
+			CommunityPoolAllocation:  sdk.ZeroDec(),
 		},
 	}
 
@@ -96,6 +97,7 @@ func TestProposal(t *testing.T) {
 					ContentCreatorAllocation: sdk.NewDec(0),
 					DeveloperAllocation:      sdk.NewDec(0),
 					ValidatorAllocation:      sdk.NewDec(0),
+					CommunityPoolAllocation:  sdk.ZeroDec(),
 				},
 			},
 		},
@@ -171,3 +173,32 @@ func TestNextProposalID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, nextProposalID, id)
 }
+
+func TestProposalFunding(t *testing.T) {
+	ctx, ps := setup(t)
+	proposalID := types.ProposalKey("1")
+
+	_, err := ps.GetProposalFunding(ctx, proposalID)
+	assert.Equal(t, ErrProposalFundingNotFound(), err)
+
+	funding := &ProposalFunding{
+		ProposalType: types.TextProposal,
+		DecideSec:    100,
+		MinDeposit:   types.NewCoinFromInt64(100),
+		Deposited:    types.NewCoinFromInt64(30),
+		Deposits: []ProposalDeposit{
+			{Username: "user1", Amount: types.NewCoinFromInt64(10)},
+			{Username: "user2", Amount: types.NewCoinFromInt64(20)},
+		},
+	}
+	err = ps.SetProposalFunding(ctx, proposalID, funding)
+	assert.Nil(t, err)
+
+	res, err := ps.GetProposalFunding(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, funding, res)
+
+	ps.DeleteProposalFunding(ctx, proposalID)
+	_, err = ps.GetProposalFunding(ctx, proposalID)
+	assert.Equal(t, ErrProposalFundingNotFound(), err)
+}
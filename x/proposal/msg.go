@@ -12,6 +12,9 @@ import (
 
 var _ types.Msg = DeletePostContentMsg{}
 var _ types.Msg = UpgradeProtocolMsg{}
+var _ types.Msg = TextProposalMsg{}
+var _ types.Msg = CommunityPoolSpendMsg{}
+var _ types.Msg = DeveloperPunishMsg{}
 var _ types.Msg = ChangeGlobalAllocationParamMsg{}
 var _ types.Msg = ChangeInfraInternalAllocationParamMsg{}
 var _ types.Msg = ChangeVoteParamMsg{}
@@ -21,7 +24,14 @@ var _ types.Msg = ChangeValidatorParamMsg{}
 var _ types.Msg = ChangeBandwidthParamMsg{}
 var _ types.Msg = ChangeAccountParamMsg{}
 var _ types.Msg = ChangePostParamMsg{}
+var _ types.Msg = ChangeCoinDayParamMsg{}
+var _ types.Msg = ChangeReputationParamMsg{}
+var _ types.Msg = ChangeParamsMsg{}
 var _ types.Msg = VoteProposalMsg{}
+var _ types.Msg = SplitVoteProposalMsg{}
+var _ types.Msg = DepositProposalMsg{}
+var _ types.Msg = CancelProposalMsg{}
+var _ types.Msg = AppealCensorshipMsg{}
 
 var _ ChangeParamMsg = ChangeGlobalAllocationParamMsg{}
 var _ ChangeParamMsg = ChangeInfraInternalAllocationParamMsg{}
@@ -32,6 +42,8 @@ var _ ChangeParamMsg = ChangeValidatorParamMsg{}
 var _ ChangeParamMsg = ChangeBandwidthParamMsg{}
 var _ ChangeParamMsg = ChangeAccountParamMsg{}
 var _ ChangeParamMsg = ChangePostParamMsg{}
+var _ ChangeParamMsg = ChangeCoinDayParamMsg{}
+var _ ChangeParamMsg = ChangeReputationParamMsg{}
 
 var _ ContentCensorshipMsg = DeletePostContentMsg{}
 
@@ -42,6 +54,7 @@ type ChangeParamMsg interface {
 	GetParameter() param.Parameter
 	GetCreator() types.AccountKey
 	GetReason() string
+	GetDeposit() types.LNO
 }
 
 // ContentCensorshipMsg - content censorship msg
@@ -49,6 +62,7 @@ type ContentCensorshipMsg interface {
 	GetCreator() types.AccountKey
 	GetPermlink() types.Permlink
 	GetReason() string
+	GetDeposit() types.LNO
 }
 
 // ProtocolUpgradeMsg - protocol upgrade msg
@@ -56,6 +70,9 @@ type ProtocolUpgradeMsg interface {
 	GetCreator() types.AccountKey
 	GetLink() string
 	GetReason() string
+	GetName() string
+	GetHeight() int64
+	GetDeposit() types.LNO
 }
 
 // DeletePostContentMsg - implement of content censorship msg
@@ -63,6 +80,7 @@ type DeletePostContentMsg struct {
 	Creator  types.AccountKey `json:"creator"`
 	Permlink types.Permlink   `json:"permlink"`
 	Reason   string           `json:"reason"`
+	Deposit  types.LNO        `json:"deposit"`
 }
 
 // UpgradeProtocolMsg - implement of protocol upgrade msg
@@ -70,6 +88,36 @@ type UpgradeProtocolMsg struct {
 	Creator types.AccountKey `json:"creator"`
 	Link    string           `json:"link"`
 	Reason  string           `json:"reason"`
+	Name    string           `json:"name"`
+	Height  int64            `json:"height"`
+	Deposit types.LNO        `json:"deposit"`
+}
+
+// TextProposalMsg - create a non-binding text proposal
+type TextProposalMsg struct {
+	Creator     types.AccountKey `json:"creator"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Deposit     types.LNO        `json:"deposit"`
+}
+
+// CommunityPoolSpendMsg - propose to pay coin from community pool to recipient
+type CommunityPoolSpendMsg struct {
+	Creator   types.AccountKey `json:"creator"`
+	Recipient types.AccountKey `json:"recipient"`
+	Amount    types.LNO        `json:"amount"`
+	Reason    string           `json:"reason"`
+	Deposit   types.LNO        `json:"deposit"`
+}
+
+// DeveloperPunishMsg - propose to slash deposit of developer and optionally revoke the developer
+type DeveloperPunishMsg struct {
+	Creator    types.AccountKey `json:"creator"`
+	Developer  types.AccountKey `json:"developer"`
+	SlashRatio string           `json:"slash_ratio"`
+	Revoke     bool             `json:"revoke"`
+	Reason     string           `json:"reason"`
+	Deposit    types.LNO        `json:"deposit"`
 }
 
 // ChangeGlobalAllocationParamMsg - implement of change parameter msg
@@ -77,6 +125,7 @@ type ChangeGlobalAllocationParamMsg struct {
 	Creator   types.AccountKey            `json:"creator"`
 	Parameter param.GlobalAllocationParam `json:"parameter"`
 	Reason    string                      `json:"reason"`
+	Deposit   types.LNO                   `json:"deposit"`
 }
 
 // ChangeInfraInternalAllocationParamMsg - implement of change parameter msg
@@ -84,6 +133,7 @@ type ChangeInfraInternalAllocationParamMsg struct {
 	Creator   types.AccountKey                   `json:"creator"`
 	Parameter param.InfraInternalAllocationParam `json:"parameter"`
 	Reason    string                             `json:"reason"`
+	Deposit   types.LNO                          `json:"deposit"`
 }
 
 // ChangeVoteParamMsg - implement of change parameter msg
@@ -91,6 +141,7 @@ type ChangeVoteParamMsg struct {
 	Creator   types.AccountKey `json:"creator"`
 	Parameter param.VoteParam  `json:"parameter"`
 	Reason    string           `json:"reason"`
+	Deposit   types.LNO        `json:"deposit"`
 }
 
 // ChangeProposalParamMsg - implement of change parameter msg
@@ -98,6 +149,7 @@ type ChangeProposalParamMsg struct {
 	Creator   types.AccountKey    `json:"creator"`
 	Parameter param.ProposalParam `json:"parameter"`
 	Reason    string              `json:"reason"`
+	Deposit   types.LNO           `json:"deposit"`
 }
 
 // ChangeDeveloperParamMsg - implement of change parameter msg
@@ -105,6 +157,7 @@ type ChangeDeveloperParamMsg struct {
 	Creator   types.AccountKey     `json:"creator"`
 	Parameter param.DeveloperParam `json:"parameter"`
 	Reason    string               `json:"reason"`
+	Deposit   types.LNO            `json:"deposit"`
 }
 
 // ChangeValidatorParamMsg - implement of change parameter msg
@@ -112,6 +165,7 @@ type ChangeValidatorParamMsg struct {
 	Creator   types.AccountKey     `json:"creator"`
 	Parameter param.ValidatorParam `json:"parameter"`
 	Reason    string               `json:"reason"`
+	Deposit   types.LNO            `json:"deposit"`
 }
 
 // ChangeBandwidthParamMsg - implement of change parameter msg
@@ -119,6 +173,7 @@ type ChangeBandwidthParamMsg struct {
 	Creator   types.AccountKey     `json:"creator"`
 	Parameter param.BandwidthParam `json:"parameter"`
 	Reason    string               `json:"reason"`
+	Deposit   types.LNO            `json:"deposit"`
 }
 
 // ChangeAccountParamMsg - implement of change parameter msg
@@ -126,6 +181,7 @@ type ChangeAccountParamMsg struct {
 	Creator   types.AccountKey   `json:"creator"`
 	Parameter param.AccountParam `json:"parameter"`
 	Reason    string             `json:"reason"`
+	Deposit   types.LNO          `json:"deposit"`
 }
 
 // ChangePostParamMsg - implement of change parameter msg
@@ -133,6 +189,31 @@ type ChangePostParamMsg struct {
 	Creator   types.AccountKey `json:"creator"`
 	Parameter param.PostParam  `json:"parameter"`
 	Reason    string           `json:"reason"`
+	Deposit   types.LNO        `json:"deposit"`
+}
+
+// ChangeCoinDayParamMsg - implement of change parameter msg
+type ChangeCoinDayParamMsg struct {
+	Creator   types.AccountKey   `json:"creator"`
+	Parameter param.CoinDayParam `json:"parameter"`
+	Reason    string             `json:"reason"`
+	Deposit   types.LNO          `json:"deposit"`
+}
+
+// ChangeReputationParamMsg - implement of change parameter msg
+type ChangeReputationParamMsg struct {
+	Creator   types.AccountKey      `json:"creator"`
+	Parameter param.ReputationParam `json:"parameter"`
+	Reason    string                `json:"reason"`
+	Deposit   types.LNO             `json:"deposit"`
+}
+
+// ChangeParamsMsg - change several parameters in one proposal, each parameter type at most once
+type ChangeParamsMsg struct {
+	Creator    types.AccountKey  `json:"creator"`
+	Parameters []param.Parameter `json:"parameters"`
+	Reason     string            `json:"reason"`
+	Deposit    types.LNO         `json:"deposit"`
 }
 
 // VoteProposalMsg - implement of change parameter msg
@@ -140,6 +221,38 @@ type VoteProposalMsg struct {
 	Voter      types.AccountKey  `json:"voter"`
 	ProposalID types.ProposalKey `json:"proposal_id"`
 	Result     bool              `json:"result"`
+	Conviction int64             `json:"conviction"`
+}
+
+// SplitVoteProposalMsg - vote proposal with voting power split across yes, no and abstain
+type SplitVoteProposalMsg struct {
+	Voter         types.AccountKey  `json:"voter"`
+	ProposalID    types.ProposalKey `json:"proposal_id"`
+	YesWeight     int64             `json:"yes_weight"`
+	NoWeight      int64             `json:"no_weight"`
+	AbstainWeight int64             `json:"abstain_weight"`
+	Conviction    int64             `json:"conviction"`
+}
+
+// DepositProposalMsg - top up deposit of a proposal which is not fully funded yet
+type DepositProposalMsg struct {
+	Depositor  types.AccountKey  `json:"depositor"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Deposit    types.LNO         `json:"deposit"`
+}
+
+// CancelProposalMsg - creator cancels a proposal before voting starts
+type CancelProposalMsg struct {
+	Creator    types.AccountKey  `json:"creator"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
+// AppealCensorshipMsg - author appeals against passed content censorship of the post,
+// ProposalID is the id of content censorship proposal
+type AppealCensorshipMsg struct {
+	Author     types.AccountKey  `json:"author"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Reason     string            `json:"reason"`
 }
 
 //----------------------------------------
@@ -163,6 +276,9 @@ func (msg DeletePostContentMsg) GetCreator() types.AccountKey { return msg.Creat
 // GetReason - implement DeletePostContentMsg
 func (msg DeletePostContentMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement DeletePostContentMsg
+func (msg DeletePostContentMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg DeletePostContentMsg) Route() string { return RouterKey }
 
@@ -181,6 +297,9 @@ func (msg DeletePostContentMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -216,11 +335,13 @@ func (msg DeletePostContentMsg) GetConsumeAmount() types.Coin {
 // UpgradeProtocolMsg Msg Implementations
 
 func NewUpgradeProtocolMsg(
-	creator, link, reason string) UpgradeProtocolMsg {
+	creator, link, reason, name string, height int64) UpgradeProtocolMsg {
 	return UpgradeProtocolMsg{
 		Creator: types.AccountKey(creator),
 		Link:    link,
 		Reason:  reason,
+		Name:    name,
+		Height:  height,
 	}
 }
 
@@ -233,6 +354,15 @@ func (msg UpgradeProtocolMsg) GetLink() string { return msg.Link }
 // GetReason - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetReason() string { return msg.Reason }
 
+// GetName - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetName() string { return msg.Name }
+
+// GetHeight - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetHeight() int64 { return msg.Height }
+
+// GetDeposit - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg UpgradeProtocolMsg) Route() string { return RouterKey }
 
@@ -251,14 +381,24 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 	if len(msg.GetLink()) > types.MaximumLinkURL {
 		return ErrInvalidLink()
 	}
+	// upgrade without name is done manually, otherwise it's scheduled at height
+	if len(msg.Name) > types.MaximumLengthOfUpgradeName ||
+		(len(msg.Name) == 0 && msg.Height != 0) ||
+		(len(msg.Name) != 0 && msg.Height <= 0) {
+		return ErrInvalidUpgradePlan()
+	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (msg UpgradeProtocolMsg) String() string {
-	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v}", msg.Creator, msg.GetLink())
+	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v, Name:%v, Height:%v}",
+		msg.Creator, msg.GetLink(), msg.Name, msg.Height)
 }
 
 // GetPermission - implement types.Msg
@@ -285,6 +425,218 @@ func (msg UpgradeProtocolMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+//----------------------------------------
+// TextProposalMsg Msg Implementations
+
+func NewTextProposalMsg(creator, title, description string) TextProposalMsg {
+	return TextProposalMsg{
+		Creator:     types.AccountKey(creator),
+		Title:       title,
+		Description: description,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg TextProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg TextProposalMsg) Type() string { return "TextProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg TextProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Title) == 0 ||
+		utf8.RuneCountInString(msg.Title) > types.MaximumLengthOfTextProposalTitle {
+		return ErrInvalidProposalTitle()
+	}
+	if utf8.RuneCountInString(msg.Description) > types.MaximumLengthOfTextProposalDescription {
+		return ErrProposalDescriptionTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg TextProposalMsg) String() string {
+	return fmt.Sprintf("TextProposalMsg{Creator:%v, Title:%v}", msg.Creator, msg.Title)
+}
+
+// GetPermission - implement types.Msg
+func (msg TextProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg TextProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg TextProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg TextProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// CommunityPoolSpendMsg Msg Implementations
+
+func NewCommunityPoolSpendMsg(creator, recipient string, amount types.LNO, reason string) CommunityPoolSpendMsg {
+	return CommunityPoolSpendMsg{
+		Creator:   types.AccountKey(creator),
+		Recipient: types.AccountKey(recipient),
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg CommunityPoolSpendMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg CommunityPoolSpendMsg) Type() string { return "CommunityPoolSpendMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg CommunityPoolSpendMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Recipient) < types.MinimumUsernameLength ||
+		len(msg.Recipient) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg CommunityPoolSpendMsg) String() string {
+	return fmt.Sprintf("CommunityPoolSpendMsg{Creator:%v, Recipient:%v, Amount:%v}",
+		msg.Creator, msg.Recipient, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg CommunityPoolSpendMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg CommunityPoolSpendMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg CommunityPoolSpendMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg CommunityPoolSpendMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// DeveloperPunishMsg Msg Implementations
+
+func NewDeveloperPunishMsg(
+	creator, developer, slashRatio string, revoke bool, reason string) DeveloperPunishMsg {
+	return DeveloperPunishMsg{
+		Creator:    types.AccountKey(creator),
+		Developer:  types.AccountKey(developer),
+		SlashRatio: slashRatio,
+		Revoke:     revoke,
+		Reason:     reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg DeveloperPunishMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg DeveloperPunishMsg) Type() string { return "DeveloperPunishMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg DeveloperPunishMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	ratio, err := sdk.NewDecFromStr(msg.SlashRatio)
+	if err != nil {
+		return ErrIllegalSlashRatio()
+	}
+	if ratio.LT(sdk.ZeroDec()) || ratio.GT(sdk.OneDec()) {
+		return ErrIllegalSlashRatio()
+	}
+	// proposal must either slash or revoke the developer
+	if ratio.IsZero() && !msg.Revoke {
+		return ErrIllegalSlashRatio()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg DeveloperPunishMsg) String() string {
+	return fmt.Sprintf("DeveloperPunishMsg{Creator:%v, Developer:%v, SlashRatio:%v, Revoke:%v}",
+		msg.Creator, msg.Developer, msg.SlashRatio, msg.Revoke)
+}
+
+// GetPermission - implement types.Msg
+func (msg DeveloperPunishMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg DeveloperPunishMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg DeveloperPunishMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg DeveloperPunishMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 //----------------------------------------
 // ChangeGlobalAllocationParamMsg Msg Implementations
 
@@ -306,6 +658,9 @@ func (msg ChangeGlobalAllocationParamMsg) GetCreator() types.AccountKey { return
 // GetReason - implement ChangeParamMsg
 func (msg ChangeGlobalAllocationParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeGlobalAllocationParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeGlobalAllocationParamMsg) Route() string { return RouterKey }
 
@@ -319,25 +674,16 @@ func (msg ChangeGlobalAllocationParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if !msg.Parameter.InfraAllocation.
-		Add(msg.Parameter.ContentCreatorAllocation).
-		Add(msg.Parameter.DeveloperAllocation).
-		Add(msg.Parameter.ValidatorAllocation).Equal(sdk.NewDec(1)) {
-		return ErrIllegalParameter()
-	}
-	if msg.Parameter.InfraAllocation.LT(sdk.ZeroDec()) ||
-		msg.Parameter.ContentCreatorAllocation.LT(sdk.ZeroDec()) ||
-		msg.Parameter.DeveloperAllocation.LT(sdk.ZeroDec()) ||
-		msg.Parameter.ValidatorAllocation.LT(sdk.ZeroDec()) {
-		return ErrIllegalParameter()
-	}
-	if msg.Parameter.GlobalGrowthRate.GT(param.AnnualInflationCeiling) {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -390,6 +736,9 @@ func (msg ChangeInfraInternalAllocationParamMsg) GetCreator() types.AccountKey {
 // GetReason - implement ChangeParamMsg
 func (msg ChangeInfraInternalAllocationParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeInfraInternalAllocationParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeInfraInternalAllocationParamMsg) Route() string { return RouterKey }
 
@@ -405,16 +754,16 @@ func (msg ChangeInfraInternalAllocationParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if !msg.Parameter.CDNAllocation.
-		Add(msg.Parameter.StorageAllocation).Equal(sdk.NewDec(1)) ||
-		msg.Parameter.CDNAllocation.LT(sdk.ZeroDec()) ||
-		msg.Parameter.StorageAllocation.LT(sdk.ZeroDec()) {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -467,6 +816,9 @@ func (msg ChangeVoteParamMsg) GetCreator() types.AccountKey { return msg.Creator
 // GetReason - implement ChangeParamMsg
 func (msg ChangeVoteParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeVoteParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeVoteParamMsg) Route() string { return RouterKey }
 
@@ -480,20 +832,16 @@ func (msg ChangeVoteParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if msg.Parameter.DelegatorCoinReturnIntervalSec <= 0 ||
-		msg.Parameter.VoterCoinReturnIntervalSec <= 0 ||
-		msg.Parameter.DelegatorCoinReturnTimes <= 0 ||
-		msg.Parameter.VoterCoinReturnTimes <= 0 {
-		return ErrIllegalParameter()
-	}
-
-	if !msg.Parameter.MinStakeIn.IsPositive() {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -546,6 +894,9 @@ func (msg ChangeProposalParamMsg) GetCreator() types.AccountKey { return msg.Cre
 // GetReason - implement ChangeParamMsg
 func (msg ChangeProposalParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeProposalParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeProposalParamMsg) Route() string { return RouterKey }
 
@@ -559,34 +910,16 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if msg.Parameter.ContentCensorshipDecideSec <= 0 ||
-		msg.Parameter.ChangeParamExecutionSec <= 0 ||
-		msg.Parameter.ChangeParamDecideSec <= 0 ||
-		msg.Parameter.ProtocolUpgradeDecideSec <= 0 {
-		return ErrIllegalParameter()
-	}
-
-	if !msg.Parameter.ContentCensorshipMinDeposit.IsPositive() ||
-		!msg.Parameter.ContentCensorshipPassVotes.IsPositive() ||
-		!msg.Parameter.ChangeParamMinDeposit.IsPositive() ||
-		!msg.Parameter.ChangeParamPassVotes.IsPositive() ||
-		!msg.Parameter.ProtocolUpgradePassVotes.IsPositive() ||
-		!msg.Parameter.ProtocolUpgradeMinDeposit.IsPositive() {
-		return ErrIllegalParameter()
-	}
-
-	if !msg.Parameter.ContentCensorshipPassRatio.GT(sdk.ZeroDec()) ||
-		!msg.Parameter.ChangeParamPassRatio.GT(sdk.ZeroDec()) ||
-		!msg.Parameter.ProtocolUpgradePassRatio.GT(sdk.ZeroDec()) ||
-		msg.Parameter.ProtocolUpgradePassRatio.GT(sdk.NewDec(1)) ||
-		msg.Parameter.ChangeParamPassRatio.GT(sdk.NewDec(1)) ||
-		msg.Parameter.ContentCensorshipPassRatio.GT(sdk.NewDec(1)) {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -639,6 +972,9 @@ func (msg ChangeDeveloperParamMsg) GetCreator() types.AccountKey { return msg.Cr
 // GetReason - implement ChangeParamMsg
 func (msg ChangeDeveloperParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeDeveloperParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeDeveloperParamMsg) Route() string { return RouterKey }
 
@@ -652,18 +988,16 @@ func (msg ChangeDeveloperParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if msg.Parameter.DeveloperCoinReturnIntervalSec <= 0 ||
-		msg.Parameter.DeveloperCoinReturnTimes <= 0 {
-		return ErrIllegalParameter()
-	}
-
-	if !msg.Parameter.DeveloperMinDeposit.IsPositive() {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -715,6 +1049,9 @@ func (msg ChangeValidatorParamMsg) GetCreator() types.AccountKey { return msg.Cr
 // GetReason - implement ChangeParamMsg
 func (msg ChangeValidatorParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeValidatorParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeValidatorParamMsg) Route() string { return RouterKey }
 
@@ -728,25 +1065,16 @@ func (msg ChangeValidatorParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if msg.Parameter.ValidatorCoinReturnIntervalSec <= 0 ||
-		msg.Parameter.ValidatorCoinReturnTimes <= 0 ||
-		msg.Parameter.AbsentCommitLimitation <= 0 ||
-		msg.Parameter.ValidatorListSize <= 0 {
-		return ErrIllegalParameter()
-	}
-
-	if !msg.Parameter.ValidatorMinWithdraw.IsPositive() ||
-		!msg.Parameter.ValidatorMinVotingDeposit.IsPositive() ||
-		!msg.Parameter.ValidatorMinCommittingDeposit.IsPositive() ||
-		!msg.Parameter.PenaltyMissVote.IsPositive() ||
-		!msg.Parameter.PenaltyMissCommit.IsPositive() ||
-		!msg.Parameter.PenaltyByzantine.IsPositive() {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
 
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -799,6 +1127,9 @@ func (msg ChangeAccountParamMsg) GetCreator() types.AccountKey { return msg.Crea
 // GetReason - implement ChangeParamMsg
 func (msg ChangeAccountParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeAccountParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangeAccountParamMsg) Route() string { return RouterKey }
 
@@ -812,15 +1143,16 @@ func (msg ChangeAccountParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if !msg.Parameter.MinimumBalance.IsNotNegative() ||
-		!msg.Parameter.RegisterFee.IsNotNegative() ||
-		!msg.Parameter.FirstDepositFullCoinDayLimit.IsNotNegative() ||
-		msg.Parameter.MaxNumFrozenMoney <= 0 {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
+
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -873,6 +1205,9 @@ func (msg ChangePostParamMsg) GetCreator() types.AccountKey { return msg.Creator
 // GetReason - implement ChangeParamMsg
 func (msg ChangePostParamMsg) GetReason() string { return msg.Reason }
 
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangePostParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
 func (msg ChangePostParamMsg) Route() string { return RouterKey }
 
@@ -886,11 +1221,15 @@ func (msg ChangePostParamMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
+	if err := msg.Parameter.Validate(); err != nil {
+		return ErrIllegalParameter()
+	}
+
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
-	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 {
-		return ErrIllegalParameter()
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
 	}
 	return nil
 }
@@ -924,11 +1263,11 @@ func (msg ChangePostParamMsg) GetConsumeAmount() types.Coin {
 }
 
 //----------------------------------------
-// ChangeBandwidthParamMsg Msg Implementations
+// ChangeCoinDayParamMsg Msg Implementations
 
-func NewChangeBandwidthParamMsg(
-	creator string, parameter param.BandwidthParam, reason string) ChangeBandwidthParamMsg {
-	return ChangeBandwidthParamMsg{
+func NewChangeCoinDayParamMsg(
+	creator string, parameter param.CoinDayParam, reason string) ChangeCoinDayParamMsg {
+	return ChangeCoinDayParamMsg{
 		Creator:   types.AccountKey(creator),
 		Parameter: parameter,
 		Reason:    reason,
@@ -936,54 +1275,55 @@ func NewChangeBandwidthParamMsg(
 }
 
 // GetParameter - implement ChangeParamMsg
-func (msg ChangeBandwidthParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+func (msg ChangeCoinDayParamMsg) GetParameter() param.Parameter { return msg.Parameter }
 
 // GetCreator - implement ChangeParamMsg
-func (msg ChangeBandwidthParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+func (msg ChangeCoinDayParamMsg) GetCreator() types.AccountKey { return msg.Creator }
 
 // GetReason - implement ChangeParamMsg
-func (msg ChangeBandwidthParamMsg) GetReason() string { return msg.Reason }
+func (msg ChangeCoinDayParamMsg) GetReason() string { return msg.Reason }
+
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeCoinDayParamMsg) GetDeposit() types.LNO { return msg.Deposit }
 
 // Route - implement sdk.Msg
-func (msg ChangeBandwidthParamMsg) Route() string { return RouterKey }
+func (msg ChangeCoinDayParamMsg) Route() string { return RouterKey }
 
 // Type - implement sdk.Msg
-func (msg ChangeBandwidthParamMsg) Type() string { return "ChangeBandwidthParamMsg" }
+func (msg ChangeCoinDayParamMsg) Type() string { return "ChangeCoinDayParamMsg" }
 
 // ValidateBasic - implement sdk.Msg
-func (msg ChangeBandwidthParamMsg) ValidateBasic() sdk.Error {
+func (msg ChangeCoinDayParamMsg) ValidateBasic() sdk.Error {
 	if len(msg.Creator) < types.MinimumUsernameLength ||
 		len(msg.Creator) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
 
-	if !msg.Parameter.CapacityUsagePerTransaction.IsNotNegative() {
-		return ErrIllegalParameter()
-	}
-	if !msg.Parameter.VirtualCoin.IsNotNegative() {
-		return ErrIllegalParameter()
-	}
-	if msg.Parameter.SecondsToRecoverBandwidth <= 0 {
+	if err := msg.Parameter.Validate(); err != nil {
 		return ErrIllegalParameter()
 	}
+
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
 	return nil
 }
 
-func (msg ChangeBandwidthParamMsg) String() string {
-	return fmt.Sprintf("ChangeBandwidthParamMsg{Creator:%v}", msg.Creator)
+func (msg ChangeCoinDayParamMsg) String() string {
+	return fmt.Sprintf("ChangeCoinDayParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
 }
 
 // GetPermission - implement types.Msg
-func (msg ChangeBandwidthParamMsg) GetPermission() types.Permission {
+func (msg ChangeCoinDayParamMsg) GetPermission() types.Permission {
 	return types.TransactionPermission
 }
 
 // GetSignBytes - implement sdk.Msg
-func (msg ChangeBandwidthParamMsg) GetSignBytes() []byte {
-	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+func (msg ChangeCoinDayParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // CoinDayCoinDayCoinDay: ensure some canonical form
 	if err != nil {
 		panic(err)
 	}
@@ -991,42 +1331,283 @@ func (msg ChangeBandwidthParamMsg) GetSignBytes() []byte {
 }
 
 // GetSigners - implement sdk.Msg
-func (msg ChangeBandwidthParamMsg) GetSigners() []sdk.AccAddress {
+func (msg ChangeCoinDayParamMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
 }
 
 // GetConsumeAmount - implement types.Msg
-func (msg ChangeBandwidthParamMsg) GetConsumeAmount() types.Coin {
+func (msg ChangeCoinDayParamMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
 //----------------------------------------
-// VoteProposalMsg Msg Implementations
-func NewVoteProposalMsg(voter string, proposalID int64, result bool) VoteProposalMsg {
-	return VoteProposalMsg{
-		Voter:      types.AccountKey(voter),
-		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
-		Result:     result,
+// ChangeReputationParamMsg Msg Implementations
+
+func NewChangeReputationParamMsg(
+	creator string, parameter param.ReputationParam, reason string) ChangeReputationParamMsg {
+	return ChangeReputationParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
 	}
 }
 
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetReason() string { return msg.Reason }
+
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
 // Route - implement sdk.Msg
-func (msg VoteProposalMsg) Route() string { return RouterKey }
+func (msg ChangeReputationParamMsg) Route() string { return RouterKey }
 
 // Type - implement sdk.Msg
-func (msg VoteProposalMsg) Type() string { return "VoteProposalMsg" }
+func (msg ChangeReputationParamMsg) Type() string { return "ChangeReputationParamMsg" }
 
 // ValidateBasic - implement sdk.Msg
-func (msg VoteProposalMsg) ValidateBasic() sdk.Error {
-	if len(msg.Voter) < types.MinimumUsernameLength ||
+func (msg ChangeReputationParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if err := msg.Parameter.Validate(); err != nil {
+		return ErrIllegalParameter()
+	}
+
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ChangeReputationParamMsg) String() string {
+	return fmt.Sprintf("ChangeReputationParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeReputationParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeReputationParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // ReputationReputationReputation: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeReputationParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeReputationParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ChangeParamsMsg Msg Implementations
+
+func NewChangeParamsMsg(
+	creator string, parameters []param.Parameter, reason string) ChangeParamsMsg {
+	return ChangeParamsMsg{
+		Creator:    types.AccountKey(creator),
+		Parameters: parameters,
+		Reason:     reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ChangeParamsMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ChangeParamsMsg) Type() string { return "ChangeParamsMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeParamsMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if len(msg.Parameters) == 0 {
+		return ErrInvalidParamBundle()
+	}
+	paramTypes := map[string]bool{}
+	for _, parameter := range msg.Parameters {
+		if parameter == nil {
+			return ErrInvalidParamBundle()
+		}
+		paramType := fmt.Sprintf("%T", parameter)
+		if paramTypes[paramType] {
+			return ErrInvalidParamBundle()
+		}
+		paramTypes[paramType] = true
+		if err := parameter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ChangeParamsMsg) String() string {
+	return fmt.Sprintf("ChangeParamsMsg{Creator:%v, params:%v}", msg.Creator, msg.Parameters)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeParamsMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeParamsMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeParamsMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeParamsMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ChangeBandwidthParamMsg Msg Implementations
+
+func NewChangeBandwidthParamMsg(
+	creator string, parameter param.BandwidthParam, reason string) ChangeBandwidthParamMsg {
+	return ChangeBandwidthParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
+	}
+}
+
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeBandwidthParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeBandwidthParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeBandwidthParamMsg) GetReason() string { return msg.Reason }
+
+// GetDeposit - implement ChangeParamMsg
+func (msg ChangeBandwidthParamMsg) GetDeposit() types.LNO { return msg.Deposit }
+
+// Route - implement sdk.Msg
+func (msg ChangeBandwidthParamMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ChangeBandwidthParamMsg) Type() string { return "ChangeBandwidthParamMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeBandwidthParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if err := msg.Parameter.Validate(); err != nil {
+		return ErrIllegalParameter()
+	}
+
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	if err := validateProposalDeposit(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ChangeBandwidthParamMsg) String() string {
+	return fmt.Sprintf("ChangeBandwidthParamMsg{Creator:%v}", msg.Creator)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeBandwidthParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeBandwidthParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeBandwidthParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeBandwidthParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// VoteProposalMsg Msg Implementations
+func NewVoteProposalMsg(voter string, proposalID int64, result bool, conviction int64) VoteProposalMsg {
+	return VoteProposalMsg{
+		Voter:      types.AccountKey(voter),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Result:     result,
+		Conviction: conviction,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg VoteProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg VoteProposalMsg) Type() string { return "VoteProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg VoteProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Voter) < types.MinimumUsernameLength ||
 		len(msg.Voter) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
+	if msg.Conviction < 0 {
+		return ErrIllegalConviction()
+	}
 	return nil
 }
 
 func (msg VoteProposalMsg) String() string {
-	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Result:%v}", msg.Voter, msg.ProposalID, msg.Result)
+	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Result:%v, Conviction:%v}",
+		msg.Voter, msg.ProposalID, msg.Result, msg.Conviction)
 }
 
 // GetPermission - implement types.Msg
@@ -1052,3 +1633,248 @@ func (msg VoteProposalMsg) GetSigners() []sdk.AccAddress {
 func (msg VoteProposalMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+//----------------------------------------
+// SplitVoteProposalMsg Msg Implementations
+
+func NewSplitVoteProposalMsg(
+	voter string, proposalID int64, yesWeight, noWeight, abstainWeight, conviction int64) SplitVoteProposalMsg {
+	return SplitVoteProposalMsg{
+		Voter:         types.AccountKey(voter),
+		ProposalID:    types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		YesWeight:     yesWeight,
+		NoWeight:      noWeight,
+		AbstainWeight: abstainWeight,
+		Conviction:    conviction,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg SplitVoteProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg SplitVoteProposalMsg) Type() string { return "SplitVoteProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg SplitVoteProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Voter) < types.MinimumUsernameLength ||
+		len(msg.Voter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.YesWeight < 0 || msg.NoWeight < 0 || msg.AbstainWeight < 0 ||
+		msg.YesWeight+msg.NoWeight+msg.AbstainWeight != types.VoteWeightBase {
+		return ErrIllegalVoteWeight()
+	}
+	if msg.Conviction < 0 {
+		return ErrIllegalConviction()
+	}
+	return nil
+}
+
+func (msg SplitVoteProposalMsg) String() string {
+	return fmt.Sprintf("SplitVoteProposalMsg{Voter:%v, ProposalID:%v, YesWeight:%v, NoWeight:%v, AbstainWeight:%v, Conviction:%v}",
+		msg.Voter, msg.ProposalID, msg.YesWeight, msg.NoWeight, msg.AbstainWeight, msg.Conviction)
+}
+
+// GetPermission - implement types.Msg
+func (msg SplitVoteProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg SplitVoteProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg SplitVoteProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Voter)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg SplitVoteProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// DepositProposalMsg Msg Implementations
+
+func NewDepositProposalMsg(depositor string, proposalID int64, deposit types.LNO) DepositProposalMsg {
+	return DepositProposalMsg{
+		Depositor:  types.AccountKey(depositor),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Deposit:    deposit,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg DepositProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg DepositProposalMsg) Type() string { return "DepositProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg DepositProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Depositor) < types.MinimumUsernameLength ||
+		len(msg.Depositor) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg DepositProposalMsg) String() string {
+	return fmt.Sprintf("DepositProposalMsg{Depositor:%v, ProposalID:%v, Deposit:%v}", msg.Depositor, msg.ProposalID, msg.Deposit)
+}
+
+// GetPermission - implement types.Msg
+func (msg DepositProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg DepositProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg DepositProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Depositor)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg DepositProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// CancelProposalMsg Msg Implementations
+
+func NewCancelProposalMsg(creator string, proposalID int64) CancelProposalMsg {
+	return CancelProposalMsg{
+		Creator:    types.AccountKey(creator),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg CancelProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg CancelProposalMsg) Type() string { return "CancelProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg CancelProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg CancelProposalMsg) String() string {
+	return fmt.Sprintf("CancelProposalMsg{Creator:%v, ProposalID:%v}", msg.Creator, msg.ProposalID)
+}
+
+// GetPermission - implement types.Msg
+func (msg CancelProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg CancelProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg CancelProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg CancelProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// AppealCensorshipMsg Msg Implementations
+
+func NewAppealCensorshipMsg(author string, proposalID int64, reason string) AppealCensorshipMsg {
+	return AppealCensorshipMsg{
+		Author:     types.AccountKey(author),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Reason:     reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg AppealCensorshipMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg AppealCensorshipMsg) Type() string { return "AppealCensorshipMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg AppealCensorshipMsg) ValidateBasic() sdk.Error {
+	if len(msg.Author) < types.MinimumUsernameLength ||
+		len(msg.Author) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg AppealCensorshipMsg) String() string {
+	return fmt.Sprintf("AppealCensorshipMsg{Author:%v, ProposalID:%v}", msg.Author, msg.ProposalID)
+}
+
+// GetPermission - implement types.Msg
+func (msg AppealCensorshipMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg AppealCensorshipMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg AppealCensorshipMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg AppealCensorshipMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// validateProposalDeposit - initial deposit is optional, creator pays
+// full minimum deposit if it's not specified
+func validateProposalDeposit(deposit types.LNO) sdk.Error {
+	if deposit == "" {
+		return nil
+	}
+	if _, err := types.LinoToCoin(deposit); err != nil {
+		return err
+	}
+	return nil
+}
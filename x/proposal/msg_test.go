@@ -48,14 +48,24 @@ func TestVoteProposalMsg(t *testing.T) {
 	}{
 		{
 			testName:        "normal case",
-			voteProposalMsg: NewVoteProposalMsg("user1", 1, true),
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, true, 0),
 			expectedError:   nil,
 		},
 		{
 			testName:        "empty username is illegal",
-			voteProposalMsg: NewVoteProposalMsg("", 1, true),
+			voteProposalMsg: NewVoteProposalMsg("", 1, true, 0),
 			expectedError:   ErrInvalidUsername(),
 		},
+		{
+			testName:        "vote with conviction",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, true, 6),
+			expectedError:   nil,
+		},
+		{
+			testName:        "negative conviction is illegal",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, true, -1),
+			expectedError:   ErrIllegalConviction(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -66,6 +76,52 @@ func TestVoteProposalMsg(t *testing.T) {
 	}
 }
 
+func TestSplitVoteProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName             string
+		splitVoteProposalMsg SplitVoteProposalMsg
+		expectedError        sdk.Error
+	}{
+		{
+			testName:             "normal case",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("user1", 1, 5000, 3000, 2000, 0),
+			expectedError:        nil,
+		},
+		{
+			testName:             "full abstain",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("user1", 1, 0, 0, 10000, 0),
+			expectedError:        nil,
+		},
+		{
+			testName:             "empty username is illegal",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("", 1, 5000, 3000, 2000, 0),
+			expectedError:        ErrInvalidUsername(),
+		},
+		{
+			testName:             "negative weight is illegal",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("user1", 1, 11000, -1000, 0, 0),
+			expectedError:        ErrIllegalVoteWeight(),
+		},
+		{
+			testName:             "weights not sum up to base is illegal",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("user1", 1, 5000, 3000, 1000, 0),
+			expectedError:        ErrIllegalVoteWeight(),
+		},
+		{
+			testName:             "negative conviction is illegal",
+			splitVoteProposalMsg: NewSplitVoteProposalMsg("user1", 1, 5000, 3000, 2000, -1),
+			expectedError:        ErrIllegalConviction(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.splitVoteProposalMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 	p1 := param.GlobalAllocationParam{
 		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
@@ -73,6 +129,7 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(55, 100),
 		DeveloperAllocation:      types.NewDecFromRat(20, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		CommunityPoolAllocation:  sdk.ZeroDec(),
 	}
 	p2 := p1
 	p2.DeveloperAllocation = types.NewDecFromRat(25, 100)
@@ -194,6 +251,11 @@ func TestChangeVoteParamMsg(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxCommissionRate:              types.NewDecFromRat(20, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(1, 100),
+		ConvictionLockPeriodSec:        int64(7 * 24 * 3600),
+		MaxConviction:                  int64(6),
 	}
 
 	p2 := p1
@@ -211,6 +273,24 @@ func TestChangeVoteParamMsg(t *testing.T) {
 	p6 := p1
 	p6.DelegatorCoinReturnTimes = int64(0)
 
+	p7 := p1
+	p7.RedelegationIntervalSec = int64(-1)
+
+	p8 := p1
+	p8.RedelegationIntervalSec = int64(0)
+
+	p9 := p1
+	p9.MaxCommissionRate = types.NewDecFromRat(101, 100)
+
+	p10 := p1
+	p10.MaxCommissionChangeRate = types.NewDecFromRat(-1, 100)
+
+	p11 := p1
+	p11.ConvictionLockPeriodSec = int64(0)
+
+	p12 := p1
+	p12.MaxConviction = types.MaxVoteConviction + 1
+
 	testCases := []struct {
 		testName           string
 		ChangeVoteParamMsg ChangeVoteParamMsg
@@ -246,6 +326,36 @@ func TestChangeVoteParamMsg(t *testing.T) {
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p5, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "negative RedelegationIntervalSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero RedelegationIntervalSec is legal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p8, ""),
+			expectedError:      nil,
+		},
+		{
+			testName:           "MaxCommissionRate larger than one is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p9, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative MaxCommissionChangeRate is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p10, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero ConvictionLockPeriodSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p11, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "MaxConviction larger than upper bound is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p12, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "empty username is illegal",
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("", p1, ""),
@@ -278,6 +388,8 @@ func TestChangeDeveloperParamMsg(t *testing.T) {
 		DeveloperCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DeveloperCoinReturnTimes:       int64(7),
 		DeveloperMinDeposit:            types.NewCoinFromInt64(1 * types.Decimals),
+		DeveloperMaxDailySponsorship:   int64(100),
+		DeveloperPunishCooldownSec:     int64(30 * 24 * 3600),
 	}
 
 	p2 := p1
@@ -289,6 +401,12 @@ func TestChangeDeveloperParamMsg(t *testing.T) {
 	p4 := p1
 	p4.DeveloperMinDeposit = types.NewCoinFromInt64(-1 * types.Decimals)
 
+	p5 := p1
+	p5.DeveloperMaxDailySponsorship = int64(-1)
+
+	p6 := p1
+	p6.DeveloperPunishCooldownSec = int64(-1)
+
 	testCases := []struct {
 		testName                string
 		ChangeDeveloperParamMsg ChangeDeveloperParamMsg
@@ -314,6 +432,16 @@ func TestChangeDeveloperParamMsg(t *testing.T) {
 			ChangeDeveloperParamMsg: NewChangeDeveloperParamMsg("user1", p4, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
+		{
+			testName:                "negative DeveloperMaxDailySponsorship is illegal",
+			ChangeDeveloperParamMsg: NewChangeDeveloperParamMsg("user1", p5, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative DeveloperPunishCooldownSec is illegal",
+			ChangeDeveloperParamMsg: NewChangeDeveloperParamMsg("user1", p6, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
 		{
 			testName:                "empty username is illegal",
 			ChangeDeveloperParamMsg: NewChangeDeveloperParamMsg("", p1, ""),
@@ -489,6 +617,33 @@ func TestChangeProposalParamMsg(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		ContentCensorshipEscalationRatio:     sdk.ZeroDec(),
+		ContentCensorshipEscalationMinReport: types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipEscalationPoolRate:  sdk.ZeroDec(),
+
+		TextProposalDecideSec:  int64(24 * 7 * 3600),
+		TextProposalPassRatio:  types.NewDecFromRat(50, 100),
+		TextProposalPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TextProposalMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		CensorshipAppealWindowSec:  int64(24 * 7 * 3600),
+		CensorshipAppealDecideSec:  int64(24 * 7 * 3600),
+		CensorshipAppealMinDeposit: types.NewCoinFromInt64(1000 * types.Decimals),
+		CensorshipAppealPassRatio:  types.NewDecFromRat(70, 100),
+		CensorshipAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+
+		ProposalDepositPeriodSec: int64(24 * 7 * 3600),
+
+		DeveloperPunishDecideSec:  int64(7 * 24 * 3600),
+		DeveloperPunishMinDeposit: types.NewCoinFromInt64(10000 * types.Decimals),
+		DeveloperPunishPassRatio:  types.NewDecFromRat(60, 100),
+		DeveloperPunishPassVotes:  types.NewCoinFromInt64(500000 * types.Decimals),
+
+		CommunitySpendDecideSec:  int64(7 * 24 * 3600),
+		CommunitySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+		CommunitySpendPassRatio:  types.NewDecFromRat(70, 100),
+		CommunitySpendPassVotes:  types.NewCoinFromInt64(5000000 * types.Decimals),
 	}
 
 	p2 := p1
@@ -527,6 +682,45 @@ func TestChangeProposalParamMsg(t *testing.T) {
 	p13 := p1
 	p13.ProtocolUpgradeMinDeposit = types.NewCoinFromInt64(-1000000 * types.Decimals)
 
+	p14 := p1
+	p14.ContentCensorshipEscalationRatio = types.NewDecFromRat(-1, 100)
+
+	p15 := p1
+	p15.ContentCensorshipEscalationMinReport = types.NewCoinFromInt64(-1)
+
+	p16 := p1
+	p16.ContentCensorshipEscalationPoolRate = types.NewDecFromRat(101, 100)
+
+	p17 := p1
+	p17.TextProposalDecideSec = int64(0)
+
+	p18 := p1
+	p18.TextProposalPassRatio = types.NewDecFromRat(101, 100)
+
+	p19 := p1
+	p19.TextProposalMinDeposit = types.NewCoinFromInt64(0)
+
+	p20 := p1
+	p20.CensorshipAppealWindowSec = int64(0)
+
+	p21 := p1
+	p21.CensorshipAppealPassRatio = types.NewDecFromRat(0, 100)
+
+	p22 := p1
+	p22.DeveloperPunishDecideSec = int64(0)
+
+	p23 := p1
+	p23.DeveloperPunishPassRatio = types.NewDecFromRat(101, 100)
+
+	p24 := p1
+	p24.DeveloperPunishMinDeposit = types.NewCoinFromInt64(0)
+
+	p25 := p1
+	p25.CommunitySpendDecideSec = int64(0)
+
+	p26 := p1
+	p26.CommunitySpendPassVotes = types.NewCoinFromInt64(0)
+
 	testCases := []struct {
 		testName               string
 		ChangeProposalParamMsg ChangeProposalParamMsg
@@ -602,6 +796,71 @@ func TestChangeProposalParamMsg(t *testing.T) {
 			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p13, ""),
 			expectedError:          ErrIllegalParameter(),
 		},
+		{
+			testName:               "negative ContentCensorshipEscalationRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p14, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "negative ContentCensorshipEscalationMinReport is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p15, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "ContentCensorshipEscalationPoolRate that is larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p16, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero TextProposalDecideSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p17, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "TextProposalPassRatio larger than 1 is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p18, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero TextProposalMinDeposit is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p19, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero CensorshipAppealWindowSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p20, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero CensorshipAppealPassRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p21, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero DeveloperPunishDecideSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p22, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "DeveloperPunishPassRatio larger than 1 is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p23, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero DeveloperPunishMinDeposit is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p24, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero CommunitySpendDecideSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p25, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero CommunitySpendPassVotes is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p26, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
 		{
 			testName: "reason is too long",
 			ChangeProposalParamMsg: NewChangeProposalParamMsg(
@@ -791,6 +1050,7 @@ func TestChangePostParamMsg(t *testing.T) {
 	p1 := param.PostParam{
 		ReportOrUpvoteIntervalSec: 1,
 		PostIntervalSec:           1,
+		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
 	}
 
 	p2 := p1
@@ -839,6 +1099,154 @@ func TestChangePostParamMsg(t *testing.T) {
 	}
 }
 
+func TestChangeCoinDayParamMsg(t *testing.T) {
+	p1 := param.CoinDayParam{
+		SecondsToRecoverCoinDay: 7 * 24 * 3600,
+	}
+
+	p2 := p1
+	p2.SecondsToRecoverCoinDay = 0
+
+	testCases := []struct {
+		testName              string
+		changeCoinDayParamMsg ChangeCoinDayParamMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("user1", p1, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "illegal seconds to recover coin day",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("user1", p2, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "username too short",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("us", p1, ""),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "username too long",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("user1user1user1user1user1", p1, ""),
+			expectedError:         ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeCoinDayParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestChangeReputationParamMsg(t *testing.T) {
+	p1 := param.ReputationParam{
+		BestContentIndexN: 200,
+	}
+
+	p2 := p1
+	p2.BestContentIndexN = -1
+
+	testCases := []struct {
+		testName                 string
+		changeReputationParamMsg ChangeReputationParamMsg
+		expectedError            sdk.Error
+	}{
+		{
+			testName:                 "normal case",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1", p1, ""),
+			expectedError:            nil,
+		},
+		{
+			testName:                 "illegal best content index n",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1", p2, ""),
+			expectedError:            ErrIllegalParameter(),
+		},
+		{
+			testName:                 "username too short",
+			changeReputationParamMsg: NewChangeReputationParamMsg("us", p1, ""),
+			expectedError:            ErrInvalidUsername(),
+		},
+		{
+			testName:                 "username too long",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1user1user1user1user1", p1, ""),
+			expectedError:            ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeReputationParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestChangeParamsMsg(t *testing.T) {
+	coinDayParam := param.CoinDayParam{
+		SecondsToRecoverCoinDay: 7 * 24 * 3600,
+	}
+	reputationParam := param.ReputationParam{
+		BestContentIndexN: 200,
+	}
+	infraAllocation := param.InfraInternalAllocationParam{
+		StorageAllocation: types.NewDecFromRat(50, 100),
+		CDNAllocation:     types.NewDecFromRat(60, 100),
+	}
+
+	testCases := []struct {
+		testName        string
+		changeParamsMsg ChangeParamsMsg
+		expectedError   sdk.Error
+	}{
+		{
+			testName: "normal case",
+			changeParamsMsg: NewChangeParamsMsg(
+				"user1", []param.Parameter{coinDayParam, reputationParam}, ""),
+			expectedError: nil,
+		},
+		{
+			testName:        "empty bundle",
+			changeParamsMsg: NewChangeParamsMsg("user1", []param.Parameter{}, ""),
+			expectedError:   ErrInvalidParamBundle(),
+		},
+		{
+			testName: "same parameter twice",
+			changeParamsMsg: NewChangeParamsMsg(
+				"user1", []param.Parameter{coinDayParam, reputationParam, coinDayParam}, ""),
+			expectedError: ErrInvalidParamBundle(),
+		},
+		{
+			testName: "nil parameter",
+			changeParamsMsg: NewChangeParamsMsg(
+				"user1", []param.Parameter{coinDayParam, nil}, ""),
+			expectedError: ErrInvalidParamBundle(),
+		},
+		{
+			testName: "allocation doesn't sum to one",
+			changeParamsMsg: NewChangeParamsMsg(
+				"user1", []param.Parameter{coinDayParam, infraAllocation}, ""),
+			expectedError: param.ErrInvalidParamValue("infra internal allocation"),
+		},
+		{
+			testName: "username too short",
+			changeParamsMsg: NewChangeParamsMsg(
+				"us", []param.Parameter{coinDayParam, reputationParam}, ""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeParamsMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestDeletePostContentMsg(t *testing.T) {
 	testCases := []struct {
 		testName             string
@@ -895,34 +1303,55 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}{
 		{
 			testName:           "normal case",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "", "", 0),
 			expectedError:      nil,
 		},
 		{
 			testName:           "too short username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", "", "", 0),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "too long username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", "", "", 0),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "empty link is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", "", "", 0),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", string(make([]byte, types.MaximumLengthOfProposalReason+1)), "", 0),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "utf8 reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", tooLongOfUTF8Reason),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", tooLongOfUTF8Reason, "", 0),
 			expectedError:      ErrInvalidLink(),
 		},
+		{
+			testName:           "upgrade scheduled at height",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "", "upgrade2", 1000),
+			expectedError:      nil,
+		},
+		{
+			testName:           "upgrade height without name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "", "", 1000),
+			expectedError:      ErrInvalidUpgradePlan(),
+		},
+		{
+			testName:           "upgrade name without height is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "", "upgrade2", 0),
+			expectedError:      ErrInvalidUpgradePlan(),
+		},
+		{
+			testName: "upgrade name is too long",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg(
+				"user1", "link", "", string(make([]byte, types.MaximumLengthOfUpgradeName+1)), 1000),
+			expectedError: ErrInvalidUpgradePlan(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -933,6 +1362,272 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}
 }
 
+func TestTextProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName        string
+		textProposalMsg TextProposalMsg
+		expectedError   sdk.Error
+	}{
+		{
+			testName:        "normal case",
+			textProposalMsg: NewTextProposalMsg("user1", "title", "description"),
+			expectedError:   nil,
+		},
+		{
+			testName:        "invalid username",
+			textProposalMsg: NewTextProposalMsg("", "title", "description"),
+			expectedError:   ErrInvalidUsername(),
+		},
+		{
+			testName:        "empty title is illegal",
+			textProposalMsg: NewTextProposalMsg("user1", "", "description"),
+			expectedError:   ErrInvalidProposalTitle(),
+		},
+		{
+			testName: "title is too long",
+			textProposalMsg: NewTextProposalMsg(
+				"user1", string(make([]byte, types.MaximumLengthOfTextProposalTitle+1)), "description"),
+			expectedError: ErrInvalidProposalTitle(),
+		},
+		{
+			testName: "description is too long",
+			textProposalMsg: NewTextProposalMsg(
+				"user1", "title", string(make([]byte, types.MaximumLengthOfTextProposalDescription+1))),
+			expectedError: ErrProposalDescriptionTooLong(),
+		},
+		{
+			testName: "initial deposit lower than minimum is allowed",
+			textProposalMsg: TextProposalMsg{
+				Creator:     "user1",
+				Title:       "title",
+				Description: "description",
+				Deposit:     "1",
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "illegal initial deposit",
+			textProposalMsg: TextProposalMsg{
+				Creator:     "user1",
+				Title:       "title",
+				Description: "description",
+				Deposit:     "-1",
+			},
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.textProposalMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestCommunityPoolSpendMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		communityPoolSpendMsg CommunityPoolSpendMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			communityPoolSpendMsg: NewCommunityPoolSpendMsg("user1", "user2", "100", "reason"),
+			expectedError:         nil,
+		},
+		{
+			testName:              "invalid creator",
+			communityPoolSpendMsg: NewCommunityPoolSpendMsg("", "user2", "100", "reason"),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "invalid recipient",
+			communityPoolSpendMsg: NewCommunityPoolSpendMsg("user1", "", "100", "reason"),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "zero amount is illegal",
+			communityPoolSpendMsg: NewCommunityPoolSpendMsg("user1", "user2", "0", "reason"),
+			expectedError:         types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "reason is too long",
+			communityPoolSpendMsg: NewCommunityPoolSpendMsg(
+				"user1", "user2", "100", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.communityPoolSpendMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestDeveloperPunishMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		developerPunishMsg DeveloperPunishMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName:           "normal case",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "0.5", false, "reason"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "revoke without slash",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "0", true, "reason"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "slash whole deposit",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "1", true, "reason"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "invalid creator",
+			developerPunishMsg: NewDeveloperPunishMsg("", "dev1", "0.5", false, "reason"),
+			expectedError:      ErrInvalidUsername(),
+		},
+		{
+			testName:           "invalid developer",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "", "0.5", false, "reason"),
+			expectedError:      ErrInvalidUsername(),
+		},
+		{
+			testName:           "slash ratio can't be parsed",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "half", false, "reason"),
+			expectedError:      ErrIllegalSlashRatio(),
+		},
+		{
+			testName:           "negative slash ratio",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "-0.1", true, "reason"),
+			expectedError:      ErrIllegalSlashRatio(),
+		},
+		{
+			testName:           "slash ratio larger than 1",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "1.1", true, "reason"),
+			expectedError:      ErrIllegalSlashRatio(),
+		},
+		{
+			testName:           "neither slash nor revoke",
+			developerPunishMsg: NewDeveloperPunishMsg("user1", "dev1", "0", false, "reason"),
+			expectedError:      ErrIllegalSlashRatio(),
+		},
+		{
+			testName: "reason is too long",
+			developerPunishMsg: NewDeveloperPunishMsg(
+				"user1", "dev1", "0.5", false, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.developerPunishMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestDepositProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		depositProposalMsg DepositProposalMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName:           "normal case",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "1"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "invalid username",
+			depositProposalMsg: NewDepositProposalMsg("", 1, "1"),
+			expectedError:      ErrInvalidUsername(),
+		},
+		{
+			testName:           "zero deposit is illegal",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "0"),
+			expectedError:      types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:           "illegal deposit",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "lino"),
+			expectedError:      types.ErrInvalidCoins("Illegal LNO"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.depositProposalMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestCancelProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		cancelProposalMsg CancelProposalMsg
+		expectedError     sdk.Error
+	}{
+		{
+			testName:          "normal case",
+			cancelProposalMsg: NewCancelProposalMsg("user1", 1),
+			expectedError:     nil,
+		},
+		{
+			testName:          "invalid username",
+			cancelProposalMsg: NewCancelProposalMsg("", 1),
+			expectedError:     ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.cancelProposalMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestAppealCensorshipMsg(t *testing.T) {
+	testCases := []struct {
+		testName            string
+		appealCensorshipMsg AppealCensorshipMsg
+		expectedError       sdk.Error
+	}{
+		{
+			testName:            "normal case",
+			appealCensorshipMsg: NewAppealCensorshipMsg("user1", 1, "reason"),
+			expectedError:       nil,
+		},
+		{
+			testName:            "invalid username",
+			appealCensorshipMsg: NewAppealCensorshipMsg("", 1, "reason"),
+			expectedError:       ErrInvalidUsername(),
+		},
+		{
+			testName: "reason is too long",
+			appealCensorshipMsg: NewAppealCensorshipMsg(
+				"user1", 1, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.appealCensorshipMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -947,7 +1642,7 @@ func TestMsgPermission(t *testing.T) {
 		},
 		{
 			testName:         "upgrade protocol msg",
-			msg:              NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:              NewUpgradeProtocolMsg("creator", "link", "", "", 0),
 			expectPermission: types.TransactionPermission,
 		},
 		{
@@ -1004,9 +1699,62 @@ func TestMsgPermission(t *testing.T) {
 				"creator", param.PostParam{}, ""),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName: "change params msg",
+			msg: NewChangeParamsMsg(
+				"creator", []param.Parameter{param.CoinDayParam{}, param.ReputationParam{}}, ""),
+			expectPermission: types.TransactionPermission,
+		},
 		{
 			testName:         "vote proposal msg",
-			msg:              NewVoteProposalMsg("voter", 1, true),
+			msg:              NewVoteProposalMsg("voter", 1, true, 0),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "community pool spend msg",
+			msg:              NewCommunityPoolSpendMsg("creator", "recipient", "1", "reason"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "developer punish msg",
+			msg:              NewDeveloperPunishMsg("creator", "developer", "0.5", true, "reason"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "text proposal msg",
+			msg:              NewTextProposalMsg("creator", "title", "description"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "split vote proposal msg",
+			msg:              NewSplitVoteProposalMsg("voter", 1, 5000, 3000, 2000, 0),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "deposit proposal msg",
+			msg:              NewDepositProposalMsg("depositor", 1, "1"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "cancel proposal msg",
+			msg:              NewCancelProposalMsg("creator", 1),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "appeal censorship msg",
+			msg:              NewAppealCensorshipMsg("author", 1, "reason"),
 			expectPermission: types.TransactionPermission,
 		},
 	}
@@ -1032,7 +1780,7 @@ func TestGetSignBytes(t *testing.T) {
 		},
 		{
 			testName: "upgrade protocol msg",
-			msg:      NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:      NewUpgradeProtocolMsg("creator", "link", "", "", 0),
 		},
 		{
 			testName: "change global allocaiton param msg",
@@ -1079,9 +1827,52 @@ func TestGetSignBytes(t *testing.T) {
 			msg: NewChangePostParamMsg(
 				"creator", param.PostParam{}, ""),
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+		},
+		{
+			testName: "change params msg",
+			msg: NewChangeParamsMsg(
+				"creator", []param.Parameter{param.CoinDayParam{}, param.ReputationParam{}}, ""),
+		},
 		{
 			testName: "vote proposal msg",
-			msg:      NewVoteProposalMsg("voter", 1, true),
+			msg:      NewVoteProposalMsg("voter", 1, true, 0),
+		},
+		{
+			testName: "community pool spend msg",
+			msg:      NewCommunityPoolSpendMsg("creator", "recipient", "1", "reason"),
+		},
+		{
+			testName: "developer punish msg",
+			msg:      NewDeveloperPunishMsg("creator", "developer", "0.5", true, "reason"),
+		},
+		{
+			testName: "text proposal msg",
+			msg:      NewTextProposalMsg("creator", "title", "description"),
+		},
+		{
+			testName: "split vote proposal msg",
+			msg:      NewSplitVoteProposalMsg("voter", 1, 5000, 3000, 2000, 0),
+		},
+		{
+			testName: "deposit proposal msg",
+			msg:      NewDepositProposalMsg("depositor", 1, "1"),
+		},
+		{
+			testName: "cancel proposal msg",
+			msg:      NewCancelProposalMsg("creator", 1),
+		},
+		{
+			testName: "appeal censorship msg",
+			msg:      NewAppealCensorshipMsg("author", 1, "reason"),
 		},
 	}
 
@@ -1104,7 +1895,7 @@ func TestGetSigners(t *testing.T) {
 		},
 		{
 			testName:      "upgrade protocol msg",
-			msg:           NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:           NewUpgradeProtocolMsg("creator", "link", "", "", 0),
 			expectSigners: []types.AccountKey{"creator"},
 		},
 		{
@@ -1161,11 +1952,64 @@ func TestGetSigners(t *testing.T) {
 				"creator", param.PostParam{}, ""),
 			expectSigners: []types.AccountKey{"creator"},
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName: "change params msg",
+			msg: NewChangeParamsMsg(
+				"creator", []param.Parameter{param.CoinDayParam{}, param.ReputationParam{}}, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
 		{
 			testName:      "vote proposal msg",
-			msg:           NewVoteProposalMsg("voter", 1, true),
+			msg:           NewVoteProposalMsg("voter", 1, true, 0),
+			expectSigners: []types.AccountKey{"voter"},
+		},
+		{
+			testName:      "community pool spend msg",
+			msg:           NewCommunityPoolSpendMsg("creator", "recipient", "1", "reason"),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "developer punish msg",
+			msg:           NewDeveloperPunishMsg("creator", "developer", "0.5", true, "reason"),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "text proposal msg",
+			msg:           NewTextProposalMsg("creator", "title", "description"),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "split vote proposal msg",
+			msg:           NewSplitVoteProposalMsg("voter", 1, 5000, 3000, 2000, 0),
 			expectSigners: []types.AccountKey{"voter"},
 		},
+		{
+			testName:      "deposit proposal msg",
+			msg:           NewDepositProposalMsg("depositor", 1, "1"),
+			expectSigners: []types.AccountKey{"depositor"},
+		},
+		{
+			testName:      "cancel proposal msg",
+			msg:           NewCancelProposalMsg("creator", 1),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "appeal censorship msg",
+			msg:           NewAppealCensorshipMsg("author", 1, "reason"),
+			expectSigners: []types.AccountKey{"author"},
+		},
 	}
 
 	for _, tc := range testCases {
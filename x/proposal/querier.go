@@ -4,6 +4,7 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal/model"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -20,11 +21,13 @@ const (
 	QueryNextProposal    = "next"
 	QueryOngoingProposal = "ongoing"
 	QueryExpiredProposal = "expired"
+	QueryProposal        = "proposal"
 )
 
 // creates a querier for proposal REST endpoints
 func NewQuerier(pm ProposalManager) sdk.Querier {
 	cdc := wire.New()
+	model.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
@@ -32,6 +35,8 @@ func NewQuerier(pm ProposalManager) sdk.Querier {
 			return queryOngoingProposal(ctx, cdc, path[1:], req, pm)
 		case QueryExpiredProposal:
 			return queryExpiredProposal(ctx, cdc, path[1:], req, pm)
+		case QueryProposal:
+			return queryProposal(ctx, cdc, path[1:], req, pm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown proposal query endpoint")
 		}
@@ -67,3 +72,19 @@ func queryExpiredProposal(ctx sdk.Context, cdc *wire.Codec, path []string, req a
 	}
 	return res, nil
 }
+
+// queryProposal - path: proposalID, proposal with its tally and projected result
+func queryProposal(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	detail, err := pm.GetProposalDetail(ctx, types.ProposalKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(detail)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -16,6 +16,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 	val "github.com/lino-network/lino/x/validator"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -30,6 +31,7 @@ var (
 	testParamKVStoreKey     = sdk.NewKVStoreKey("param")
 	testValidatorKVStoreKey = sdk.NewKVStoreKey("validator")
 	testPostKVStoreKey      = sdk.NewKVStoreKey("post")
+	testDeveloperKVStoreKey = sdk.NewKVStoreKey("developer")
 )
 
 func initGlobalManager(ctx sdk.Context, gm global.GlobalManager) error {
@@ -38,7 +40,7 @@ func initGlobalManager(ctx sdk.Context, gm global.GlobalManager) error {
 
 func setupTest(t *testing.T, height int64) (
 	sdk.Context, acc.AccountManager, ProposalManager, post.PostManager, vote.VoteManager,
-	val.ValidatorManager, global.GlobalManager) {
+	val.ValidatorManager, global.GlobalManager, dev.DeveloperManager) {
 	ctx := getContext(height)
 	ph := param.NewParamHolder(testParamKVStoreKey)
 	ph.InitParam(ctx)
@@ -49,16 +51,19 @@ func setupTest(t *testing.T, height int64) (
 	voteManager := vote.NewVoteManager(testGlobalKVStoreKey, ph)
 	valManager := val.NewValidatorManager(testValidatorKVStoreKey, ph)
 	postManager := post.NewPostManager(testPostKVStoreKey, ph)
+	developerManager := dev.NewDeveloperManager(testDeveloperKVStoreKey, ph)
 
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "1", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "2", nil)
 	cdc.RegisterConcrete(DecideProposalEvent{}, "3", nil)
+	cdc.RegisterConcrete(ExpirePendingProposalEvent{}, "4", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
-	return ctx, accManager, proposalManager, postManager, voteManager, valManager, globalManager
+	return ctx, accManager, proposalManager, postManager, voteManager, valManager, globalManager,
+		developerManager
 }
 
 func getContext(height int64) sdk.Context {
@@ -71,6 +76,7 @@ func getContext(height int64) sdk.Context {
 	ms.MountStoreWithDB(testVoteKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testValidatorKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testPostKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
 
 	ms.LoadLatestVersion()
 
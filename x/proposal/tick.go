@@ -0,0 +1,78 @@
+package proposal
+
+import (
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EndBlocker - called every end blocker, open content censorship proposal
+// for posts whose reports exceed the escalation threshold. Must be called
+// before global end blocker to commit decide events.
+func EndBlocker(
+	ctx sdk.Context, req abci.RequestEndBlock, pm ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager) (tags sdk.Tags) {
+	if err := escalateContentCensorship(ctx, pm, postManager, gm); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func escalateContentCensorship(
+	ctx sdk.Context, pm ProposalManager, postManager post.PostManager, gm *global.GlobalManager) sdk.Error {
+	permlinks, err := postManager.GetCensorshipEscalationList(ctx)
+	if err != nil {
+		return err
+	}
+	if len(permlinks) == 0 {
+		return nil
+	}
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	for _, permlink := range permlinks {
+		postManager.RemoveCensorshipEscalation(ctx, permlink)
+		pool, err := gm.GetCensorshipEscalationPool(ctx)
+		if err != nil {
+			return err
+		}
+		// if pool can't fund the deposit or post is deleted, post is visible to reward again
+		isDeleted, err := postManager.IsDeleted(ctx, permlink)
+		if err != nil {
+			return err
+		}
+		if isDeleted || !pool.IsGTE(param.ContentCensorshipMinDeposit) {
+			if err := postManager.ClearCensorshipPending(ctx, permlink, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := gm.MinusFromCensorshipEscalationPool(ctx, param.ContentCensorshipMinDeposit); err != nil {
+			return err
+		}
+
+		proposal := pm.CreateEscalatedContentCensorshipProposal(ctx, permlink)
+		proposalID, err := pm.AddProposal(
+			ctx, types.AccountKey(""), proposal, param.ContentCensorshipDecideSec)
+		if err != nil {
+			return err
+		}
+		if err := pm.SetEscalationDeposit(ctx, proposalID, param.ContentCensorshipMinDeposit); err != nil {
+			return err
+		}
+		// only decision of this proposal releases the post
+		if err := postManager.SetCensorshipPendingProposal(ctx, permlink, proposalID); err != nil {
+			return err
+		}
+		// set a time event to decide the proposal
+		event := pm.CreateDecideProposalEvent(ctx, types.ContentCensorship, proposalID)
+		if err := gm.RegisterProposalDecideEvent(ctx, param.ContentCensorshipDecideSec, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,23 +1,49 @@
 package proposal
 
 import (
+	"github.com/lino-network/lino/param"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 )
 
 // Register concrete types on wire codec
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(VoteProposalMsg{}, "lino/voteProposal", nil)
+	cdc.RegisterConcrete(SplitVoteProposalMsg{}, "lino/splitVoteProposal", nil)
+	cdc.RegisterConcrete(DepositProposalMsg{}, "lino/depositProposal", nil)
+	cdc.RegisterConcrete(CancelProposalMsg{}, "lino/cancelProposal", nil)
+	cdc.RegisterConcrete(AppealCensorshipMsg{}, "lino/appealCensorship", nil)
 	cdc.RegisterConcrete(DeletePostContentMsg{}, "lino/deletePostContent", nil)
 	cdc.RegisterConcrete(UpgradeProtocolMsg{}, "lino/upgradeProtocol", nil)
+	cdc.RegisterConcrete(TextProposalMsg{}, "lino/textProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolSpendMsg{}, "lino/communityPoolSpend", nil)
+	cdc.RegisterConcrete(DeveloperPunishMsg{}, "lino/developerPunish", nil)
 	cdc.RegisterConcrete(ChangeGlobalAllocationParamMsg{}, "lino/changeGlobalAllocation", nil)
 	cdc.RegisterConcrete(ChangeInfraInternalAllocationParamMsg{}, "lino/changeInfraAllocation", nil)
 	cdc.RegisterConcrete(ChangeVoteParamMsg{}, "lino/changeVoteParam", nil)
 	cdc.RegisterConcrete(ChangeProposalParamMsg{}, "lino/changeProposalParam", nil)
 	cdc.RegisterConcrete(ChangeDeveloperParamMsg{}, "lino/changeDeveloperParam", nil)
 	cdc.RegisterConcrete(ChangeValidatorParamMsg{}, "lino/changeValidatorParam", nil)
+	cdc.RegisterConcrete(ChangeCoinDayParamMsg{}, "lino/changeCoinDayParam", nil)
 	cdc.RegisterConcrete(ChangeBandwidthParamMsg{}, "lino/changeBandwidthParam", nil)
 	cdc.RegisterConcrete(ChangeAccountParamMsg{}, "lino/changeAccountParam", nil)
 	cdc.RegisterConcrete(ChangePostParamMsg{}, "lino/changePostParam", nil)
+	cdc.RegisterConcrete(ChangeReputationParamMsg{}, "lino/changeReputationParam", nil)
+	cdc.RegisterConcrete(ChangeParamsMsg{}, "lino/changeParams", nil)
+
+	// parameters carried by bundled change parameters msg
+	cdc.RegisterInterface((*param.Parameter)(nil), nil)
+	cdc.RegisterConcrete(param.GlobalAllocationParam{}, "param/allocation", nil)
+	cdc.RegisterConcrete(param.InfraInternalAllocationParam{}, "param/infaAllocation", nil)
+	cdc.RegisterConcrete(param.VoteParam{}, "param/vote", nil)
+	cdc.RegisterConcrete(param.ProposalParam{}, "param/proposal", nil)
+	cdc.RegisterConcrete(param.DeveloperParam{}, "param/developer", nil)
+	cdc.RegisterConcrete(param.ValidatorParam{}, "param/validator", nil)
+	cdc.RegisterConcrete(param.CoinDayParam{}, "param/coinDay", nil)
+	cdc.RegisterConcrete(param.BandwidthParam{}, "param/bandwidth", nil)
+	cdc.RegisterConcrete(param.AccountParam{}, "param/account", nil)
+	cdc.RegisterConcrete(param.PostParam{}, "param/post", nil)
+	cdc.RegisterConcrete(param.ReputationParam{}, "param/reputation", nil)
 }
 
 var msgCdc = wire.New()
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BackTxCmd will create a back tx and sign it with the given key
+func BackTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-back",
+		Short: "back a validator with coin",
+		RunE:  sendBackTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "backer of this transaction")
+	cmd.Flags().String(client.FlagValidator, "", "validator to back")
+	cmd.Flags().String(client.FlagAmount, "", "amount of LNO to back")
+	return cmd
+}
+
+// UnbackTxCmd will create an unback tx and sign it with the given key
+func UnbackTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-unback",
+		Short: "withdraw coin backed to a validator",
+		RunE:  sendUnbackTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "backer of this transaction")
+	cmd.Flags().String(client.FlagValidator, "", "validator backed")
+	cmd.Flags().String(client.FlagAmount, "", "amount of LNO to withdraw")
+	return cmd
+}
+
+// send back transaction to the blockchain
+func sendBackTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := validator.NewValidatorBackMsg(
+			viper.GetString(client.FlagUser), viper.GetString(client.FlagValidator),
+			viper.GetString(client.FlagAmount))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// send unback transaction to the blockchain
+func sendUnbackTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := validator.NewValidatorUnbackMsg(
+			viper.GetString(client.FlagUser), viper.GetString(client.FlagValidator),
+			viper.GetString(client.FlagAmount))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
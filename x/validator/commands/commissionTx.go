@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCommissionTxCmd will create a set commission tx and sign it with the given key
+func SetCommissionTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-commission",
+		Short: "set commission rate taken from inflation of backers",
+		RunE:  sendSetCommissionTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "validator of this transaction")
+	cmd.Flags().String(client.FlagCommissionRate, "", "commission rate, between 0 and 1")
+	return cmd
+}
+
+// send set commission transaction to the blockchain
+func sendSetCommissionTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+		msg := validator.NewValidatorSetCommissionMsg(name, viper.GetString(client.FlagCommissionRate))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
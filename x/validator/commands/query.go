@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
@@ -39,6 +40,32 @@ func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetCommissionCmd returns commission rate of validator
+func GetCommissionCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-commission",
+		Short: "Query validator commission rate",
+		RunE:  cmdr.getCommissionCmd,
+	}
+}
+
+// GetBackingsCmd returns all backings of validator
+func GetBackingsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-backings",
+		Short: "Query coin backed to validator",
+		RunE:  cmdr.getBackingsCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -90,3 +117,56 @@ func (c commander) getValidatorCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getCommissionCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.Query(model.GetCommissionKey(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	// validator never set commission rate
+	commission := &model.ValidatorCommission{Rate: sdk.ZeroDec()}
+	if len(res) != 0 {
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, commission); err != nil {
+			return err
+		}
+	}
+
+	output, err := json.MarshalIndent(commission, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getBackingsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetBackingPrefix(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	backings := []model.Backing{}
+	for _, kv := range resKVs {
+		var backing model.Backing
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(kv.Value, &backing); err != nil {
+			return err
+		}
+		backings = append(backings, backing)
+	}
+
+	output, err := json.MarshalIndent(backings, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -51,3 +51,33 @@ func ErrValidatorPubKeyAlreadyExist() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeValidatorQueryFailed, fmt.Sprintf("query validator store failed"))
 }
+
+// ErrInvalidCommissionRate - error if commission rate is invalid
+func ErrInvalidCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidCommissionRate, fmt.Sprintf("invalid commission rate"))
+}
+
+// ErrCommissionRateTooHigh - error if commission rate exceeds maximum commission rate
+func ErrCommissionRateTooHigh() sdk.Error {
+	return types.NewError(types.CodeCommissionRateTooHigh, fmt.Sprintf("commission rate exceeds maximum"))
+}
+
+// ErrCommissionChangeTooOften - error if validator changes commission rate more than once a day
+func ErrCommissionChangeTooOften() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooOften, fmt.Sprintf("commission rate can only be changed once a day"))
+}
+
+// ErrCommissionChangeTooMuch - error if commission rate change exceeds maximum change rate
+func ErrCommissionChangeTooMuch() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooMuch, fmt.Sprintf("commission rate change exceeds maximum"))
+}
+
+// ErrBackSelf - error if validator backs itself
+func ErrBackSelf() sdk.Error {
+	return types.NewError(types.CodeBackSelf, fmt.Sprintf("validator can't back itself"))
+}
+
+// ErrInsufficientBacking - error if backer withdraws more than backed to validator
+func ErrInsufficientBacking() sdk.Error {
+	return types.NewError(types.CodeInsufficientBacking, fmt.Sprintf("backing is not enough"))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/validator/model"
 	vote "github.com/lino-network/lino/x/vote"
 )
 
@@ -21,6 +22,12 @@ func NewHandler(am acc.AccountManager, valManager ValidatorManager, voteManager
 			return handleWithdrawMsg(ctx, valManager, gm, am, msg)
 		case ValidatorRevokeMsg:
 			return handleRevokeMsg(ctx, valManager, gm, am, msg)
+		case ValidatorSetCommissionMsg:
+			return handleSetCommissionMsg(ctx, valManager, msg)
+		case ValidatorBackMsg:
+			return handleBackMsg(ctx, valManager, am, msg)
+		case ValidatorUnbackMsg:
+			return handleUnbackMsg(ctx, valManager, gm, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized validator msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -118,7 +125,90 @@ func handleRevokeMsg(
 		return withdrawErr.Result()
 	}
 
-	if err := vm.RemoveValidatorFromAllLists(ctx, msg.Username); err != nil {
+	backings, err := vm.RemoveValidatorFromAllLists(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := returnCoinTo(
+		ctx, msg.Username, gm, am, param.ValidatorCoinReturnTimes,
+		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
+		return err.Result()
+	}
+	if err := ReturnBackings(ctx, vm, gm, am, backings); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleSetCommissionMsg(
+	ctx sdk.Context, vm ValidatorManager, msg ValidatorSetCommissionMsg) sdk.Result {
+	if !vm.DoesValidatorExist(ctx, msg.Username) {
+		return model.ErrValidatorNotFound().Result()
+	}
+	rate, err := sdk.NewDecFromStr(msg.CommissionRate)
+	if err != nil {
+		return ErrInvalidCommissionRate().Result()
+	}
+	if err := vm.SetCommissionRate(ctx, msg.Username, rate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleBackMsg(
+	ctx sdk.Context, vm ValidatorManager, am acc.AccountManager, msg ValidatorBackMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+	// validator commits its own deposit instead of backing itself
+	if msg.Username == msg.Validator {
+		return ErrBackSelf().Result()
+	}
+	// only registered validator can be backed
+	lst, err := vm.GetValidatorList(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if types.FindAccountInList(msg.Validator, lst.AllValidators) == -1 {
+		return model.ErrValidatorNotFound().Result()
+	}
+
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, coin, msg.Validator, "", types.ValidatorBacking); err != nil {
+		return err.Result()
+	}
+	if err := vm.Back(ctx, msg.Validator, msg.Username, coin); err != nil {
+		return err.Result()
+	}
+
+	// backed power may change oncall validators
+	if err := vm.AdjustValidatorList(ctx); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleUnbackMsg(
+	ctx sdk.Context, vm ValidatorManager, gm *global.GlobalManager, am acc.AccountManager,
+	msg ValidatorUnbackMsg) sdk.Result {
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if err := vm.Unback(ctx, msg.Validator, msg.Username, coin); err != nil {
+		return err.Result()
+	}
+	if err := vm.AdjustValidatorList(ctx); err != nil {
 		return err.Result()
 	}
 
@@ -135,6 +225,27 @@ func handleRevokeMsg(
 	return sdk.Result{}
 }
 
+// ReturnBackings - return coin of backings released by removed validator to backers
+func ReturnBackings(
+	ctx sdk.Context, vm ValidatorManager, gm *global.GlobalManager, am acc.AccountManager,
+	backings []model.Backing) sdk.Error {
+	if len(backings) == 0 {
+		return nil
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	for _, backing := range backings {
+		if err := returnCoinTo(
+			ctx, backing.Backer, gm, am, param.ValidatorCoinReturnTimes,
+			param.ValidatorCoinReturnIntervalSec, backing.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager, am acc.AccountManager,
 	times int64, interval int64, coin types.Coin) sdk.Error {
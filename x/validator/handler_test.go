@@ -559,3 +559,136 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestSetCommissionMsg(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, &gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	createTestAccount(ctx, am, "user2", minBalance)
+
+	// user2 is not validator
+	result := handler(ctx, NewValidatorSetCommissionMsg("user2", "0.01"))
+	assert.Equal(t, model.ErrValidatorNotFound().Result(), result)
+
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	result = handler(ctx, NewValidatorDepositMsg("user1", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewValidatorSetCommissionMsg("user1", "0.01"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	rate, err := valManager.GetCommissionRate(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, rate.Equal(types.NewDecFromRat(1, 100)))
+}
+
+func TestBackAndUnbackValidator(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, &gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+
+	// create 21 oncall validators with min committing deposit + 10,20,30...210
+	users := make([]types.AccountKey, 21)
+	for i := 0; i < 21; i++ {
+		users[i] = createTestAccount(ctx, am, "user"+strconv.Itoa(i+1), minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, users[i], valParam.ValidatorMinVotingDeposit)
+
+		valMinCommitDeposit, _ := valParam.ValidatorMinCommittingDeposit.ToInt64()
+		num := int64((i+1)*10) + valMinCommitDeposit/types.Decimals
+		deposit := types.LNO(strconv.FormatInt(num, 10))
+		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, secp256k1.GenPrivKey().PubKey(), "")
+		result := handler(ctx, msg)
+		assert.Equal(t, sdk.Result{}, result)
+	}
+
+	// candidate with min committing deposit can't join oncall validator list
+	candidate := createTestAccount(ctx, am, "candidate", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, candidate, valParam.ValidatorMinVotingDeposit)
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	result := handler(ctx, NewValidatorDepositMsg("candidate", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ := valManager.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList(candidate, lst.OncallValidators))
+
+	backer := createTestAccount(ctx, am, "backer", minBalance)
+
+	// only registered validator can be backed
+	result = handler(ctx, NewValidatorBackMsg("backer", "user1000", types.LNO("100")))
+	assert.Equal(t, model.ErrValidatorNotFound().Result(), result)
+
+	// backed power makes candidate replace validator with lowest power
+	result = handler(ctx, NewValidatorBackMsg("backer", "candidate", types.LNO("100")))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ := am.GetSavingFromBank(ctx, backer)
+	assert.True(t, saving.IsEqual(minBalance.Minus(types.NewCoinFromInt64(100*types.Decimals))))
+	lst, _ = valManager.GetValidatorList(ctx)
+	assert.NotEqual(t, -1, types.FindAccountInList(candidate, lst.OncallValidators))
+	assert.Equal(t, -1, types.FindAccountInList(users[0], lst.OncallValidators))
+	assert.Equal(t, users[1], lst.LowestValidator)
+
+	result = handler(ctx, NewValidatorUnbackMsg("backer", "candidate", types.LNO("101")))
+	assert.Equal(t, ErrInsufficientBacking().Result(), result)
+
+	// after unback, validator with lowest power comes back
+	result = handler(ctx, NewValidatorUnbackMsg("backer", "candidate", types.LNO("100")))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList(candidate, lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList(users[0], lst.OncallValidators))
+	backings, _ := valManager.GetBackings(ctx, candidate)
+	assert.Equal(t, 0, len(backings))
+
+	// unbacked coin is returned through coin return events
+	frozenMoneyList, err := am.GetFrozenMoneyList(ctx, backer)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frozenMoneyList))
+	assert.True(t, frozenMoneyList[0].Amount.IsEqual(types.NewCoinFromInt64(100*types.Decimals)))
+}
+
+func TestRevokeReturnsBackings(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, &gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1",
+		minBalance.Plus(valParam.ValidatorMinCommittingDeposit).Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	result := handler(ctx, NewValidatorDepositMsg("user1", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// validator can't back itself
+	result = handler(ctx, NewValidatorBackMsg("user1", "user1", types.LNO("100")))
+	assert.Equal(t, ErrBackSelf().Result(), result)
+
+	backer := createTestAccount(ctx, am, "backer", minBalance)
+	result = handler(ctx, NewValidatorBackMsg("backer", "user1", types.LNO("100")))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// backing is returned to backer after validator revokes
+	result = handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	backings, err := valManager.GetBackings(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(backings))
+	frozenMoneyList, err := am.GetFrozenMoneyList(ctx, backer)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frozenMoneyList))
+	assert.True(t, frozenMoneyList[0].Amount.IsEqual(types.NewCoinFromInt64(100*types.Decimals)))
+
+	// released backing doesn't count after validator deposits again
+	result = handler(ctx, NewValidatorDepositMsg("user1", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+	power, err := valManager.GetValidatorPower(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, power.IsEqual(valParam.ValidatorMinCommittingDeposit))
+}
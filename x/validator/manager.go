@@ -199,15 +199,19 @@ func (vm ValidatorManager) UpdateSigningStats(
 	return nil
 }
 
-// PunishOncallValidator - punish oncall validator if 1) byzantine or 2) missing blocks reach limiation
+// PunishOncallValidator - punish oncall validator if 1) byzantine or 2) missing blocks reach limiation,
+// backings are slashed at the same rate as deposit. Return penalty and backings released
+// if validator is removed, caller should return released backings to backers.
 func (vm ValidatorManager) PunishOncallValidator(
-	ctx sdk.Context, username types.AccountKey, penalty types.Coin, punishType types.PunishType) (types.Coin, sdk.Error) {
+	ctx sdk.Context, username types.AccountKey, penalty types.Coin,
+	punishType types.PunishType) (types.Coin, []model.Backing, sdk.Error) {
 	actualPenalty := penalty
 	validator, err := vm.storage.GetValidator(ctx, username)
 	if err != nil {
-		return actualPenalty, err
+		return actualPenalty, nil, err
 	}
 
+	deposit := validator.Deposit
 	if penalty.IsGT(validator.Deposit) {
 		actualPenalty = validator.Deposit
 		validator.Deposit = types.NewCoinFromInt64(0)
@@ -215,100 +219,148 @@ func (vm ValidatorManager) PunishOncallValidator(
 		validator.Deposit = validator.Deposit.Minus(penalty)
 	}
 
+	// backed power counts in validator's power, backers share the punishment
+	backingPenalty, err := vm.slashBackings(ctx, username, actualPenalty, deposit)
+	if err != nil {
+		return actualPenalty, nil, err
+	}
+
 	if punishType == types.PunishAbsentCommit {
 		validator.AbsentCommit = 0
 	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
-		return actualPenalty, err
+		return actualPenalty, nil, err
 	}
 
 	// remove this validator if its remaining deposit is not enough
 	// OR, we explicitly want to fire this validator
 	// all deposit will be added back to inflation pool
+	var released []model.Backing
 	if punishType == types.PunishByzantine || !validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
-		if err := vm.RemoveValidatorFromAllLists(ctx, validator.Username); err != nil {
-			return actualPenalty, err
+		released, err = vm.RemoveValidatorFromAllLists(ctx, validator.Username)
+		if err != nil {
+			return actualPenalty, nil, err
 		}
 		actualPenalty = actualPenalty.Plus(validator.Deposit)
 		validator.Deposit = types.NewCoinFromInt64(0)
 	}
+	actualPenalty = actualPenalty.Plus(backingPenalty)
 
 	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
-		return actualPenalty, err
+		return actualPenalty, nil, err
 	}
 
 	if err := vm.AdjustValidatorList(ctx); err != nil {
-		return actualPenalty, err
+		return actualPenalty, nil, err
+	}
+	return actualPenalty, released, nil
+}
+
+// slash backings of validator at rate of deposit penalty to deposit, return total slashed coin
+func (vm ValidatorManager) slashBackings(
+	ctx sdk.Context, username types.AccountKey, depositPenalty, deposit types.Coin) (types.Coin, sdk.Error) {
+	slashed := types.NewCoinFromInt64(0)
+	if !deposit.IsPositive() || !depositPenalty.IsPositive() {
+		return slashed, nil
+	}
+	backings, err := vm.storage.GetBackings(ctx, username)
+	if err != nil {
+		return slashed, err
+	}
+	for _, backing := range backings {
+		slash := types.DecToCoin(
+			backing.Amount.ToDec().Mul(depositPenalty.ToDec()).Quo(deposit.ToDec()))
+		if slash.IsZero() {
+			continue
+		}
+		slashed = slashed.Plus(slash)
+		backing.Amount = backing.Amount.Minus(slash)
+		if backing.Amount.IsZero() {
+			if err := vm.storage.DeleteBacking(ctx, username, backing.Backer); err != nil {
+				return slashed, err
+			}
+			continue
+		}
+		if err := vm.storage.SetBacking(ctx, username, &backing); err != nil {
+			return slashed, err
+		}
 	}
-	return actualPenalty, nil
+	return slashed, nil
 }
 
-// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine
+// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine,
+// return total penalty and backings released by fired validators.
 func (vm ValidatorManager) FireIncompetentValidator(
-	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, sdk.Error) {
+	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, []model.Backing, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
+	released := []model.Backing{}
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
-		return totalPenalty, err
+		return totalPenalty, nil, err
 	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
-		return totalPenalty, err
+		return totalPenalty, nil, err
 	}
 
 	for _, validatorName := range lst.OncallValidators {
 		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
-			return totalPenalty, err
+			return totalPenalty, nil, err
 		}
 
 		for _, evidence := range byzantineValidators {
 			if reflect.DeepEqual(validator.ABCIValidator.Address, evidence.Validator.Address) {
-				actualPenalty, err := vm.PunishOncallValidator(
+				actualPenalty, backings, err := vm.PunishOncallValidator(
 					ctx, validator.Username, param.PenaltyByzantine, types.PunishByzantine)
 				if err != nil {
-					return totalPenalty, err
+					return totalPenalty, nil, err
 				}
 				totalPenalty = totalPenalty.Plus(actualPenalty)
+				released = append(released, backings...)
 				break
 			}
 		}
 
 		if validator.AbsentCommit > param.AbsentCommitLimitation {
-			actualPenalty, err := vm.PunishOncallValidator(
+			actualPenalty, backings, err := vm.PunishOncallValidator(
 				ctx, validator.Username, param.PenaltyMissCommit, types.PunishAbsentCommit)
 			if err != nil {
-				return totalPenalty, err
+				return totalPenalty, nil, err
 			}
 
 			totalPenalty = totalPenalty.Plus(actualPenalty)
+			released = append(released, backings...)
 		}
 	}
 
-	return totalPenalty, nil
+	return totalPenalty, released, nil
 }
 
-// PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal
+// PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal,
+// return total penalty and backings released by fired validators.
 func (vm ValidatorManager) PunishValidatorsDidntVote(
-	ctx sdk.Context, penaltyList []types.AccountKey) (types.Coin, sdk.Error) {
+	ctx sdk.Context, penaltyList []types.AccountKey) (types.Coin, []model.Backing, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
+	released := []model.Backing{}
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
-		return totalPenalty, err
+		return totalPenalty, nil, err
 	}
 	// punish these validators who didn't vote
 	for _, validator := range penaltyList {
-		actualPenalty, err := vm.PunishOncallValidator(ctx, validator, param.PenaltyMissVote, types.PunishDidntVote)
+		actualPenalty, backings, err := vm.PunishOncallValidator(ctx, validator, param.PenaltyMissVote, types.PunishDidntVote)
 		if err != nil {
-			return totalPenalty, err
+			return totalPenalty, nil, err
 		}
 		totalPenalty = totalPenalty.Plus(actualPenalty)
+		released = append(released, backings...)
 	}
 
-	return totalPenalty, nil
+	return totalPenalty, released, nil
 }
 
 // RegisterValidator - register validator
@@ -435,10 +487,14 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 		lst.AllValidators = append(lst.AllValidators, username)
 	}
 
+	curPower, err := vm.getPower(ctx, curValidator)
+	if err != nil {
+		return err
+	}
 	// add to list directly if validator list is not full
 	if int64(len(lst.OncallValidators)) < param.ValidatorListSize {
 		lst.OncallValidators = append(lst.OncallValidators, curValidator.Username)
-	} else if curValidator.Deposit.IsGT(lst.LowestPower) {
+	} else if curPower.IsGT(lst.LowestPower) {
 		// replace the validator with lowest power
 		for idx, validatorKey := range lst.OncallValidators {
 			validator, err := vm.storage.GetValidator(ctx, validatorKey)
@@ -461,27 +517,39 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 	return nil
 }
 
-// RemoveValidatorFromAllLists - remove the user from both oncall and allValidators lists
-func (vm ValidatorManager) RemoveValidatorFromAllLists(ctx sdk.Context, username types.AccountKey) sdk.Error {
+// RemoveValidatorFromAllLists - remove the user from both oncall and allValidators lists,
+// all backings of the user are removed and returned, caller should return them to backers.
+func (vm ValidatorManager) RemoveValidatorFromAllLists(
+	ctx sdk.Context, username types.AccountKey) ([]model.Backing, sdk.Error) {
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if types.FindAccountInList(username, lst.AllValidators) == -1 {
-		return nil
+		return nil, nil
 	}
 
 	lst.AllValidators = remove(username, lst.AllValidators)
 	lst.OncallValidators = remove(username, lst.OncallValidators)
 
 	if err := vm.storage.SetValidatorList(ctx, lst); err != nil {
-		return err
+		return nil, err
+	}
+	// backings don't count again if the user registers as validator later
+	backings, err := vm.storage.GetBackings(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	for _, backing := range backings {
+		if err := vm.storage.DeleteBacking(ctx, username, backing.Backer); err != nil {
+			return nil, err
+		}
 	}
 	if err := vm.AdjustValidatorList(ctx); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return backings, nil
 }
 
 // if any change happens in oncall validator(remove, punish),
@@ -533,9 +601,13 @@ func (vm ValidatorManager) updateLowestValidator(ctx sdk.Context) sdk.Error {
 		if err != nil {
 			return err
 		}
+		power, err := vm.getPower(ctx, validator)
+		if err != nil {
+			return err
+		}
 
-		if newLowestPower.IsGT(validator.Deposit) {
-			newLowestPower = validator.Deposit
+		if newLowestPower.IsGT(power) {
+			newLowestPower = power
 			newLowestValidator = validator.Username
 		}
 	}
@@ -565,17 +637,170 @@ func (vm ValidatorManager) getBestCandidate(ctx sdk.Context) (types.AccountKey,
 		if err != nil {
 			return bestCandidate, err
 		}
+		power, err := vm.getPower(ctx, validator)
+		if err != nil {
+			return bestCandidate, err
+		}
 		// not in the oncall list and has a larger power
 		if types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
-			validator.Deposit.IsGT(bestCandidatePower) {
+			power.IsGT(bestCandidatePower) {
 			bestCandidate = validator.Username
-			bestCandidatePower = validator.Deposit
+			bestCandidatePower = power
 		}
 	}
 	return bestCandidate, nil
 
 }
 
+// power of validator is its committing deposit plus coin backed by stakeholders
+func (vm ValidatorManager) getPower(ctx sdk.Context, validator *model.Validator) (types.Coin, sdk.Error) {
+	backedPower, err := vm.GetBackedPower(ctx, validator.Username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return validator.Deposit.Plus(backedPower), nil
+}
+
+// GetValidatorPower - get power of validator used to elect oncall validators
+func (vm ValidatorManager) GetValidatorPower(ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return vm.getPower(ctx, validator)
+}
+
+// GetBackedPower - get total coin backed to validator by stakeholders
+func (vm ValidatorManager) GetBackedPower(ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
+	backings, err := vm.storage.GetBackings(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	backedPower := types.NewCoinFromInt64(0)
+	for _, backing := range backings {
+		backedPower = backedPower.Plus(backing.Amount)
+	}
+	return backedPower, nil
+}
+
+// GetBackings - get all backings of validator
+func (vm ValidatorManager) GetBackings(ctx sdk.Context, username types.AccountKey) ([]model.Backing, sdk.Error) {
+	return vm.storage.GetBackings(ctx, username)
+}
+
+// Back - stakeholder backs validator with coin
+func (vm ValidatorManager) Back(
+	ctx sdk.Context, validator, backer types.AccountKey, coin types.Coin) sdk.Error {
+	backing, err := vm.storage.GetBacking(ctx, validator, backer)
+	if err != nil {
+		backing = &model.Backing{
+			Backer: backer,
+			Amount: types.NewCoinFromInt64(0),
+		}
+	}
+	backing.Amount = backing.Amount.Plus(coin)
+	return vm.storage.SetBacking(ctx, validator, backing)
+}
+
+// Unback - stakeholder withdraws coin backed to validator, backing is removed if nothing left
+func (vm ValidatorManager) Unback(
+	ctx sdk.Context, validator, backer types.AccountKey, coin types.Coin) sdk.Error {
+	backing, err := vm.storage.GetBacking(ctx, validator, backer)
+	if err != nil {
+		return err
+	}
+	if coin.IsGT(backing.Amount) {
+		return ErrInsufficientBacking()
+	}
+	backing.Amount = backing.Amount.Minus(coin)
+	if backing.Amount.IsZero() {
+		return vm.storage.DeleteBacking(ctx, validator, backer)
+	}
+	return vm.storage.SetBacking(ctx, validator, backing)
+}
+
+// GetCommissionRate - get commission rate of validator, zero if validator never set commission
+func (vm ValidatorManager) GetCommissionRate(ctx sdk.Context, username types.AccountKey) (sdk.Dec, sdk.Error) {
+	commission, err := vm.storage.GetCommission(ctx, username)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return commission.Rate, nil
+}
+
+// SetCommissionRate - change commission rate of validator. Same as voter commission, rate can't
+// exceed maximum commission rate, and can only be changed once a day within maximum change rate.
+func (vm ValidatorManager) SetCommissionRate(ctx sdk.Context, username types.AccountKey, rate sdk.Dec) sdk.Error {
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	if rate.GT(param.MaxCommissionRate) {
+		return ErrCommissionRateTooHigh()
+	}
+	commission, err := vm.storage.GetCommission(ctx, username)
+	if err != nil {
+		return err
+	}
+	if commission.LastChangedAt != 0 &&
+		commission.LastChangedAt+types.CommissionChangeIntervalSec > ctx.BlockHeader().Time.Unix() {
+		return ErrCommissionChangeTooOften()
+	}
+	if rate.GT(commission.Rate.Add(param.MaxCommissionChangeRate)) ||
+		rate.LT(commission.Rate.Sub(param.MaxCommissionChangeRate)) {
+		return ErrCommissionChangeTooMuch()
+	}
+	commission.Rate = rate
+	commission.LastChangedAt = ctx.BlockHeader().Time.Unix()
+	return vm.storage.SetCommission(ctx, username, commission)
+}
+
+// DistributeInflation - share inflation of validator with backers pro rata to their part
+// of validator's power, validator takes commission from backers' share and keeps the rest.
+// Return inflation kept by validator and rewards of backers.
+func (vm ValidatorManager) DistributeInflation(
+	ctx sdk.Context, username types.AccountKey, inflation types.Coin) (types.Coin, []model.BackingReward, sdk.Error) {
+	rewards := []model.BackingReward{}
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	backings, err := vm.storage.GetBackings(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	power, err := vm.getPower(ctx, validator)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	if len(backings) == 0 || !power.IsPositive() {
+		return inflation, rewards, nil
+	}
+	rate, err := vm.GetCommissionRate(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	validatorInflation := inflation
+	for _, backing := range backings {
+		share := types.DecToCoin(
+			inflation.ToDec().Mul(backing.Amount.ToDec()).Quo(power.ToDec()))
+		reward := share.Minus(types.DecToCoin(share.ToDec().Mul(rate)))
+		// rounding must not pay out more than validator's inflation
+		if reward.IsGT(validatorInflation) {
+			reward = validatorInflation
+		}
+		if reward.IsZero() {
+			continue
+		}
+		validatorInflation = validatorInflation.Minus(reward)
+		rewards = append(rewards, model.BackingReward{
+			Backer: backing.Backer,
+			Amount: reward,
+		})
+	}
+	return validatorInflation, rewards, nil
+}
+
 // Export storage state.
 func (vm ValidatorManager) Export(ctx sdk.Context) *model.ValidatorTables {
 	return vm.storage.Export(ctx)
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -49,7 +50,7 @@ func TestByzantines(t *testing.T) {
 			Address: valKeys[idx].Address(),
 			Power:   1000}})
 	}
-	_, err := valManager.FireIncompetentValidator(ctx, byzantines)
+	_, _, err := valManager.FireIncompetentValidator(ctx, byzantines)
 	assert.Nil(t, err)
 
 	validatorList3, _ := valManager.storage.GetValidatorList(ctx)
@@ -146,7 +147,7 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, _, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
@@ -242,7 +243,7 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, _, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
@@ -611,3 +612,190 @@ func TestIsLegalWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestSetValidatorCommissionRate(t *testing.T) {
+	ctx, am, valManager, _, _ := setupTest(t, 0)
+	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(100*types.Decimals))
+	valManager.InitGenesis(ctx)
+	err := valManager.RegisterValidator(
+		ctx, user1, secp256k1.GenPrivKey().PubKey(), param.ValidatorMinCommittingDeposit, "")
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		rate         sdk.Dec
+		atWhen       time.Time
+		expectedErr  sdk.Error
+		expectedRate sdk.Dec
+	}{
+		{
+			testName:     "commission rate exceeds maximum",
+			rate:         types.NewDecFromRat(21, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  ErrCommissionRateTooHigh(),
+			expectedRate: sdk.ZeroDec(),
+		},
+		{
+			testName:     "change commission rate too much from zero",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  ErrCommissionChangeTooMuch(),
+			expectedRate: sdk.ZeroDec(),
+		},
+		{
+			testName:     "set commission rate",
+			rate:         types.NewDecFromRat(1, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  nil,
+			expectedRate: types.NewDecFromRat(1, 100),
+		},
+		{
+			testName:     "change commission rate within one day",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000+types.CommissionChangeIntervalSec-1, 0),
+			expectedErr:  ErrCommissionChangeTooOften(),
+			expectedRate: types.NewDecFromRat(1, 100),
+		},
+		{
+			testName:     "change commission rate after one day",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000+types.CommissionChangeIntervalSec, 0),
+			expectedErr:  nil,
+			expectedRate: types.NewDecFromRat(2, 100),
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: tc.atWhen})
+		err := valManager.SetCommissionRate(ctx, user1, tc.rate)
+		if !assert.Equal(t, tc.expectedErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectedErr)
+		}
+		rate, err := valManager.GetCommissionRate(ctx, user1)
+		assert.Nil(t, err)
+		if !rate.Equal(tc.expectedRate) {
+			t.Errorf("%s: diff rate, got %v, want %v", tc.testName, rate, tc.expectedRate)
+		}
+	}
+}
+
+func TestBackAndUnback(t *testing.T) {
+	ctx, am, valManager, _, _ := setupTest(t, 0)
+	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(100*types.Decimals))
+	user2 := createTestAccount(ctx, am, "user2", types.NewCoinFromInt64(100*types.Decimals))
+	valManager.InitGenesis(ctx)
+	err := valManager.RegisterValidator(
+		ctx, user1, secp256k1.GenPrivKey().PubKey(), param.ValidatorMinCommittingDeposit, "")
+	assert.Nil(t, err)
+
+	c50 := types.NewCoinFromInt64(50 * types.Decimals)
+	err = valManager.Back(ctx, user1, user2, c50)
+	assert.Nil(t, err)
+	err = valManager.Back(ctx, user1, user2, c50)
+	assert.Nil(t, err)
+
+	power, err := valManager.GetValidatorPower(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, power.IsEqual(param.ValidatorMinCommittingDeposit.Plus(c50).Plus(c50)))
+
+	err = valManager.Unback(ctx, user1, user2, c50.Plus(c50).Plus(types.NewCoinFromInt64(1)))
+	assert.Equal(t, ErrInsufficientBacking(), err)
+	err = valManager.Unback(ctx, user1, user2, c50)
+	assert.Nil(t, err)
+	backedPower, err := valManager.GetBackedPower(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, backedPower.IsEqual(c50))
+
+	// backing is removed after all coin withdrawn
+	err = valManager.Unback(ctx, user1, user2, c50)
+	assert.Nil(t, err)
+	backings, err := valManager.GetBackings(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(backings))
+	err = valManager.Unback(ctx, user1, user2, c50)
+	assert.Equal(t, model.ErrBackingNotFound(), err)
+}
+
+func TestDistributeInflation(t *testing.T) {
+	ctx, am, valManager, _, _ := setupTest(t, 0)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(100*types.Decimals))
+	user2 := createTestAccount(ctx, am, "user2", types.NewCoinFromInt64(100*types.Decimals))
+	user3 := createTestAccount(ctx, am, "user3", types.NewCoinFromInt64(100*types.Decimals))
+	valManager.InitGenesis(ctx)
+	deposit := types.NewCoinFromInt64(100000 * types.Decimals)
+	err := valManager.RegisterValidator(ctx, user1, secp256k1.GenPrivKey().PubKey(), deposit, "")
+	assert.Nil(t, err)
+	inflation := types.NewCoinFromInt64(400 * types.Decimals)
+
+	// validator without backer keeps all inflation
+	validatorInflation, rewards, err := valManager.DistributeInflation(ctx, user1, inflation)
+	assert.Nil(t, err)
+	assert.True(t, validatorInflation.IsEqual(inflation))
+	assert.Equal(t, 0, len(rewards))
+
+	err = valManager.Back(ctx, user1, user2, types.NewCoinFromInt64(100000*types.Decimals))
+	assert.Nil(t, err)
+	err = valManager.Back(ctx, user1, user3, types.NewCoinFromInt64(200000*types.Decimals))
+	assert.Nil(t, err)
+	err = valManager.storage.SetCommission(ctx, user1, &model.ValidatorCommission{
+		Rate: types.NewDecFromRat(10, 100),
+	})
+	assert.Nil(t, err)
+
+	// backers get 100 and 200 before commission
+	validatorInflation, rewards, err = valManager.DistributeInflation(ctx, user1, inflation)
+	assert.Nil(t, err)
+	assert.True(t, validatorInflation.IsEqual(types.NewCoinFromInt64(130*types.Decimals)))
+	assert.Equal(t, 2, len(rewards))
+	for _, reward := range rewards {
+		switch reward.Backer {
+		case user2:
+			assert.True(t, reward.Amount.IsEqual(types.NewCoinFromInt64(90*types.Decimals)))
+		case user3:
+			assert.True(t, reward.Amount.IsEqual(types.NewCoinFromInt64(180*types.Decimals)))
+		default:
+			t.Errorf("unexpected backer %v", reward.Backer)
+		}
+	}
+}
+
+func TestPunishSlashesBackings(t *testing.T) {
+	ctx, am, valManager, _, _ := setupTest(t, 0)
+	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(100*types.Decimals))
+	user2 := createTestAccount(ctx, am, "user2", types.NewCoinFromInt64(100*types.Decimals))
+	valManager.InitGenesis(ctx)
+	deposit := param.ValidatorMinCommittingDeposit.Plus(param.ValidatorMinCommittingDeposit)
+	err := valManager.RegisterValidator(ctx, user1, secp256k1.GenPrivKey().PubKey(), deposit, "")
+	assert.Nil(t, err)
+	err = valManager.TryBecomeOncallValidator(ctx, user1)
+	assert.Nil(t, err)
+	err = valManager.Back(ctx, user1, user2, types.NewCoinFromInt64(100000*types.Decimals))
+	assert.Nil(t, err)
+
+	// 10% of deposit is slashed, backing is slashed at the same rate
+	actualPenalty, released, err := valManager.PunishOncallValidator(
+		ctx, user1, types.NewCoinFromInt64(20000*types.Decimals), types.PunishDidntVote)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(released))
+	assert.True(t, actualPenalty.IsEqual(types.NewCoinFromInt64(30000*types.Decimals)))
+	backedPower, err := valManager.GetBackedPower(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, backedPower.IsEqual(types.NewCoinFromInt64(90000*types.Decimals)))
+
+	// validator with insufficient deposit is removed, remaining backing is released
+	actualPenalty, released, err = valManager.PunishOncallValidator(
+		ctx, user1, types.NewCoinFromInt64(90000*types.Decimals), types.PunishDidntVote)
+	assert.Nil(t, err)
+	assert.True(t, actualPenalty.IsEqual(types.NewCoinFromInt64(225000*types.Decimals)))
+	assert.Equal(t, 1, len(released))
+	assert.Equal(t, user2, released[0].Backer)
+	assert.True(t, released[0].Amount.IsEqual(types.NewCoinFromInt64(45000*types.Decimals)))
+	backings, err := valManager.GetBackings(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(backings))
+	lst, _ := valManager.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList(user1, lst.AllValidators))
+}
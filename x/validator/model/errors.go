@@ -16,6 +16,10 @@ func ErrValidatorListNotFound() sdk.Error {
 	return types.NewError(types.CodeValidatorListNotFound, fmt.Sprintf("validator list is not found"))
 }
 
+func ErrBackingNotFound() sdk.Error {
+	return types.NewError(types.CodeBackingNotFound, fmt.Sprintf("backing is not found"))
+}
+
 // marshal error
 func ErrFailedToMarshalValidator(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalValidator, fmt.Sprintf("failed to marshal validator: %s", err.Error()))
@@ -25,6 +29,14 @@ func ErrFailedToMarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalValidatorList, fmt.Sprintf("failed to marshal validator list: %s", err.Error()))
 }
 
+func ErrFailedToMarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalValCommission, fmt.Sprintf("failed to marshal validator commission: %s", err.Error()))
+}
+
+func ErrFailedToMarshalBacking(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalBacking, fmt.Sprintf("failed to marshal backing: %s", err.Error()))
+}
+
 // unmarshal error
 func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidator, fmt.Sprintf("failed to unmarshal validator: %s", err.Error()))
@@ -33,3 +45,11 @@ func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 func ErrFailedToUnmarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidatorList, fmt.Sprintf("failed to unmarshal validator list: %s", err.Error()))
 }
+
+func ErrFailedToUnmarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalValCommission, fmt.Sprintf("failed to unmarshal validator commission: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalBacking(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalBacking, fmt.Sprintf("failed to unmarshal backing: %s", err.Error()))
+}
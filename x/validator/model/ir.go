@@ -37,6 +37,8 @@ type ValidatorRowIR struct {
 
 // ValidatorTablesIR - Validators changed.
 type ValidatorTablesIR struct {
-	Validators    []ValidatorRowIR `json:"validators"`
-	ValidatorList ValidatorListRow `json:"validator_list"`
+	Validators    []ValidatorRowIR         `json:"validators"`
+	ValidatorList ValidatorListRow         `json:"validator_list"`
+	Commissions   []ValidatorCommissionRow `json:"commissions"`
+	Backings      []BackingRow             `json:"backings"`
 }
@@ -24,10 +24,24 @@ type ValidatorListRow struct {
 	List ValidatorList `json:"list"`
 }
 
+// ValidatorCommissionRow - pk: (Username)
+type ValidatorCommissionRow struct {
+	Username   types.AccountKey    `json:"username"`
+	Commission ValidatorCommission `json:"commission"`
+}
+
+// BackingRow - pk: (Validator, Backing.Backer)
+type BackingRow struct {
+	Validator types.AccountKey `json:"validator"`
+	Backing   Backing          `json:"backing"`
+}
+
 // ValidatorTables state of validators
 type ValidatorTables struct {
-	Validators    []ValidatorRow   `json:"validators"`
-	ValidatorList ValidatorListRow `json:"validator_list"`
+	Validators    []ValidatorRow           `json:"validators"`
+	ValidatorList ValidatorListRow         `json:"validator_list"`
+	Commissions   []ValidatorCommissionRow `json:"commissions"`
+	Backings      []BackingRow             `json:"backings"`
 }
 
 // ToIR -
@@ -37,5 +51,7 @@ func (v ValidatorTables) ToIR() *ValidatorTablesIR {
 		rst.Validators = append(rst.Validators, v.ToIR())
 	}
 	rst.ValidatorList = v.ValidatorList
+	rst.Commissions = v.Commissions
+	rst.Backings = v.Backings
 	return rst
 }
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +14,8 @@ import (
 var (
 	validatorSubstore     = []byte{0x00}
 	validatorListSubstore = []byte{0x01}
+	commissionSubstore    = []byte{0x02}
+	backingSubstore       = []byte{0x03}
 )
 
 type ValidatorStorage struct {
@@ -97,6 +101,85 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 	return nil
 }
 
+// GetCommission - get validator commission from KVStore, zero rate if validator never sets commission
+func (vs ValidatorStorage) GetCommission(
+	ctx sdk.Context, username types.AccountKey) (*ValidatorCommission, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	commissionByte := store.Get(GetCommissionKey(username))
+	if commissionByte == nil {
+		return &ValidatorCommission{Rate: sdk.ZeroDec()}, nil
+	}
+	commission := new(ValidatorCommission)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(commissionByte, commission); err != nil {
+		return nil, ErrFailedToUnmarshalCommission(err)
+	}
+	return commission, nil
+}
+
+// SetCommission - set validator commission to KVStore
+func (vs ValidatorStorage) SetCommission(
+	ctx sdk.Context, username types.AccountKey, commission *ValidatorCommission) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	commissionByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*commission)
+	if err != nil {
+		return ErrFailedToMarshalCommission(err)
+	}
+	store.Set(GetCommissionKey(username), commissionByte)
+	return nil
+}
+
+// GetBacking - get backing of validator by backer from KVStore
+func (vs ValidatorStorage) GetBacking(
+	ctx sdk.Context, validator, backer types.AccountKey) (*Backing, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	backingByte := store.Get(GetBackingKey(validator, backer))
+	if backingByte == nil {
+		return nil, ErrBackingNotFound()
+	}
+	backing := new(Backing)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(backingByte, backing); err != nil {
+		return nil, ErrFailedToUnmarshalBacking(err)
+	}
+	return backing, nil
+}
+
+// GetBackings - get all backings of validator, ordered by backer
+func (vs ValidatorStorage) GetBackings(
+	ctx sdk.Context, validator types.AccountKey) ([]Backing, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	backings := []Backing{}
+	iter := sdk.KVStorePrefixIterator(store, GetBackingPrefix(validator))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		backing := new(Backing)
+		if err := vs.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), backing); err != nil {
+			return nil, ErrFailedToUnmarshalBacking(err)
+		}
+		backings = append(backings, *backing)
+	}
+	return backings, nil
+}
+
+// SetBacking - set backing of validator to KVStore
+func (vs ValidatorStorage) SetBacking(
+	ctx sdk.Context, validator types.AccountKey, backing *Backing) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	backingByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*backing)
+	if err != nil {
+		return ErrFailedToMarshalBacking(err)
+	}
+	store.Set(GetBackingKey(validator, backing.Backer), backingByte)
+	return nil
+}
+
+// DeleteBacking - delete backing of validator by backer from KVStore
+func (vs ValidatorStorage) DeleteBacking(
+	ctx sdk.Context, validator, backer types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetBackingKey(validator, backer))
+	return nil
+}
+
 // Export state of validators.
 func (vs ValidatorStorage) Export(ctx sdk.Context) *ValidatorTables {
 	tables := &ValidatorTables{}
@@ -127,6 +210,41 @@ func (vs ValidatorStorage) Export(ctx sdk.Context) *ValidatorTables {
 	tables.ValidatorList = ValidatorListRow{
 		List: *list,
 	}
+	// export table.commissions
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, commissionSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			username := types.AccountKey(itr.Key()[1:])
+			commission, err := vs.GetCommission(ctx, username)
+			if err != nil {
+				panic("failed to read validator commission: " + err.Error())
+			}
+			tables.Commissions = append(tables.Commissions, ValidatorCommissionRow{
+				Username:   username,
+				Commission: *commission,
+			})
+		}
+	}()
+	// export table.backings
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, backingSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			strs := strings.Split(string(itr.Key()[1:]), types.KeySeparator)
+			if len(strs) != 2 {
+				panic("illegal backing key: " + string(itr.Key()[1:]))
+			}
+			backing := new(Backing)
+			if err := vs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), backing); err != nil {
+				panic("failed to read backing: " + err.Error())
+			}
+			tables.Backings = append(tables.Backings, BackingRow{
+				Validator: types.AccountKey(strs[0]),
+				Backing:   *backing,
+			})
+		}
+	}()
 	return tables
 }
 
@@ -162,6 +280,16 @@ func (vs ValidatorStorage) Import(ctx sdk.Context, tb *ValidatorTablesIR) {
 	// import ValidatorList
 	err := vs.SetValidatorList(ctx, &tb.ValidatorList.List)
 	check(err)
+	// import table.Commissions
+	for _, v := range tb.Commissions {
+		err := vs.SetCommission(ctx, v.Username, &v.Commission)
+		check(err)
+	}
+	// import table.Backings
+	for _, v := range tb.Backings {
+		err := vs.SetBacking(ctx, v.Validator, &v.Backing)
+		check(err)
+	}
 }
 
 func GetValidatorKey(accKey types.AccountKey) []byte {
@@ -171,3 +299,18 @@ func GetValidatorKey(accKey types.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return validatorListSubstore
 }
+
+// GetCommissionKey - "commission substore" + "validator"
+func GetCommissionKey(username types.AccountKey) []byte {
+	return append(commissionSubstore, username...)
+}
+
+// GetBackingPrefix - "backing substore" + "validator" + sep
+func GetBackingPrefix(validator types.AccountKey) []byte {
+	return append(append(backingSubstore, validator...), types.KeySeparator...)
+}
+
+// GetBackingKey - "backing substore" + "validator" + sep + "backer"
+func GetBackingKey(validator, backer types.AccountKey) []byte {
+	return append(GetBackingPrefix(validator), backer...)
+}
@@ -119,3 +119,53 @@ func TestValidatorList(t *testing.T) {
 		}
 	}
 }
+
+func TestCommission(t *testing.T) {
+	ctx, vs := setup(t)
+
+	commission, err := vs.GetCommission(ctx, "user")
+	assert.Nil(t, err)
+	assert.True(t, commission.Rate.IsZero())
+	assert.Equal(t, int64(0), commission.LastChangedAt)
+
+	err = vs.SetCommission(ctx, "user", &ValidatorCommission{
+		Rate:          types.NewDecFromRat(1, 10),
+		LastChangedAt: 100,
+	})
+	assert.Nil(t, err)
+	commission, err = vs.GetCommission(ctx, "user")
+	assert.Nil(t, err)
+	assert.True(t, commission.Rate.Equal(types.NewDecFromRat(1, 10)))
+	assert.Equal(t, int64(100), commission.LastChangedAt)
+}
+
+func TestBacking(t *testing.T) {
+	ctx, vs := setup(t)
+	backings := []Backing{
+		{Backer: "backer1", Amount: types.NewCoinFromInt64(10)},
+		{Backer: "backer2", Amount: types.NewCoinFromInt64(20)},
+	}
+
+	_, err := vs.GetBacking(ctx, "user", "backer1")
+	assert.Equal(t, ErrBackingNotFound(), err)
+
+	for _, backing := range backings {
+		err = vs.SetBacking(ctx, "user", &backing)
+		assert.Nil(t, err)
+	}
+	backingPtr, err := vs.GetBacking(ctx, "user", "backer1")
+	assert.Nil(t, err)
+	assert.Equal(t, backings[0], *backingPtr)
+	result, err := vs.GetBackings(ctx, "user")
+	assert.Nil(t, err)
+	assert.Equal(t, backings, result)
+	result, err = vs.GetBackings(ctx, "user2")
+	assert.Nil(t, err)
+	assert.Equal(t, []Backing{}, result)
+
+	err = vs.DeleteBacking(ctx, "user", "backer1")
+	assert.Nil(t, err)
+	result, err = vs.GetBackings(ctx, "user")
+	assert.Nil(t, err)
+	assert.Equal(t, backings[1:], result)
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -49,3 +50,21 @@ type ValidatorList struct {
 	LowestPower        types.Coin         `json:"lowest_power"`
 	LowestValidator    types.AccountKey   `json:"lowest_validator"`
 }
+
+// ValidatorCommission - commission rate validator takes from inflation shared with backers
+type ValidatorCommission struct {
+	Rate          sdk.Dec `json:"rate"`
+	LastChangedAt int64   `json:"last_changed_at"`
+}
+
+// Backing - stakeholder backs validator with coin to increase validator's power
+type Backing struct {
+	Backer types.AccountKey `json:"backer"`
+	Amount types.Coin       `json:"amount"`
+}
+
+// BackingReward - validator inflation shared with backer after commission
+type BackingReward struct {
+	Backer types.AccountKey `json:"backer"`
+	Amount types.Coin       `json:"amount"`
+}
@@ -12,6 +12,9 @@ import (
 var _ types.Msg = ValidatorDepositMsg{}
 var _ types.Msg = ValidatorWithdrawMsg{}
 var _ types.Msg = ValidatorRevokeMsg{}
+var _ types.Msg = ValidatorSetCommissionMsg{}
+var _ types.Msg = ValidatorBackMsg{}
+var _ types.Msg = ValidatorUnbackMsg{}
 
 // ValidatorDepositMsg - deposit to become validator or add deposit
 type ValidatorDepositMsg struct {
@@ -32,6 +35,26 @@ type ValidatorRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// ValidatorSetCommissionMsg - validator sets commission rate taken from inflation of backers
+type ValidatorSetCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ValidatorBackMsg - stakeholder backs a validator with coin
+type ValidatorBackMsg struct {
+	Username  types.AccountKey `json:"username"`
+	Validator types.AccountKey `json:"validator"`
+	Amount    types.LNO        `json:"amount"`
+}
+
+// ValidatorUnbackMsg - stakeholder withdraws coin backed to a validator
+type ValidatorUnbackMsg struct {
+	Username  types.AccountKey `json:"username"`
+	Validator types.AccountKey `json:"validator"`
+	Amount    types.LNO        `json:"amount"`
+}
+
 // ValidatorDepositMsg Msg Implementations
 func NewValidatorDepositMsg(validator string, deposit types.LNO, pubKey crypto.PubKey, link string) ValidatorDepositMsg {
 	return ValidatorDepositMsg{
@@ -199,3 +222,185 @@ func (msg ValidatorRevokeMsg) GetSigners() []sdk.AccAddress {
 func (msg ValidatorRevokeMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ValidatorSetCommissionMsg Msg Implementations
+func NewValidatorSetCommissionMsg(validator, commissionRate string) ValidatorSetCommissionMsg {
+	return ValidatorSetCommissionMsg{
+		Username:       types.AccountKey(validator),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) Type() string { return "ValidatorSetCommissionMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	rate, err := sdk.NewDecFromStr(msg.CommissionRate)
+	if err != nil {
+		return ErrInvalidCommissionRate()
+	}
+	if rate.LT(sdk.ZeroDec()) || rate.GT(sdk.OneDec()) {
+		return ErrInvalidCommissionRate()
+	}
+	return nil
+}
+
+func (msg ValidatorSetCommissionMsg) String() string {
+	return fmt.Sprintf("ValidatorSetCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorSetCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorSetCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorBackMsg Msg Implementations
+func NewValidatorBackMsg(username, validator string, amount types.LNO) ValidatorBackMsg {
+	return ValidatorBackMsg{
+		Username:  types.AccountKey(username),
+		Validator: types.AccountKey(validator),
+		Amount:    amount,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorBackMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorBackMsg) Type() string { return "ValidatorBackMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorBackMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Validator) < types.MinimumUsernameLength ||
+		len(msg.Validator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	// validator commits its own deposit instead of backing itself
+	if msg.Username == msg.Validator {
+		return ErrBackSelf()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ValidatorBackMsg) String() string {
+	return fmt.Sprintf("ValidatorBackMsg{Username:%v, Validator:%v, Amount:%v}", msg.Username, msg.Validator, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorBackMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorBackMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorBackMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorBackMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorUnbackMsg Msg Implementations
+func NewValidatorUnbackMsg(username, validator string, amount types.LNO) ValidatorUnbackMsg {
+	return ValidatorUnbackMsg{
+		Username:  types.AccountKey(username),
+		Validator: types.AccountKey(validator),
+		Amount:    amount,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorUnbackMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorUnbackMsg) Type() string { return "ValidatorUnbackMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUnbackMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Validator) < types.MinimumUsernameLength ||
+		len(msg.Validator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ValidatorUnbackMsg) String() string {
+	return fmt.Sprintf("ValidatorUnbackMsg{Username:%v, Validator:%v, Amount:%v}", msg.Username, msg.Validator, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUnbackMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUnbackMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUnbackMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUnbackMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -99,6 +99,124 @@ func TestValidatorDepositMsg(t *testing.T) {
 	}
 }
 
+func TestValidatorSetCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorSetCommissionMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorSetCommissionMsg("user1", "0.1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorSetCommissionMsg("", "0.1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "commission rate is not a decimal",
+			msg:           NewValidatorSetCommissionMsg("user1", "abc"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName:      "negative commission rate",
+			msg:           NewValidatorSetCommissionMsg("user1", "-0.1"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName:      "commission rate larger than one",
+			msg:           NewValidatorSetCommissionMsg("user1", "1.1"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorBackMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorBackMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorBackMsg("user1", "user2", types.LNO("1")),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorBackMsg("", "user2", types.LNO("1")),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid validator",
+			msg:           NewValidatorBackMsg("user1", "", types.LNO("1")),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "validator backs itself",
+			msg:           NewValidatorBackMsg("user1", "user1", types.LNO("1")),
+			expectedError: ErrBackSelf(),
+		},
+		{
+			testName:      "invalid amount",
+			msg:           NewValidatorBackMsg("user1", "user2", types.LNO("-1")),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorUnbackMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUnbackMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUnbackMsg("user1", "user2", types.LNO("1")),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUnbackMsg("", "user2", types.LNO("1")),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid validator",
+			msg:           NewValidatorUnbackMsg("user1", "", types.LNO("1")),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid amount",
+			msg:           NewValidatorUnbackMsg("user1", "user2", types.LNO("-1")),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -121,6 +239,21 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewValidatorRevokeMsg("test"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "validator set commission msg",
+			msg:                NewValidatorSetCommissionMsg("test", "0.1"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator back msg",
+			msg:                NewValidatorBackMsg("test", "validator", types.LNO("1")),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator unback msg",
+			msg:                NewValidatorUnbackMsg("test", "validator", types.LNO("1")),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,6 +283,18 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "validator revoke msg",
 			msg:      NewValidatorRevokeMsg("test"),
 		},
+		{
+			testName: "validator set commission msg",
+			msg:      NewValidatorSetCommissionMsg("test", "0.1"),
+		},
+		{
+			testName: "validator back msg",
+			msg:      NewValidatorBackMsg("test", "validator", types.LNO("1")),
+		},
+		{
+			testName: "validator unback msg",
+			msg:      NewValidatorUnbackMsg("test", "validator", types.LNO("1")),
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -179,6 +324,21 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewValidatorRevokeMsg("test"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "validator set commission msg",
+			msg:           NewValidatorSetCommissionMsg("test", "0.1"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator back msg",
+			msg:           NewValidatorBackMsg("test", "validator", types.LNO("1")),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator unback msg",
+			msg:           NewValidatorUnbackMsg("test", "validator", types.LNO("1")),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -19,6 +19,8 @@ const (
 
 	QueryValidator     = "validator"
 	QueryValidatorList = "valList"
+	QueryCommission    = "commission"
+	QueryBackings      = "backings"
 )
 
 // creates a querier for validator REST endpoints
@@ -31,6 +33,10 @@ func NewQuerier(vm ValidatorManager) sdk.Querier {
 			return queryValidator(ctx, cdc, path[1:], req, vm)
 		case QueryValidatorList:
 			return queryValidatorList(ctx, cdc, path[1:], req, vm)
+		case QueryCommission:
+			return queryCommission(ctx, cdc, path[1:], req, vm)
+		case QueryBackings:
+			return queryBackings(ctx, cdc, path[1:], req, vm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown validator query endpoint")
 		}
@@ -63,3 +69,33 @@ func queryValidatorList(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryCommission(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	commission, err := vm.storage.GetCommission(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(commission)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryBackings(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	backings, err := vm.storage.GetBackings(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(backings)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -3,12 +3,15 @@ package validator
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// BeginBlocker - execute before every block, update signing info and record validator set
+// BeginBlocker - execute before every block, update signing info and record validator set,
+// return penalty of fired validators and their released backings.
 func BeginBlocker(
-	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager) (panelty types.Coin) {
+	ctx sdk.Context, req abci.RequestBeginBlock,
+	vm ValidatorManager) (panelty types.Coin, released []model.Backing) {
 	// update preblock validators
 	validatorList, err := vm.GetValidatorList(ctx)
 	if err != nil {
@@ -25,6 +28,6 @@ func BeginBlocker(
 		panic(updateErr)
 	}
 
-	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	panelty, released, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
 	return
 }
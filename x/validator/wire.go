@@ -9,6 +9,9 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ValidatorDepositMsg{}, "lino/valDeposit", nil)
 	cdc.RegisterConcrete(ValidatorWithdrawMsg{}, "lino/valWithdraw", nil)
 	cdc.RegisterConcrete(ValidatorRevokeMsg{}, "lino/valRevoke", nil)
+	cdc.RegisterConcrete(ValidatorSetCommissionMsg{}, "lino/valSetCommission", nil)
+	cdc.RegisterConcrete(ValidatorBackMsg{}, "lino/valBack", nil)
+	cdc.RegisterConcrete(ValidatorUnbackMsg{}, "lino/valUnback", nil)
 }
 
 var msgCdc = wire.New()
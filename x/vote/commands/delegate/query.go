@@ -6,12 +6,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetDelegationCmd returns the delegator's delegation
@@ -27,6 +29,22 @@ func GetDelegationCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetDelegateesCmd returns all delegations made by a delegator
+func GetDelegateesCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "delegatees <delegator>",
+		Short: "Query delegations made by a delegator",
+		RunE:  cmdr.getDelegateesCmd,
+	}
+	cmd.Flags().Int64(client.FlagPage, 1, "page number, starts from 1")
+	cmd.Flags().Int64(client.FlagLimit, 20, "maximum number of delegations per page")
+	return cmd
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -58,3 +76,82 @@ func (c commander) getDelegationCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getDelegateesCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide delegator name")
+	}
+	page := viper.GetInt64(client.FlagPage)
+	limit := viper.GetInt64(client.FlagLimit)
+	if page < 1 || limit < 1 {
+		return errors.New("page and limit must be positive")
+	}
+
+	delegator := types.AccountKey(args[0])
+	prefix := model.GetDelegateePrefix(delegator)
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+
+	infos := []model.DelegateeInfo{}
+	start := (page - 1) * limit
+	for i := start; i < int64(len(resKVs)) && i < start+limit; i++ {
+		var delegation model.Delegation
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(resKVs[i].Value, &delegation); err != nil {
+			return err
+		}
+		voterName := types.AccountKey(resKVs[i].Key[len(prefix):])
+		info := model.DelegateeInfo{
+			Voter:             voterName,
+			Amount:            delegation.Amount,
+			UnclaimedInterest: types.NewCoinFromInt64(0),
+			VoterInterest:     types.NewCoinFromInt64(0),
+			CommissionRate:    sdk.ZeroDec(),
+		}
+
+		res, err := ctx.Query(model.GetDelegationInterestKey(voterName, delegator), c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) != 0 {
+			interest := new(model.DelegationInterest)
+			if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, interest); err != nil {
+				return err
+			}
+			info.UnclaimedInterest = interest.Interest
+		}
+
+		res, err = ctx.Query(model.GetVoterKey(voterName), c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) != 0 {
+			voter := new(model.Voter)
+			if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, voter); err != nil {
+				return err
+			}
+			info.VoterInterest = voter.Interest
+		}
+
+		res, err = ctx.Query(model.GetVoterCommissionKey(voterName), c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) != 0 {
+			commission := new(model.VoterCommission)
+			if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, commission); err != nil {
+				return err
+			}
+			info.CommissionRate = commission.Rate
+			info.CommissionLastChangedAt = commission.LastChangedAt
+		}
+		infos = append(infos, info)
+	}
+
+	if err := client.PrintIndent(infos); err != nil {
+		return err
+	}
+	return nil
+}
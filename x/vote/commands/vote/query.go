@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
@@ -39,6 +40,38 @@ func GetVoteCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetVotesCmd returns votes cast on a proposal
+func GetVotesCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "votes <proposal-id>",
+		Short: "Query votes cast on a proposal",
+		RunE:  cmdr.getVotesCmd,
+	}
+	cmd.Flags().Int64(client.FlagPage, 1, "page number, starts from 1")
+	cmd.Flags().Int64(client.FlagLimit, 20, "maximum number of votes per page")
+	return cmd
+}
+
+// GetVoteHistoryCmd returns votes cast by a voter
+func GetVoteHistoryCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "vote-history <voter>",
+		Short: "Query votes cast by a voter",
+		RunE:  cmdr.getVoteHistoryCmd,
+	}
+	cmd.Flags().Int64(client.FlagPage, 1, "page number, starts from 1")
+	cmd.Flags().Int64(client.FlagLimit, 20, "maximum number of votes per page")
+	return cmd
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -97,3 +130,76 @@ func (c commander) getVoteCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getVotesCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide proposal ID")
+	}
+	page := viper.GetInt64(client.FlagPage)
+	limit := viper.GetInt64(client.FlagLimit)
+	if page < 1 || limit < 1 {
+		return errors.New("page and limit must be positive")
+	}
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetVotePrefix(types.ProposalKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+
+	votes := []model.Vote{}
+	start := (page - 1) * limit
+	for i := start; i < int64(len(resKVs)) && i < start+limit; i++ {
+		var vote model.Vote
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(resKVs[i].Value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+	}
+
+	if err := client.PrintIndent(votes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getVoteHistoryCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a voter name")
+	}
+	page := viper.GetInt64(client.FlagPage)
+	limit := viper.GetInt64(client.FlagLimit)
+	if page < 1 || limit < 1 {
+		return errors.New("page and limit must be positive")
+	}
+
+	voter := types.AccountKey(args[0])
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetVoterVotePrefix(voter), c.storeName)
+	if err != nil {
+		return err
+	}
+
+	records := []model.VoteRecord{}
+	start := (page - 1) * limit
+	for i := start; i < int64(len(resKVs)) && i < start+limit; i++ {
+		proposalID := types.ProposalKey(resKVs[i].Value)
+		res, err := ctx.Query(model.GetVoteKey(proposalID, voter), c.storeName)
+		if err != nil {
+			return err
+		}
+		var vote model.Vote
+		if err := c.cdc.UnmarshalBinaryLengthPrefixed(res, &vote); err != nil {
+			return err
+		}
+		records = append(records, model.VoteRecord{
+			ProposalID: proposalID,
+			Vote:       vote,
+		})
+	}
+
+	if err := client.PrintIndent(records); err != nil {
+		return err
+	}
+	return nil
+}
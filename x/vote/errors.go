@@ -51,3 +51,48 @@ func ErrInvalidUsername() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeVoteQueryFailed, fmt.Sprintf("query vote store failed"))
 }
+
+// ErrRedelegateTooOften - error if delegator redelegates within redelegation interval
+func ErrRedelegateTooOften() sdk.Error {
+	return types.NewError(types.CodeRedelegateTooOften, fmt.Sprintf("redelegate too often"))
+}
+
+// ErrRedelegateDuringVoting - error if source voter has voted on an ongoing proposal
+func ErrRedelegateDuringVoting() sdk.Error {
+	return types.NewError(types.CodeRedelegateDuringVoting, fmt.Sprintf("source voter has voted on an ongoing proposal"))
+}
+
+// ErrRedelegateToSameVoter - error if delegator redelegates to the same voter
+func ErrRedelegateToSameVoter() sdk.Error {
+	return types.NewError(types.CodeRedelegateToSameVoter, fmt.Sprintf("can't redelegate to the same voter"))
+}
+
+// ErrInvalidCommissionRate - error if commission rate is invalid
+func ErrInvalidCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidCommissionRate, fmt.Sprintf("invalid commission rate"))
+}
+
+// ErrCommissionRateTooHigh - error if commission rate exceeds maximum commission rate
+func ErrCommissionRateTooHigh() sdk.Error {
+	return types.NewError(types.CodeCommissionRateTooHigh, fmt.Sprintf("commission rate exceeds maximum"))
+}
+
+// ErrCommissionChangeTooOften - error if voter changes commission rate more than once a day
+func ErrCommissionChangeTooOften() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooOften, fmt.Sprintf("commission rate can only be changed once a day"))
+}
+
+// ErrCommissionChangeTooMuch - error if commission rate change exceeds maximum change rate
+func ErrCommissionChangeTooMuch() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooMuch, fmt.Sprintf("commission rate change exceeds maximum"))
+}
+
+// ErrInvalidVoteWeight - error if weights of a vote are negative or don't sum up to vote weight base
+func ErrInvalidVoteWeight() sdk.Error {
+	return types.NewError(types.CodeInvalidVoteWeight, fmt.Sprintf("vote weights must be non-negative and sum up to %v", types.VoteWeightBase))
+}
+
+// ErrInvalidConviction - error if conviction of a vote exceeds maximum conviction
+func ErrInvalidConviction(maxConviction int64) sdk.Error {
+	return types.NewError(types.CodeInvalidConviction, fmt.Sprintf("conviction must be in [0, %v]", maxConviction))
+}
@@ -26,6 +26,12 @@ func NewHandler(vm VoteManager, am acc.AccountManager, gm *global.GlobalManager,
 			return handleDelegatorWithdrawMsg(ctx, vm, gm, am, rm, msg)
 		case ClaimInterestMsg:
 			return handleClaimInterestMsg(ctx, vm, gm, am, msg)
+		case RedelegateMsg:
+			return handleRedelegateMsg(ctx, vm, gm, am, msg)
+		case SetCommissionMsg:
+			return handleSetCommissionMsg(ctx, vm, gm, am, msg)
+		case ClaimDelegationInterestMsg:
+			return handleClaimDelegationInterestMsg(ctx, vm, gm, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vote msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -164,6 +170,30 @@ func handleDelegatorWithdrawMsg(
 	return sdk.Result{}
 }
 
+func handleRedelegateMsg(
+	ctx sdk.Context, vm VoteManager, gm *global.GlobalManager, am acc.AccountManager, msg RedelegateMsg) sdk.Result {
+	// Must have an normal acount
+	if !am.DoesAccountExist(ctx, msg.DstVoter) {
+		return ErrAccountNotFound().Result()
+	}
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if err := vm.CheckRedelegate(ctx, msg.SrcVoter, msg.Delegator, coin); err != nil {
+		return err.Result()
+	}
+	// settle interest with source voter's commission before moving delegation,
+	// delegator's lino stake is unchanged so no coin return needed
+	if err := calculateAndAddInterest(ctx, vm, gm, am, msg.Delegator); err != nil {
+		return err.Result()
+	}
+	if err := vm.Redelegate(ctx, msg.SrcVoter, msg.DstVoter, msg.Delegator, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm *global.GlobalManager, am acc.AccountManager, msg ClaimInterestMsg) sdk.Result {
 	if err := calculateAndAddInterest(ctx, vm, gm, am, msg.Username); err != nil {
 		return err.Result()
@@ -180,6 +210,49 @@ func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm *global.GlobalMa
 	return sdk.Result{}
 }
 
+func handleSetCommissionMsg(
+	ctx sdk.Context, vm VoteManager, gm *global.GlobalManager,
+	am acc.AccountManager, msg SetCommissionMsg) sdk.Result {
+	if !vm.DoesVoterExist(ctx, msg.Username) {
+		return ErrVoterNotFound().Result()
+	}
+	rate, err := sdk.NewDecFromStr(msg.CommissionRate)
+	if err != nil {
+		return ErrInvalidCommissionRate().Result()
+	}
+	// interest generated before the change is shared at the old commission rate
+	delegators, getErr := vm.GetAllDelegators(ctx, msg.Username)
+	if getErr != nil {
+		return getErr.Result()
+	}
+	for _, delegator := range delegators {
+		if err := calculateAndAddInterest(ctx, vm, gm, am, delegator); err != nil {
+			return err.Result()
+		}
+	}
+	if err := vm.SetCommissionRate(ctx, msg.Username, rate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleClaimDelegationInterestMsg(
+	ctx sdk.Context, vm VoteManager, gm *global.GlobalManager,
+	am acc.AccountManager, msg ClaimDelegationInterestMsg) sdk.Result {
+	if err := calculateAndAddInterest(ctx, vm, gm, am, msg.Delegator); err != nil {
+		return err.Result()
+	}
+	interest, err := vm.ClaimDelegationInterest(ctx, msg.Voter, msg.Delegator)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.AddSavingCoin(
+		ctx, msg.Delegator, interest, msg.Voter, "", types.ClaimInterest); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 func AddStake(
 	ctx sdk.Context, username types.AccountKey, stake types.Coin, vm VoteManager,
 	gm *global.GlobalManager, am acc.AccountManager, rm rep.ReputationManager) sdk.Error {
@@ -242,6 +315,12 @@ func calculateAndAddInterest(ctx sdk.Context, vm VoteManager, gm *global.GlobalM
 		return err
 	}
 
+	// interest generated by delegations is shared with voters
+	interest, err = vm.SettleDelegationInterest(ctx, name, interest)
+	if err != nil {
+		return err
+	}
+
 	if err := vm.AddInterest(ctx, name, interest); err != nil {
 		return err
 	}
@@ -2,13 +2,16 @@ package vote
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	"github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestVoterDepositBasic(t *testing.T) {
@@ -304,6 +307,103 @@ func TestDelegatorWithdraw(t *testing.T) {
 	}
 }
 
+func TestRedelegate(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+	minBalance := types.NewCoinFromInt64(2000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	handler := NewHandler(vm, am, &gm, rm)
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+	delegatedCoin := param.MinStakeIn
+	redelegatedCoin := types.NewCoinFromInt64(400 * types.Decimals)
+
+	vm.AddVoter(ctx, user1, param.MinStakeIn)
+	vm.AddVoter(ctx, user2, param.MinStakeIn)
+	res := handler(ctx, NewDelegateMsg(string(user3), string(user1), coinToString(delegatedCoin)))
+	assert.Equal(t, sdk.Result{}, res)
+	saving, _ := am.GetSavingFromBank(ctx, user3)
+
+	testCases := []struct {
+		testName             string
+		msg                  RedelegateMsg
+		atWhen               time.Time
+		expectedResult       sdk.Result
+		expectedSrcDelegated types.Coin
+		expectedDstDelegated types.Coin
+	}{
+		{
+			testName:             "destination voter doesn't exist",
+			msg:                  NewRedelegateMsg(string(user3), string(user1), "user4", coinToString(redelegatedCoin)),
+			atWhen:               time.Unix(1000, 0),
+			expectedResult:       ErrAccountNotFound().Result(),
+			expectedSrcDelegated: delegatedCoin,
+			expectedDstDelegated: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:             "can't redelegate more than delegation",
+			msg:                  NewRedelegateMsg(string(user3), string(user1), string(user2), coinToString(delegatedCoin.Plus(redelegatedCoin))),
+			atWhen:               time.Unix(1000, 0),
+			expectedResult:       ErrIllegalWithdraw().Result(),
+			expectedSrcDelegated: delegatedCoin,
+			expectedDstDelegated: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:             "normal redelegate",
+			msg:                  NewRedelegateMsg(string(user3), string(user1), string(user2), coinToString(redelegatedCoin)),
+			atWhen:               time.Unix(1000, 0),
+			expectedResult:       sdk.Result{},
+			expectedSrcDelegated: delegatedCoin.Minus(redelegatedCoin),
+			expectedDstDelegated: redelegatedCoin,
+		},
+		{
+			testName:             "redelegate too often",
+			msg:                  NewRedelegateMsg(string(user3), string(user1), string(user2), coinToString(redelegatedCoin)),
+			atWhen:               time.Unix(1000+param.RedelegationIntervalSec-1, 0),
+			expectedResult:       ErrRedelegateTooOften().Result(),
+			expectedSrcDelegated: delegatedCoin.Minus(redelegatedCoin),
+			expectedDstDelegated: redelegatedCoin,
+		},
+		{
+			testName:             "redelegate all remaining delegation after interval",
+			msg:                  NewRedelegateMsg(string(user3), string(user1), string(user2), coinToString(delegatedCoin.Minus(redelegatedCoin))),
+			atWhen:               time.Unix(1000+param.RedelegationIntervalSec, 0),
+			expectedResult:       sdk.Result{},
+			expectedSrcDelegated: types.NewCoinFromInt64(0),
+			expectedDstDelegated: delegatedCoin,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: tc.atWhen})
+		res := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectedResult, res) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.expectedResult)
+		}
+		srcVotingPower, _ := vm.GetVotingPower(ctx, user1)
+		if !srcVotingPower.IsEqual(param.MinStakeIn.Plus(tc.expectedSrcDelegated)) {
+			t.Errorf("%s: diff source voting power, got %v, want %v",
+				tc.testName, srcVotingPower, param.MinStakeIn.Plus(tc.expectedSrcDelegated))
+		}
+		dstVotingPower, _ := vm.GetVotingPower(ctx, user2)
+		if !dstVotingPower.IsEqual(param.MinStakeIn.Plus(tc.expectedDstDelegated)) {
+			t.Errorf("%s: diff destination voting power, got %v, want %v",
+				tc.testName, dstVotingPower, param.MinStakeIn.Plus(tc.expectedDstDelegated))
+		}
+		// delegator's own stake and saving are untouched
+		delegator, _ := vm.storage.GetVoter(ctx, user3)
+		assert.True(t, delegator.LinoStake.IsEqual(delegatedCoin))
+		assert.True(t, delegator.DelegateToOthers.IsEqual(delegatedCoin))
+		delegatorSaving, _ := am.GetSavingFromBank(ctx, user3)
+		assert.True(t, delegatorSaving.IsEqual(saving))
+	}
+	assert.False(t, vm.DoesDelegationExist(ctx, user1, user3))
+	delegation, err := vm.storage.GetDelegation(ctx, user2, user3)
+	assert.Nil(t, err)
+	assert.True(t, delegation.Amount.IsEqual(delegatedCoin))
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, vm, gm, _ := setupTest(t, 0)
 	vm.InitGenesis(ctx)
@@ -393,3 +493,44 @@ func TestDeleteVoteBasic(t *testing.T) {
 	_, err := vm.storage.GetVote(ctx, proposalID1, "user2")
 	assert.Equal(t, model.ErrVoteNotFound(), err)
 }
+
+func TestSetCommissionSettlesDelegationInterest(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	vm.InitGenesis(ctx)
+	handler := NewHandler(vm, am, &gm, rm)
+
+	c1000 := types.NewCoinFromInt64(1000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", c1000)
+	user2 := createTestAccount(ctx, am, "user2", c1000)
+
+	result := handler(ctx, NewStakeInMsg("user1", coinToString(c1000)))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewDelegateMsg("user2", "user1", coinToString(c1000)))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewSetCommissionMsg("user1", "0.01"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// friction of the first day is shared by user1 and user2 equally
+	err := gm.AddFrictionAndRegisterContentRewardEvent(
+		ctx, acc.ReturnCoinEvent{}, types.NewCoinFromInt64(100*types.Decimals),
+		types.NewCoinFromInt64(100*types.Decimals))
+	assert.Nil(t, err)
+
+	// commission changes in the next day, interest of the first day is shared at old rate
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(types.CommissionChangeIntervalSec, 0)})
+	result = handler(ctx, NewSetCommissionMsg("user1", "0.02"))
+	assert.Equal(t, sdk.Result{}, result)
+	rate, err := vm.GetCommissionRate(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewDecFromRat(2, 100), rate)
+
+	voter, err := vm.storage.GetVoter(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, types.NewCoinFromInt64(50*types.Decimals/100).IsEqual(voter.Interest))
+
+	result = handler(ctx, NewClaimDelegationInterestMsg("user2", "user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, err := am.GetSavingFromBank(ctx, user2)
+	assert.Nil(t, err)
+	assert.True(t, types.NewCoinFromInt64(4950*types.Decimals/100).IsEqual(saving))
+}
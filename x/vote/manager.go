@@ -58,7 +58,7 @@ func (vm VoteManager) IsInValidatorList(ctx sdk.Context, username types.AccountK
 	return false
 }
 
-// IsLegalVoterWithdraw - check withdraw voter is not validator
+// IsLegalVoterWithdraw - check withdraw voter is not validator and stake is not locked by conviction votes
 func (vm VoteManager) IsLegalVoterWithdraw(
 	ctx sdk.Context, username types.AccountKey, coin types.Coin) bool {
 	voter, err := vm.storage.GetVoter(ctx, username)
@@ -70,7 +70,13 @@ func (vm VoteManager) IsLegalVoterWithdraw(
 		return false
 	}
 
-	availableStakes := voter.LinoStake.Minus(voter.DelegateToOthers)
+	// reject if stake is locked by conviction votes
+	locked, err := vm.GetLockedStake(ctx, username)
+	if err != nil {
+		return false
+	}
+
+	availableStakes := voter.LinoStake.Minus(voter.DelegateToOthers).Minus(locked)
 	//reject if the remaining coins are not enough
 	return availableStakes.IsGTE(coin) && coin.IsPositive()
 }
@@ -101,22 +107,55 @@ func (vm VoteManager) CanBecomeValidator(ctx sdk.Context, username types.Account
 	return voter.LinoStake.IsGTE(param.ValidatorMinVotingDeposit)
 }
 
-// AddVote - voter vote for a proposal
+// AddVote - voter vote for a proposal, previous vote of the voter is overwritten
 func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) sdk.Error {
-	// check if the vote exist
-	if vm.DoesVoteExist(ctx, proposalID, voter) {
-		return ErrVoteAlreadyExist()
+	if res {
+		return vm.AddSplitVote(ctx, proposalID, voter, types.VoteWeightBase, 0, 0, 0)
+	}
+	return vm.AddSplitVote(ctx, proposalID, voter, 0, types.VoteWeightBase, 0, 0)
+}
+
+// AddSplitVote - voter split voting power across yes, no and abstain,
+// previous vote of the voter is overwritten
+func (vm VoteManager) AddSplitVote(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey,
+	yesWeight, noWeight, abstainWeight, conviction int64) sdk.Error {
+	if yesWeight < 0 || noWeight < 0 || abstainWeight < 0 ||
+		yesWeight+noWeight+abstainWeight != types.VoteWeightBase {
+		return ErrInvalidVoteWeight()
+	}
+
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	if conviction < 0 || conviction > param.MaxConviction {
+		return ErrInvalidConviction(param.MaxConviction)
 	}
 
 	votingPower, err := vm.GetVotingPower(ctx, voter)
 	if err != nil {
 		return err
 	}
+	// only voter's own stake is locked by conviction, delegated power is not amplified
+	lockedPower := types.NewCoinFromInt64(0)
+	if conviction > 0 {
+		voterInfo, err := vm.storage.GetVoter(ctx, voter)
+		if err != nil {
+			return err
+		}
+		lockedPower = voterInfo.LinoStake.Minus(voterInfo.DelegateToOthers)
+	}
 
 	vote := model.Vote{
-		Voter:       voter,
-		Result:      res,
-		VotingPower: votingPower,
+		Voter:         voter,
+		Result:        yesWeight > noWeight,
+		VotingPower:   votingPower,
+		YesWeight:     yesWeight,
+		NoWeight:      noWeight,
+		AbstainWeight: abstainWeight,
+		Conviction:    conviction,
+		LockedPower:   lockedPower,
 	}
 
 	if err := vm.storage.SetVote(ctx, proposalID, voter, &vote); err != nil {
@@ -125,6 +164,72 @@ func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	return nil
 }
 
+// LockStakeForConviction - lock voter's own stake until proposal ends plus lock period of conviction,
+// lock period of conviction 1 is doubled by each conviction level. Expired locks are removed.
+func (vm VoteManager) LockStakeForConviction(
+	ctx sdk.Context, voterName types.AccountKey, conviction int64, proposalEndAt int64) sdk.Error {
+	if conviction <= 0 {
+		return nil
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return err
+	}
+	locks, err := vm.storage.GetConvictionLocks(ctx, voterName)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	// stake delegated to others is not part of voter's own voting power
+	activeLocks := []model.ConvictionLock{{
+		Amount:   voter.LinoStake.Minus(voter.DelegateToOthers),
+		UnlockAt: proposalEndAt + param.ConvictionLockPeriodSec<<uint(conviction-1),
+	}}
+	for _, lock := range locks.Locks {
+		if lock.UnlockAt > now {
+			activeLocks = append(activeLocks, lock)
+		}
+	}
+	locks.Locks = activeLocks
+	return vm.storage.SetConvictionLocks(ctx, voterName, locks)
+}
+
+// RecordVotedProposalEnd - record end time of proposal voter has voted on,
+// redelegation from the voter is rejected until all voted proposals end.
+func (vm VoteManager) RecordVotedProposalEnd(
+	ctx sdk.Context, voterName types.AccountKey, proposalEndAt int64) sdk.Error {
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return err
+	}
+	if voter.LatestVotedProposalEndAt >= proposalEndAt {
+		return nil
+	}
+	voter.LatestVotedProposalEndAt = proposalEndAt
+	return vm.storage.SetVoter(ctx, voterName, voter)
+}
+
+// GetLockedStake - get voter's stake locked by conviction votes, overlapping locks don't stack
+func (vm VoteManager) GetLockedStake(ctx sdk.Context, voterName types.AccountKey) (types.Coin, sdk.Error) {
+	locks, err := vm.storage.GetConvictionLocks(ctx, voterName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	locked := types.NewCoinFromInt64(0)
+	for _, lock := range locks.Locks {
+		if lock.UnlockAt > now && lock.Amount.IsGT(locked) {
+			locked = lock.Amount
+		}
+	}
+	return locked, nil
+}
+
 // GetVote - get vote detail based on voter and proposal ID
 func (vm VoteManager) GetVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) (*model.Vote, sdk.Error) {
 	return vm.storage.GetVote(ctx, proposalID, voter)
@@ -270,6 +375,109 @@ func (vm VoteManager) DelegatorWithdraw(
 	return nil
 }
 
+// CheckRedelegate - check if delegator can redelegate coin from source voter
+func (vm VoteManager) CheckRedelegate(
+	ctx sdk.Context, srcVoterName types.AccountKey, delegatorName types.AccountKey, coin types.Coin) sdk.Error {
+	if !vm.IsLegalDelegatorWithdraw(ctx, srcVoterName, delegatorName, coin) {
+		return ErrIllegalWithdraw()
+	}
+	delegator, err := vm.storage.GetVoter(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	// rate limit redelegation, otherwise same stake could vote on one proposal through many voters
+	if delegator.LastRedelegatedAt != 0 &&
+		delegator.LastRedelegatedAt+param.RedelegationIntervalSec > ctx.BlockHeader().Time.Unix() {
+		return ErrRedelegateTooOften()
+	}
+	// delegated power is counted in source voter's votes until proposals end
+	srcVoter, err := vm.storage.GetVoter(ctx, srcVoterName)
+	if err != nil {
+		return err
+	}
+	if srcVoter.LatestVotedProposalEndAt > ctx.BlockHeader().Time.Unix() {
+		return ErrRedelegateDuringVoting()
+	}
+	return nil
+}
+
+// Redelegate - move delegation from source voter to destination voter,
+// delegator's stake and delegate to others are unchanged.
+func (vm VoteManager) Redelegate(
+	ctx sdk.Context, srcVoterName, dstVoterName, delegatorName types.AccountKey, coin types.Coin) sdk.Error {
+	if coin.IsZero() {
+		return ErrInvalidCoin()
+	}
+	// change source voter's delegated power and delegation
+	srcVoter, err := vm.storage.GetVoter(ctx, srcVoterName)
+	if err != nil {
+		return err
+	}
+	srcVoter.DelegatedPower = srcVoter.DelegatedPower.Minus(coin)
+	if err := vm.storage.SetVoter(ctx, srcVoterName, srcVoter); err != nil {
+		return err
+	}
+	srcDelegation, err := vm.storage.GetDelegation(ctx, srcVoterName, delegatorName)
+	if err != nil {
+		return err
+	}
+	srcDelegation.Amount = srcDelegation.Amount.Minus(coin)
+	if srcDelegation.Amount.IsZero() {
+		if err := vm.storage.DeleteDelegation(ctx, srcVoterName, delegatorName); err != nil {
+			return err
+		}
+	} else {
+		if err := vm.storage.SetDelegation(ctx, srcVoterName, delegatorName, srcDelegation); err != nil {
+			return err
+		}
+	}
+
+	// add voter if not exist
+	if !vm.DoesVoterExist(ctx, dstVoterName) {
+		if err := vm.AddVoter(ctx, dstVoterName, types.NewCoinFromInt64(0)); err != nil {
+			return err
+		}
+	}
+	// change destination voter's delegated power and delegation
+	dstVoter, err := vm.storage.GetVoter(ctx, dstVoterName)
+	if err != nil {
+		return err
+	}
+	dstVoter.DelegatedPower = dstVoter.DelegatedPower.Plus(coin)
+	if err := vm.storage.SetVoter(ctx, dstVoterName, dstVoter); err != nil {
+		return err
+	}
+	dstDelegation := &model.Delegation{
+		Delegator: delegatorName,
+		Amount:    types.NewCoinFromInt64(0),
+	}
+	if vm.DoesDelegationExist(ctx, dstVoterName, delegatorName) {
+		dstDelegation, err = vm.storage.GetDelegation(ctx, dstVoterName, delegatorName)
+		if err != nil {
+			return err
+		}
+	}
+	dstDelegation.Amount = dstDelegation.Amount.Plus(coin)
+	if err := vm.storage.SetDelegation(ctx, dstVoterName, delegatorName, dstDelegation); err != nil {
+		return err
+	}
+
+	// record redelegation time for rate limit
+	delegator, err := vm.storage.GetVoter(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+	delegator.LastRedelegatedAt = ctx.BlockHeader().Time.Unix()
+	if err := vm.storage.SetVoter(ctx, delegatorName, delegator); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ClaimInterest - add lino power interst to user balance
 func (vm VoteManager) ClaimInterest(
 	ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
@@ -299,6 +507,216 @@ func (vm VoteManager) AddInterest(
 	return nil
 }
 
+// GetCommissionRate - get commission rate of voter, zero if voter never set commission
+func (vm VoteManager) GetCommissionRate(ctx sdk.Context, voterName types.AccountKey) (sdk.Dec, sdk.Error) {
+	if !vm.storage.DoesVoterCommissionExist(ctx, voterName) {
+		return sdk.ZeroDec(), nil
+	}
+	commission, err := vm.storage.GetVoterCommission(ctx, voterName)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return commission.Rate, nil
+}
+
+// SetCommissionRate - change commission rate of voter. Rate can't exceed maximum
+// commission rate, and can only be changed once a day within maximum change rate.
+func (vm VoteManager) SetCommissionRate(ctx sdk.Context, voterName types.AccountKey, rate sdk.Dec) sdk.Error {
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	if rate.GT(param.MaxCommissionRate) {
+		return ErrCommissionRateTooHigh()
+	}
+	commission := &model.VoterCommission{
+		Rate:          sdk.ZeroDec(),
+		LastChangedAt: 0,
+	}
+	if vm.storage.DoesVoterCommissionExist(ctx, voterName) {
+		commission, err = vm.storage.GetVoterCommission(ctx, voterName)
+		if err != nil {
+			return err
+		}
+		if commission.LastChangedAt+types.CommissionChangeIntervalSec > ctx.BlockHeader().Time.Unix() {
+			return ErrCommissionChangeTooOften()
+		}
+	}
+	if rate.GT(commission.Rate.Add(param.MaxCommissionChangeRate)) ||
+		rate.LT(commission.Rate.Sub(param.MaxCommissionChangeRate)) {
+		return ErrCommissionChangeTooMuch()
+	}
+	commission.Rate = rate
+	commission.LastChangedAt = ctx.BlockHeader().Time.Unix()
+	return vm.storage.SetVoterCommission(ctx, voterName, commission)
+}
+
+// SettleDelegationInterest - split interest generated by delegator's lino stake.
+// Part generated by delegations is shared with voters by their commission rate,
+// the rest of it is kept in delegation interest for delegator to claim.
+// Return interest generated by delegator's own stake.
+func (vm VoteManager) SettleDelegationInterest(
+	ctx sdk.Context, delegatorName types.AccountKey, interest types.Coin) (types.Coin, sdk.Error) {
+	delegator, err := vm.storage.GetVoter(ctx, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if !interest.IsPositive() || !delegator.DelegateToOthers.IsPositive() ||
+		!delegator.LinoStake.IsPositive() {
+		return interest, nil
+	}
+	voters, err := vm.storage.GetAllDelegatees(ctx, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	ownInterest := interest
+	for _, voterName := range voters {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		share := types.DecToCoin(
+			interest.ToDec().Mul(delegation.Amount.ToDec()).Quo(delegator.LinoStake.ToDec()))
+		rate, err := vm.GetCommissionRate(ctx, voterName)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		commission := types.DecToCoin(share.ToDec().Mul(rate))
+		if err := vm.AddInterest(ctx, voterName, commission); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		if err := vm.addDelegationInterest(
+			ctx, voterName, delegatorName, share.Minus(commission)); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		ownInterest = ownInterest.Minus(share)
+	}
+	return ownInterest, nil
+}
+
+func (vm VoteManager) addDelegationInterest(
+	ctx sdk.Context, voterName, delegatorName types.AccountKey, interest types.Coin) sdk.Error {
+	delegationInterest := &model.DelegationInterest{
+		Delegator: delegatorName,
+		Interest:  types.NewCoinFromInt64(0),
+	}
+	if vm.storage.DoesDelegationInterestExist(ctx, voterName, delegatorName) {
+		var err sdk.Error
+		delegationInterest, err = vm.storage.GetDelegationInterest(ctx, voterName, delegatorName)
+		if err != nil {
+			return err
+		}
+	}
+	delegationInterest.Interest = delegationInterest.Interest.Plus(interest)
+	return vm.storage.SetDelegationInterest(ctx, voterName, delegatorName, delegationInterest)
+}
+
+// ClaimDelegationInterest - claim interest generated by delegation to voter
+func (vm VoteManager) ClaimDelegationInterest(
+	ctx sdk.Context, voterName, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	if !vm.storage.DoesDelegationInterestExist(ctx, voterName, delegatorName) {
+		return types.NewCoinFromInt64(0), nil
+	}
+	delegationInterest, err := vm.storage.GetDelegationInterest(ctx, voterName, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := vm.storage.DeleteDelegationInterest(ctx, voterName, delegatorName); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return delegationInterest.Interest, nil
+}
+
+// GetDelegateeInfos - get delegations made by delegator with voter's interest and commission,
+// page starts from 1 and each page has at most limit delegations.
+func (vm VoteManager) GetDelegateeInfos(
+	ctx sdk.Context, delegatorName types.AccountKey, page, limit int64) ([]model.DelegateeInfo, sdk.Error) {
+	rows, err := vm.storage.GetDelegateesWithPagination(ctx, delegatorName, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+	infos := []model.DelegateeInfo{}
+	for _, row := range rows {
+		info := model.DelegateeInfo{
+			Voter:             row.Voter,
+			Amount:            row.Delegation.Amount,
+			UnclaimedInterest: types.NewCoinFromInt64(0),
+			VoterInterest:     types.NewCoinFromInt64(0),
+			CommissionRate:    sdk.ZeroDec(),
+		}
+		if vm.storage.DoesDelegationInterestExist(ctx, row.Voter, delegatorName) {
+			interest, err := vm.storage.GetDelegationInterest(ctx, row.Voter, delegatorName)
+			if err != nil {
+				return nil, err
+			}
+			info.UnclaimedInterest = interest.Interest
+		}
+		if vm.DoesVoterExist(ctx, row.Voter) {
+			voter, err := vm.storage.GetVoter(ctx, row.Voter)
+			if err != nil {
+				return nil, err
+			}
+			info.VoterInterest = voter.Interest
+		}
+		if vm.storage.DoesVoterCommissionExist(ctx, row.Voter) {
+			commission, err := vm.storage.GetVoterCommission(ctx, row.Voter)
+			if err != nil {
+				return nil, err
+			}
+			info.CommissionRate = commission.Rate
+			info.CommissionLastChangedAt = commission.LastChangedAt
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// GetUnclaimedInterest - get unclaimed interest of voter's own stake and delegations made by voter
+func (vm VoteManager) GetUnclaimedInterest(ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
+	voter, err := vm.storage.GetVoter(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	delegationInterest, err := vm.GetUnclaimedDelegationInterest(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return voter.Interest.Plus(delegationInterest), nil
+}
+
+// GetUnclaimedDelegationInterest - get total unclaimed interest of delegations made by delegator
+func (vm VoteManager) GetUnclaimedDelegationInterest(
+	ctx sdk.Context, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	voters, err := vm.storage.GetAllDelegatees(ctx, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	total := types.NewCoinFromInt64(0)
+	for _, voterName := range voters {
+		if !vm.storage.DoesDelegationInterestExist(ctx, voterName, delegatorName) {
+			continue
+		}
+		interest, err := vm.storage.GetDelegationInterest(ctx, voterName, delegatorName)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		total = total.Plus(interest.Interest)
+	}
+	return total, nil
+}
+
+// GetVotes - get votes cast on a proposal, page starts from 1 and each page has at most limit votes.
+func (vm VoteManager) GetVotes(
+	ctx sdk.Context, proposalID types.ProposalKey, page, limit int64) ([]model.Vote, sdk.Error) {
+	return vm.storage.GetVotesWithPagination(ctx, proposalID, (page-1)*limit, limit)
+}
+
+// GetVoteHistory - get votes cast by a voter, page starts from 1 and each page has at most limit votes.
+func (vm VoteManager) GetVoteHistory(
+	ctx sdk.Context, voter types.AccountKey, page, limit int64) ([]model.VoteRecord, sdk.Error) {
+	return vm.storage.GetVoterVotesWithPagination(ctx, voter, (page-1)*limit, limit)
+}
+
 // GetVotingPower - get voter voting power
 func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, voterName)
@@ -324,7 +742,7 @@ func (vm VoteManager) GetPenaltyList(
 	}
 
 	for _, vote := range votes {
-		// remove from list if the validator voted
+		// remove from list if the validator voted, abstain also counts as participation
 		for idx, validator := range oncallValidators {
 			if validator == vote.Voter {
 				oncallValidators = append(oncallValidators[:idx], oncallValidators[idx+1:]...)
@@ -2,12 +2,14 @@ package vote
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestAddVoter(t *testing.T) {
@@ -122,6 +124,258 @@ func TestAddAndClaimInterest(t *testing.T) {
 
 }
 
+func TestSetCommissionRate(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	user1 := createTestAccount(ctx, am, "user1", c100)
+	err := vm.AddVoter(ctx, user1, c100)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		rate         sdk.Dec
+		atWhen       time.Time
+		expectedErr  sdk.Error
+		expectedRate sdk.Dec
+	}{
+		{
+			testName:     "commission rate exceeds maximum",
+			rate:         types.NewDecFromRat(21, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  ErrCommissionRateTooHigh(),
+			expectedRate: sdk.ZeroDec(),
+		},
+		{
+			testName:     "change commission rate too much from zero",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  ErrCommissionChangeTooMuch(),
+			expectedRate: sdk.ZeroDec(),
+		},
+		{
+			testName:     "set commission rate",
+			rate:         types.NewDecFromRat(1, 100),
+			atWhen:       time.Unix(1000, 0),
+			expectedErr:  nil,
+			expectedRate: types.NewDecFromRat(1, 100),
+		},
+		{
+			testName:     "change commission rate within one day",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000+types.CommissionChangeIntervalSec-1, 0),
+			expectedErr:  ErrCommissionChangeTooOften(),
+			expectedRate: types.NewDecFromRat(1, 100),
+		},
+		{
+			testName:     "change commission rate too much after one day",
+			rate:         types.NewDecFromRat(3, 100),
+			atWhen:       time.Unix(1000+types.CommissionChangeIntervalSec, 0),
+			expectedErr:  ErrCommissionChangeTooMuch(),
+			expectedRate: types.NewDecFromRat(1, 100),
+		},
+		{
+			testName:     "change commission rate after one day",
+			rate:         types.NewDecFromRat(2, 100),
+			atWhen:       time.Unix(1000+types.CommissionChangeIntervalSec, 0),
+			expectedErr:  nil,
+			expectedRate: types.NewDecFromRat(2, 100),
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: tc.atWhen})
+		err := vm.SetCommissionRate(ctx, user1, tc.rate)
+		if !assert.Equal(t, tc.expectedErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectedErr)
+		}
+		rate, err := vm.GetCommissionRate(ctx, user1)
+		assert.Nil(t, err)
+		if !rate.Equal(tc.expectedRate) {
+			t.Errorf("%s: diff rate, got %v, want %v", tc.testName, rate, tc.expectedRate)
+		}
+	}
+}
+
+func TestSettleDelegationInterest(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	voter := createTestAccount(ctx, am, "voter", c100)
+	delegator := createTestAccount(ctx, am, "delegator", c100)
+	err := vm.AddVoter(ctx, voter, c100)
+	assert.Nil(t, err)
+	err = vm.AddVoter(ctx, delegator, c100)
+	assert.Nil(t, err)
+
+	// delegator without delegation keeps all interest
+	ownInterest, err := vm.SettleDelegationInterest(ctx, delegator, c100)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, ownInterest)
+
+	// delegate half of stake to voter with 10% commission
+	err = vm.AddLinoStake(ctx, delegator, c100)
+	assert.Nil(t, err)
+	err = vm.AddDelegation(ctx, voter, delegator, c100)
+	assert.Nil(t, err)
+	err = vm.storage.SetVoterCommission(ctx, voter, &model.VoterCommission{
+		Rate: types.NewDecFromRat(10, 100),
+	})
+	assert.Nil(t, err)
+
+	ownInterest, err = vm.SettleDelegationInterest(ctx, delegator, c100)
+	assert.Nil(t, err)
+	assert.True(t, types.NewCoinFromInt64(50*types.Decimals).IsEqual(ownInterest))
+
+	voterInfo, err := vm.storage.GetVoter(ctx, voter)
+	assert.Nil(t, err)
+	assert.True(t, types.NewCoinFromInt64(5*types.Decimals).IsEqual(voterInfo.Interest))
+
+	interest, err := vm.ClaimDelegationInterest(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.True(t, types.NewCoinFromInt64(45*types.Decimals).IsEqual(interest))
+
+	interest, err = vm.ClaimDelegationInterest(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.True(t, interest.IsZero())
+}
+
+func TestGetDelegateeInfos(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	delegator := createTestAccount(ctx, am, "delegator", c100)
+	err := vm.AddVoter(ctx, delegator, c500)
+	assert.Nil(t, err)
+	voters := []types.AccountKey{"voter1", "voter2", "voter3"}
+	for _, voter := range voters {
+		err := vm.AddDelegation(ctx, voter, delegator, c100)
+		assert.Nil(t, err)
+	}
+	err = vm.storage.SetVoterCommission(ctx, "voter2", &model.VoterCommission{
+		Rate:          types.NewDecFromRat(1, 100),
+		LastChangedAt: 100,
+	})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName       string
+		page           int64
+		limit          int64
+		expectedVoters []types.AccountKey
+	}{
+		{
+			testName:       "first page",
+			page:           1,
+			limit:          2,
+			expectedVoters: []types.AccountKey{"voter1", "voter2"},
+		},
+		{
+			testName:       "second page",
+			page:           2,
+			limit:          2,
+			expectedVoters: []types.AccountKey{"voter3"},
+		},
+		{
+			testName:       "page out of range",
+			page:           3,
+			limit:          2,
+			expectedVoters: []types.AccountKey{},
+		},
+		{
+			testName:       "all in one page",
+			page:           1,
+			limit:          10,
+			expectedVoters: voters,
+		},
+	}
+
+	for _, tc := range testCases {
+		infos, err := vm.GetDelegateeInfos(ctx, delegator, tc.page, tc.limit)
+		assert.Nil(t, err)
+		gotVoters := []types.AccountKey{}
+		for _, info := range infos {
+			gotVoters = append(gotVoters, info.Voter)
+			assert.True(t, c100.IsEqual(info.Amount), tc.testName)
+			if info.Voter == "voter2" {
+				assert.True(t, info.CommissionRate.Equal(types.NewDecFromRat(1, 100)), tc.testName)
+				assert.Equal(t, int64(100), info.CommissionLastChangedAt, tc.testName)
+			} else {
+				assert.True(t, info.CommissionRate.IsZero(), tc.testName)
+			}
+		}
+		if !assert.Equal(t, tc.expectedVoters, gotVoters) {
+			t.Errorf("%s: diff voters, got %v, want %v", tc.testName, gotVoters, tc.expectedVoters)
+		}
+	}
+}
+
+func TestGetVotesAndVoteHistory(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	voters := []types.AccountKey{}
+	for _, name := range []string{"user1", "user2", "user3"} {
+		voter := createTestAccount(ctx, am, name, c100)
+		err := vm.AddVoter(ctx, voter, c500)
+		assert.Nil(t, err)
+		voters = append(voters, voter)
+	}
+	for _, voter := range voters {
+		err := vm.AddVote(ctx, "1", voter, true)
+		assert.Nil(t, err)
+	}
+	for _, proposalID := range []types.ProposalKey{"2", "3"} {
+		err := vm.AddVote(ctx, proposalID, voters[0], true)
+		assert.Nil(t, err)
+	}
+	// change vote doesn't add duplicate history
+	err := vm.AddVote(ctx, "1", voters[0], false)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName            string
+		page                int64
+		limit               int64
+		expectedVoters      []types.AccountKey
+		expectedProposalIDs []types.ProposalKey
+	}{
+		{
+			testName:            "first page",
+			page:                1,
+			limit:               2,
+			expectedVoters:      []types.AccountKey{"user1", "user2"},
+			expectedProposalIDs: []types.ProposalKey{"1", "2"},
+		},
+		{
+			testName:            "second page",
+			page:                2,
+			limit:               2,
+			expectedVoters:      []types.AccountKey{"user3"},
+			expectedProposalIDs: []types.ProposalKey{"3"},
+		},
+		{
+			testName:            "page out of range",
+			page:                3,
+			limit:               2,
+			expectedVoters:      []types.AccountKey{},
+			expectedProposalIDs: []types.ProposalKey{},
+		},
+	}
+
+	for _, tc := range testCases {
+		votes, err := vm.GetVotes(ctx, "1", tc.page, tc.limit)
+		assert.Nil(t, err)
+		gotVoters := []types.AccountKey{}
+		for _, vote := range votes {
+			gotVoters = append(gotVoters, vote.Voter)
+		}
+		assert.Equal(t, tc.expectedVoters, gotVoters, tc.testName)
+
+		records, err := vm.GetVoteHistory(ctx, voters[0], tc.page, tc.limit)
+		assert.Nil(t, err)
+		gotProposalIDs := []types.ProposalKey{}
+		for _, record := range records {
+			gotProposalIDs = append(gotProposalIDs, record.ProposalID)
+			assert.Equal(t, voters[0], record.Vote.Voter, tc.testName)
+			assert.Equal(t, record.ProposalID != "1", record.Vote.Result, tc.testName)
+		}
+		assert.Equal(t, tc.expectedProposalIDs, gotProposalIDs, tc.testName)
+	}
+}
+
 func TestIsInValidatorList(t *testing.T) {
 	ctx, am, vm, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -224,6 +478,78 @@ func TestIsLegalVoterWithdraw(t *testing.T) {
 	}
 }
 
+func TestConvictionLock(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100*types.Decimals))
+	vm.AddVoter(ctx, user2, types.NewCoinFromInt64(100*types.Decimals))
+
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+	proposalEndAt := int64(1000)
+	if err := vm.LockStakeForConviction(ctx, user1, 2, proposalEndAt); err != nil {
+		t.Fatalf("failed to lock stake for conviction, got err %v", err)
+	}
+	if err := vm.LockStakeForConviction(ctx, user2, 0, proposalEndAt); err != nil {
+		t.Fatalf("failed to lock stake without conviction, got err %v", err)
+	}
+
+	testCases := []struct {
+		testName        string
+		username        types.AccountKey
+		atWhen          int64
+		expectLocked    types.Coin
+		expectWithdrawn bool
+	}{
+		{
+			testName:        "stake is locked before proposal ends",
+			username:        user1,
+			atWhen:          0,
+			expectLocked:    types.NewCoinFromInt64(100 * types.Decimals),
+			expectWithdrawn: false,
+		},
+		{
+			testName:        "stake is still locked after one lock period",
+			username:        user1,
+			atWhen:          proposalEndAt + param.ConvictionLockPeriodSec,
+			expectLocked:    types.NewCoinFromInt64(100 * types.Decimals),
+			expectWithdrawn: false,
+		},
+		{
+			testName:        "stake is unlocked after doubled lock period",
+			username:        user1,
+			atWhen:          proposalEndAt + 2*param.ConvictionLockPeriodSec,
+			expectLocked:    types.NewCoinFromInt64(0),
+			expectWithdrawn: true,
+		},
+		{
+			testName:        "vote without conviction doesn't lock stake",
+			username:        user2,
+			atWhen:          0,
+			expectLocked:    types.NewCoinFromInt64(0),
+			expectWithdrawn: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atWhen, 0)})
+		locked, err := vm.GetLockedStake(ctx, tc.username)
+		if err != nil {
+			t.Errorf("%s: failed to get locked stake, got err %v", tc.testName, err)
+		}
+		if !locked.IsEqual(tc.expectLocked) {
+			t.Errorf("%s: diff locked stake, got %v, want %v", tc.testName, locked, tc.expectLocked)
+		}
+		res := vm.IsLegalVoterWithdraw(ctx, tc.username, types.NewCoinFromInt64(1))
+		if res != tc.expectWithdrawn {
+			t.Errorf("%s: diff withdraw result, got %v, want %v", tc.testName, res, tc.expectWithdrawn)
+		}
+	}
+}
+
 func TestIsLegalDelegatorWithdraw(t *testing.T) {
 	ctx, am, vm, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -66,3 +66,43 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrVoterCommissionNotFound - error if voter commission is not found in KVStore
+func ErrVoterCommissionNotFound() sdk.Error {
+	return types.NewError(types.CodeVoterCommissionNotFound, fmt.Sprintf("voter commission not found"))
+}
+
+// ErrFailedToMarshalCommission - error if marshal voter commission failed
+func ErrFailedToMarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCommission, fmt.Sprintf("failed to marshal voter commission: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCommission - error if unmarshal voter commission failed
+func ErrFailedToUnmarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCommission, fmt.Sprintf("failed to unmarshal voter commission: %s", err.Error()))
+}
+
+// ErrDelegationInterestNotFound - error if delegation interest is not found in KVStore
+func ErrDelegationInterestNotFound() sdk.Error {
+	return types.NewError(types.CodeDelegationInterestNotFound, fmt.Sprintf("delegation interest not found"))
+}
+
+// ErrFailedToMarshalDelegationInterest - error if marshal delegation interest failed
+func ErrFailedToMarshalDelegationInterest(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalInterest, fmt.Sprintf("failed to marshal delegation interest: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalDelegationInterest - error if unmarshal delegation interest failed
+func ErrFailedToUnmarshalDelegationInterest(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalInterest, fmt.Sprintf("failed to unmarshal delegation interest: %s", err.Error()))
+}
+
+// ErrFailedToMarshalConvictionLocks - error if marshal conviction locks failed
+func ErrFailedToMarshalConvictionLocks(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalConviction, fmt.Sprintf("failed to marshal conviction locks: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalConvictionLocks - error if unmarshal conviction locks failed
+func ErrFailedToUnmarshalConvictionLocks(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalConviction, fmt.Sprintf("failed to unmarshal conviction locks: %s", err.Error()))
+}
@@ -17,6 +17,32 @@ type DelegationRow struct {
 	Delegation Delegation       `json:"delegation"`
 }
 
+// VoteRow - pk: (proposalID, voter)
+type VoteRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Voter      types.AccountKey  `json:"voter"`
+	Vote       Vote              `json:"vote"`
+}
+
+// VoterCommissionRow - pk: username
+type VoterCommissionRow struct {
+	Username   types.AccountKey `json:"username"`
+	Commission VoterCommission  `json:"commission"`
+}
+
+// DelegationInterestRow - pk: (voter, delegator)
+type DelegationInterestRow struct {
+	Voter     types.AccountKey   `json:"username"`
+	Delegator types.AccountKey   `json:"delegator"`
+	Interest  DelegationInterest `json:"interest"`
+}
+
+// ConvictionLocksRow - pk: username
+type ConvictionLocksRow struct {
+	Username types.AccountKey `json:"username"`
+	Locks    ConvictionLocks  `json:"locks"`
+}
+
 // ReferenceListTable - no pk
 type ReferenceListTable struct {
 	List ReferenceList `json:"list"`
@@ -24,9 +50,13 @@ type ReferenceListTable struct {
 
 // VoterTables - state of voter
 type VoterTables struct {
-	Voters        []VoterRow         `json:"voters"`
-	Delegations   []DelegationRow    `json:"delegations"`
-	ReferenceList ReferenceListTable `json:"reference_list"`
+	Voters              []VoterRow              `json:"voters"`
+	Delegations         []DelegationRow         `json:"delegations"`
+	Votes               []VoteRow               `json:"votes"`
+	ReferenceList       ReferenceListTable      `json:"reference_list"`
+	VoterCommissions    []VoterCommissionRow    `json:"voter_commissions"`
+	DelegationInterests []DelegationInterestRow `json:"delegation_interests"`
+	ConvictionLocks     []ConvictionLocksRow    `json:"conviction_locks"`
 }
 
 // ToIR - same
@@ -15,6 +15,10 @@ var (
 	voteSubstore          = []byte{0x02}
 	referenceListSubStore = []byte{0x03}
 	delegateeSubStore     = []byte{0x04}
+	commissionSubstore    = []byte{0x05}
+	interestSubstore      = []byte{0x06}
+	voterVoteSubstore     = []byte{0x07}
+	convictionSubstore    = []byte{0x08}
 )
 
 // VoteStorage - vote storage
@@ -116,6 +120,7 @@ func (vs VoteStorage) SetVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 		return ErrFailedToMarshalVote(err)
 	}
 	store.Set(GetVoteKey(proposalID, voter), voteByte)
+	store.Set(getVoterVoteKey(voter, proposalID), []byte(proposalID))
 	return nil
 }
 
@@ -123,6 +128,7 @@ func (vs VoteStorage) SetVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 func (vs VoteStorage) DeleteVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) sdk.Error {
 	store := ctx.KVStore(vs.key)
 	store.Delete(GetVoteKey(proposalID, voter))
+	store.Delete(getVoterVoteKey(voter, proposalID))
 	return nil
 }
 
@@ -180,10 +186,124 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegates to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := GetDelegateePrefix(delegatorName)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	return delegatees, nil
+}
+
+// GetDelegateesWithPagination - get delegations of a delegator from KVStore ordered by voter,
+// skip first offset delegations and return at most limit delegations.
+func (vs VoteStorage) GetDelegateesWithPagination(
+	ctx sdk.Context, delegatorName types.AccountKey, offset, limit int64) ([]DelegationRow, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := GetDelegateePrefix(delegatorName)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	rows := []DelegationRow{}
+	for idx := int64(0); iterator.Valid() && int64(len(rows)) < limit; iterator.Next() {
+		if idx < offset {
+			idx++
+			continue
+		}
+		var delegation Delegation
+		if err := vs.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &delegation); err != nil {
+			return nil, ErrFailedToUnmarshalDelegation(err)
+		}
+		rows = append(rows, DelegationRow{
+			Voter:      types.AccountKey(iterator.Key()[len(prefix):]),
+			Delegator:  delegatorName,
+			Delegation: delegation,
+		})
+	}
+	return rows, nil
+}
+
+// DoesVoterCommissionExist - check if voter has set commission
+func (vs VoteStorage) DoesVoterCommissionExist(ctx sdk.Context, voter types.AccountKey) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetVoterCommissionKey(voter))
+}
+
+// GetVoterCommission - get voter commission from KVStore
+func (vs VoteStorage) GetVoterCommission(ctx sdk.Context, voter types.AccountKey) (*VoterCommission, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	commissionByte := store.Get(GetVoterCommissionKey(voter))
+	if commissionByte == nil {
+		return nil, ErrVoterCommissionNotFound()
+	}
+	commission := new(VoterCommission)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(commissionByte, commission); err != nil {
+		return nil, ErrFailedToUnmarshalCommission(err)
+	}
+	return commission, nil
+}
+
+// SetVoterCommission - set voter commission to KVStore
+func (vs VoteStorage) SetVoterCommission(ctx sdk.Context, voter types.AccountKey, commission *VoterCommission) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	commissionByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*commission)
+	if err != nil {
+		return ErrFailedToMarshalCommission(err)
+	}
+	store.Set(GetVoterCommissionKey(voter), commissionByte)
+	return nil
+}
+
+// DoesDelegationInterestExist - check if delegation has unclaimed interest
+func (vs VoteStorage) DoesDelegationInterestExist(ctx sdk.Context, voter, delegator types.AccountKey) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetDelegationInterestKey(voter, delegator))
+}
+
+// GetDelegationInterest - get delegation interest from KVStore
+func (vs VoteStorage) GetDelegationInterest(
+	ctx sdk.Context, voter, delegator types.AccountKey) (*DelegationInterest, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	interestByte := store.Get(GetDelegationInterestKey(voter, delegator))
+	if interestByte == nil {
+		return nil, ErrDelegationInterestNotFound()
+	}
+	interest := new(DelegationInterest)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(interestByte, interest); err != nil {
+		return nil, ErrFailedToUnmarshalDelegationInterest(err)
+	}
+	return interest, nil
+}
+
+// SetDelegationInterest - set delegation interest to KVStore
+func (vs VoteStorage) SetDelegationInterest(
+	ctx sdk.Context, voter, delegator types.AccountKey, interest *DelegationInterest) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	interestByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*interest)
+	if err != nil {
+		return ErrFailedToMarshalDelegationInterest(err)
+	}
+	store.Set(GetDelegationInterestKey(voter, delegator), interestByte)
+	return nil
+}
+
+// DeleteDelegationInterest - delete delegation interest from KVStore
+func (vs VoteStorage) DeleteDelegationInterest(ctx sdk.Context, voter, delegator types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetDelegationInterestKey(voter, delegator))
+	return nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
-	iterator := store.Iterator(subspace(getVotePrefix(proposalID)))
+	iterator := store.Iterator(subspace(GetVotePrefix(proposalID)))
 	defer iterator.Close()
 
 	var votes []Vote
@@ -200,6 +320,85 @@ func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey)
 	return votes, nil
 }
 
+// GetVotesWithPagination - get votes of a proposal from KVStore ordered by voter,
+// skip first offset votes and return at most limit votes.
+func (vs VoteStorage) GetVotesWithPagination(
+	ctx sdk.Context, proposalID types.ProposalKey, offset, limit int64) ([]Vote, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iterator := sdk.KVStorePrefixIterator(store, GetVotePrefix(proposalID))
+	defer iterator.Close()
+
+	votes := []Vote{}
+	for idx := int64(0); iterator.Valid() && int64(len(votes)) < limit; iterator.Next() {
+		if idx < offset {
+			idx++
+			continue
+		}
+		var vote Vote
+		if err := vs.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &vote); err != nil {
+			return nil, ErrFailedToUnmarshalVote(err)
+		}
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+// GetVoterVotesWithPagination - get votes cast by a voter from KVStore ordered by proposal key,
+// skip first offset votes and return at most limit votes.
+func (vs VoteStorage) GetVoterVotesWithPagination(
+	ctx sdk.Context, voter types.AccountKey, offset, limit int64) ([]VoteRecord, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iterator := sdk.KVStorePrefixIterator(store, GetVoterVotePrefix(voter))
+	defer iterator.Close()
+
+	records := []VoteRecord{}
+	for idx := int64(0); iterator.Valid() && int64(len(records)) < limit; iterator.Next() {
+		if idx < offset {
+			idx++
+			continue
+		}
+		proposalID := types.ProposalKey(iterator.Value())
+		vote, err := vs.GetVote(ctx, proposalID, voter)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, VoteRecord{
+			ProposalID: proposalID,
+			Vote:       *vote,
+		})
+	}
+	return records, nil
+}
+
+// GetConvictionLocks - get conviction locks of voter from KVStore
+func (vs VoteStorage) GetConvictionLocks(ctx sdk.Context, voter types.AccountKey) (*ConvictionLocks, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	locksByte := store.Get(GetConvictionLocksKey(voter))
+	if locksByte == nil {
+		return &ConvictionLocks{}, nil
+	}
+	locks := new(ConvictionLocks)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(locksByte, locks); err != nil {
+		return nil, ErrFailedToUnmarshalConvictionLocks(err)
+	}
+	return locks, nil
+}
+
+// SetConvictionLocks - set conviction locks of voter to KVStore, empty locks are deleted
+func (vs VoteStorage) SetConvictionLocks(ctx sdk.Context, voter types.AccountKey, locks *ConvictionLocks) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	if len(locks.Locks) == 0 {
+		store.Delete(GetConvictionLocksKey(voter))
+		return nil
+	}
+	locksByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*locks)
+	if err != nil {
+		return ErrFailedToMarshalConvictionLocks(err)
+	}
+	store.Set(GetConvictionLocksKey(voter), locksByte)
+	return nil
+}
+
 // GetReferenceList - get reference list from KVStore
 func (vs VoteStorage) GetReferenceList(ctx sdk.Context) (*ReferenceList, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -260,6 +459,90 @@ func (vs VoteStorage) Export(ctx sdk.Context) *VoterTables {
 			tables.Delegations = append(tables.Delegations, row)
 		}
 	}()
+	// export table.Votes
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, voteSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			idVoter := string(k[1:])
+			strs := strings.Split(idVoter, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out idVoter: " + idVoter)
+			}
+			proposalID, voter := types.ProposalKey(strs[0]), types.AccountKey(strs[1])
+			val, err := vs.GetVote(ctx, proposalID, voter)
+			if err != nil {
+				panic("failed to read vote: " + err.Error())
+			}
+			row := VoteRow{
+				ProposalID: proposalID,
+				Voter:      voter,
+				Vote:       *val,
+			}
+			tables.Votes = append(tables.Votes, row)
+		}
+	}()
+	// export table.VoterCommissions
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, commissionSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			username := types.AccountKey(k[1:])
+			val, err := vs.GetVoterCommission(ctx, username)
+			if err != nil {
+				panic("failed to read voter commission: " + err.Error())
+			}
+			row := VoterCommissionRow{
+				Username:   username,
+				Commission: *val,
+			}
+			tables.VoterCommissions = append(tables.VoterCommissions, row)
+		}
+	}()
+	// export table.DelegationInterests
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, interestSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			meDelegator := string(k[1:])
+			strs := strings.Split(meDelegator, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out meDelegator: " + meDelegator)
+			}
+			voter, delegator := types.AccountKey(strs[0]), types.AccountKey(strs[1])
+			val, err := vs.GetDelegationInterest(ctx, voter, delegator)
+			if err != nil {
+				panic("failed to read delegation interest: " + err.Error())
+			}
+			row := DelegationInterestRow{
+				Voter:     voter,
+				Delegator: delegator,
+				Interest:  *val,
+			}
+			tables.DelegationInterests = append(tables.DelegationInterests, row)
+		}
+	}()
+	// export table.ConvictionLocks
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, convictionSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			username := types.AccountKey(k[1:])
+			val, err := vs.GetConvictionLocks(ctx, username)
+			if err != nil {
+				panic("failed to read conviction locks: " + err.Error())
+			}
+			row := ConvictionLocksRow{
+				Username: username,
+				Locks:    *val,
+			}
+			tables.ConvictionLocks = append(tables.ConvictionLocks, row)
+		}
+	}()
 
 	list, err := vs.GetReferenceList(ctx)
 	if err != nil {
@@ -288,6 +571,26 @@ func (vs VoteStorage) Import(ctx sdk.Context, ir *VoterTablesIR) {
 		err := vs.SetDelegation(ctx, v.Voter, v.Delegator, &v.Delegation)
 		check(err)
 	}
+	// import table.Votes, voter vote index is rebuilt by SetVote
+	for _, v := range ir.Votes {
+		err := vs.SetVote(ctx, v.ProposalID, v.Voter, &v.Vote)
+		check(err)
+	}
+	// import table.VoterCommissions
+	for _, v := range ir.VoterCommissions {
+		err := vs.SetVoterCommission(ctx, v.Username, &v.Commission)
+		check(err)
+	}
+	// import table.DelegationInterests
+	for _, v := range ir.DelegationInterests {
+		err := vs.SetDelegationInterest(ctx, v.Voter, v.Delegator, &v.Interest)
+		check(err)
+	}
+	// import table.ConvictionLocks
+	for _, v := range ir.ConvictionLocks {
+		err := vs.SetConvictionLocks(ctx, v.Username, &v.Locks)
+		check(err)
+	}
 	// import table.ReferenceList
 	err := vs.SetReferenceList(ctx, &ir.ReferenceList.List)
 	check(err)
@@ -313,13 +616,28 @@ func GetDelegationKey(me types.AccountKey, myDelegator types.AccountKey) []byte
 	return append(getDelegationPrefix(me), myDelegator...)
 }
 
-func getVotePrefix(id types.ProposalKey) []byte {
+// GetVotePrefix - "vote substore" + "proposalID" + "/"
+func GetVotePrefix(id types.ProposalKey) []byte {
 	return append(append(voteSubstore, id...), types.KeySeparator...)
 }
 
 // GetVoteKey - "vote substore" + "proposalID" + "voter"
 func GetVoteKey(proposalID types.ProposalKey, voter types.AccountKey) []byte {
-	return append(getVotePrefix(proposalID), voter...)
+	return append(GetVotePrefix(proposalID), voter...)
+}
+
+// GetVoterVotePrefix - "voter vote substore" + "voter" + "/"
+func GetVoterVotePrefix(voter types.AccountKey) []byte {
+	return append(append(voterVoteSubstore, voter...), types.KeySeparator...)
+}
+
+// GetConvictionLocksKey - "conviction substore" + "voter"
+func GetConvictionLocksKey(voter types.AccountKey) []byte {
+	return append(convictionSubstore, voter...)
+}
+
+func getVoterVoteKey(voter types.AccountKey, proposalID types.ProposalKey) []byte {
+	return append(GetVoterVotePrefix(voter), proposalID...)
 }
 
 // GetVoterKey - "voter substore" + "voter"
@@ -331,12 +649,23 @@ func getReferenceListKey() []byte {
 	return referenceListSubStore
 }
 
-func getDelegateePrefix(me types.AccountKey) []byte {
+// GetVoterCommissionKey - "commission substore" + "voter"
+func GetVoterCommissionKey(me types.AccountKey) []byte {
+	return append(commissionSubstore, me...)
+}
+
+// GetDelegationInterestKey - "interest substore" + "voter" + "/" + "delegator"
+func GetDelegationInterestKey(me types.AccountKey, myDelegator types.AccountKey) []byte {
+	return append(append(append(interestSubstore, me...), types.KeySeparator...), myDelegator...)
+}
+
+// GetDelegateePrefix - "delegatee substore" + "me(delegator)" + "/"
+func GetDelegateePrefix(me types.AccountKey) []byte {
 	return append(append(delegateeSubStore, me...), types.KeySeparator...)
 }
 
 func getDelegateeKey(me, delegatee types.AccountKey) []byte {
-	return append(getDelegateePrefix(me), delegatee...)
+	return append(GetDelegateePrefix(me), delegatee...)
 }
 
 func subspace(prefix []byte) (start, end []byte) {
@@ -222,6 +222,7 @@ func TestVote(t *testing.T) {
 					Voter:       user1,
 					VotingPower: votingPower,
 					Result:      true,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -237,6 +238,7 @@ func TestVote(t *testing.T) {
 					Voter:       user2,
 					VotingPower: votingPower,
 					Result:      true,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -252,6 +254,7 @@ func TestVote(t *testing.T) {
 					Voter:       user2,
 					VotingPower: votingPower,
 					Result:      false,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -267,11 +270,13 @@ func TestVote(t *testing.T) {
 					Voter:       user2,
 					VotingPower: votingPower,
 					Result:      false,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
 					Result:      true,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -295,6 +300,7 @@ func TestVote(t *testing.T) {
 					Voter:       user3,
 					VotingPower: votingPower,
 					Result:      true,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -310,6 +316,7 @@ func TestVote(t *testing.T) {
 					Voter:       user3,
 					VotingPower: votingPower,
 					Result:      false,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -325,11 +332,13 @@ func TestVote(t *testing.T) {
 					Voter:       user2,
 					VotingPower: votingPower,
 					Result:      true,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
 					Result:      false,
+					LockedPower: types.NewCoinFromInt64(0),
 				},
 			},
 		},
@@ -349,6 +358,7 @@ func TestVote(t *testing.T) {
 			vote := Vote{
 				Voter:       tc.voter,
 				Result:      tc.result,
+				LockedPower: types.NewCoinFromInt64(0),
 				VotingPower: tc.votingPower,
 			}
 			err := vs.SetVote(ctx, tc.proposalID, tc.voter, &vote)
@@ -512,3 +522,62 @@ func TestAllDelegation(t *testing.T) {
 		}
 	}
 }
+
+func TestExportImport(t *testing.T) {
+	ctx, vs := setup(t)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	voter := Voter{
+		Username:                 user1,
+		LinoStake:                types.NewCoinFromInt64(1000),
+		DelegatedPower:           types.NewCoinFromInt64(100),
+		DelegateToOthers:         types.NewCoinFromInt64(10),
+		Interest:                 types.NewCoinFromInt64(1),
+		LatestVotedProposalEndAt: 200,
+	}
+	delegation := Delegation{Delegator: user2, Amount: types.NewCoinFromInt64(100)}
+	locks := ConvictionLocks{Locks: []ConvictionLock{
+		{Amount: types.NewCoinFromInt64(1000), UnlockAt: 300},
+		{Amount: types.NewCoinFromInt64(500), UnlockAt: 400},
+	}}
+	vote := Vote{
+		Voter:       user1,
+		VotingPower: types.NewCoinFromInt64(1100),
+		Result:      true,
+		YesWeight:   types.VoteWeightBase,
+		Conviction:  1,
+		LockedPower: types.NewCoinFromInt64(1000),
+	}
+	list := ReferenceList{AllValidators: []types.AccountKey{user1}}
+	assert.Nil(t, vs.SetVoter(ctx, user1, &voter))
+	assert.Nil(t, vs.SetVote(ctx, types.ProposalKey("1"), user1, &vote))
+	assert.Nil(t, vs.SetDelegation(ctx, user1, user2, &delegation))
+	assert.Nil(t, vs.SetConvictionLocks(ctx, user1, &locks))
+	assert.Nil(t, vs.SetReferenceList(ctx, &list))
+
+	tables := vs.Export(ctx)
+	assert.Equal(t, []VoterRow{{Username: user1, Voter: voter}}, tables.Voters)
+	assert.Equal(t, []DelegationRow{{Voter: user1, Delegator: user2, Delegation: delegation}}, tables.Delegations)
+	assert.Equal(t, []VoteRow{{ProposalID: types.ProposalKey("1"), Voter: user1, Vote: vote}}, tables.Votes)
+	assert.Equal(t, []ConvictionLocksRow{{Username: user1, Locks: locks}}, tables.ConvictionLocks)
+	assert.Equal(t, list, tables.ReferenceList.List)
+
+	ctx, _ = setup(t)
+	vs.Import(ctx, tables.ToIR())
+	voterPtr, err := vs.GetVoter(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, voter, *voterPtr)
+	delegationPtr, err := vs.GetDelegation(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, delegation, *delegationPtr)
+	// vote history is available after import
+	records, err := vs.GetVoterVotesWithPagination(ctx, user1, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []VoteRecord{{ProposalID: types.ProposalKey("1"), Vote: vote}}, records)
+	locksPtr, err := vs.GetConvictionLocks(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, locks, *locksPtr)
+	listPtr, err := vs.GetReferenceList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, list, *listPtr)
+}
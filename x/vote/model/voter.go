@@ -2,6 +2,8 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Voter - a voter in blockchain is account with voter deposit, who can vote for a proposal
@@ -12,13 +14,81 @@ type Voter struct {
 	DelegateToOthers  types.Coin       `json:"delegate_to_others"`
 	LastPowerChangeAt int64            `json:"last_power_change_at"`
 	Interest          types.Coin       `json:"interest"`
+	LastRedelegatedAt int64            `json:"last_redelegated_at"`
+	// end time of the latest ending proposal voter has voted on,
+	// delegated power can't be moved away before it to vote again.
+	LatestVotedProposalEndAt int64 `json:"latest_voted_proposal_end_at"`
 }
 
-// Vote - a vote is created by a voter to a proposal
+// Vote - a vote is created by a voter to a proposal, voting power can be split
+// across yes, no and abstain by weights in basis of types.VoteWeightBase.
+// Vote without any weight is a full vote decided by result.
+// Vote with conviction n locks voter's own stake after proposal ends, the locked part of
+// voting power counts n+1 times while delegated power counts once.
 type Vote struct {
-	Voter       types.AccountKey `json:"voter"`
-	VotingPower types.Coin       `json:"voting_power"`
-	Result      bool             `json:"result"`
+	Voter         types.AccountKey `json:"voter"`
+	VotingPower   types.Coin       `json:"voting_power"`
+	Result        bool             `json:"result"`
+	YesWeight     int64            `json:"yes_weight"`
+	NoWeight      int64            `json:"no_weight"`
+	AbstainWeight int64            `json:"abstain_weight"`
+	Conviction    int64            `json:"conviction"`
+	LockedPower   types.Coin       `json:"locked_power"`
+}
+
+// GetAgreeVotes - voting power counted as agree to the proposal
+func (v Vote) GetAgreeVotes() types.Coin {
+	if v.YesWeight == 0 && v.NoWeight == 0 && v.AbstainWeight == 0 {
+		if v.Result {
+			return v.VotingPower
+		}
+		return types.NewCoinFromInt64(0)
+	}
+	return v.weightedVotingPower(v.YesWeight)
+}
+
+// GetDisagreeVotes - voting power counted as disagree to the proposal
+func (v Vote) GetDisagreeVotes() types.Coin {
+	if v.YesWeight == 0 && v.NoWeight == 0 && v.AbstainWeight == 0 {
+		if !v.Result {
+			return v.VotingPower
+		}
+		return types.NewCoinFromInt64(0)
+	}
+	return v.weightedVotingPower(v.NoWeight)
+}
+
+// GetConvictionAgreeVotes - agree votes with locked power multiplied by conviction
+func (v Vote) GetConvictionAgreeVotes() types.Coin {
+	if v.Conviction <= 0 {
+		return v.GetAgreeVotes()
+	}
+	locked := v
+	locked.VotingPower = v.LockedPower
+	return v.GetAgreeVotes().Plus(
+		types.DecToCoin(locked.GetAgreeVotes().ToDec().Mul(sdk.NewDec(v.Conviction))))
+}
+
+// GetConvictionDisagreeVotes - disagree votes with locked power multiplied by conviction
+func (v Vote) GetConvictionDisagreeVotes() types.Coin {
+	if v.Conviction <= 0 {
+		return v.GetDisagreeVotes()
+	}
+	locked := v
+	locked.VotingPower = v.LockedPower
+	return v.GetDisagreeVotes().Plus(
+		types.DecToCoin(locked.GetDisagreeVotes().ToDec().Mul(sdk.NewDec(v.Conviction))))
+}
+
+func (v Vote) weightedVotingPower(weight int64) types.Coin {
+	return types.DecToCoin(
+		v.VotingPower.ToDec().Mul(sdk.NewDec(weight)).Quo(sdk.NewDec(types.VoteWeightBase)))
+}
+
+// VoteRecord - vote cast by a voter on a proposal
+type VoteRecord struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Vote       Vote              `json:"vote"`
 }
 
 // Delegation - normal user can delegate money to a voter to increase voter's voting power
@@ -27,6 +97,39 @@ type Delegation struct {
 	Amount    types.Coin       `json:"amount"`
 }
 
+// VoterCommission - commission rate voter takes from interest generated by delegations
+type VoterCommission struct {
+	Rate          sdk.Dec `json:"rate"`
+	LastChangedAt int64   `json:"last_changed_at"`
+}
+
+// DelegationInterest - interest generated by delegation which can be claimed by delegator
+type DelegationInterest struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Interest  types.Coin       `json:"interest"`
+}
+
+// DelegateeInfo - delegation made by a delegator, with voter's interest and commission
+type DelegateeInfo struct {
+	Voter                   types.AccountKey `json:"voter"`
+	Amount                  types.Coin       `json:"amount"`
+	UnclaimedInterest       types.Coin       `json:"unclaimed_interest"`
+	VoterInterest           types.Coin       `json:"voter_interest"`
+	CommissionRate          sdk.Dec          `json:"commission_rate"`
+	CommissionLastChangedAt int64            `json:"commission_last_changed_at"`
+}
+
+// ConvictionLock - stake locked by conviction vote until unlock time
+type ConvictionLock struct {
+	Amount   types.Coin `json:"amount"`
+	UnlockAt int64      `json:"unlock_at"`
+}
+
+// ConvictionLocks - conviction locks of a voter, locks overlap instead of stacking
+type ConvictionLocks struct {
+	Locks []ConvictionLock `json:"locks"`
+}
+
 // ReferenceList - record validator to punish the validator who doesn't vote for proposal
 type ReferenceList struct {
 	AllValidators []types.AccountKey `json:"all_validators"`
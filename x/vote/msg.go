@@ -14,6 +14,9 @@ var _ types.Msg = StakeOutMsg{}
 var _ types.Msg = DelegateMsg{}
 var _ types.Msg = DelegatorWithdrawMsg{}
 var _ types.Msg = ClaimInterestMsg{}
+var _ types.Msg = RedelegateMsg{}
+var _ types.Msg = SetCommissionMsg{}
+var _ types.Msg = ClaimDelegationInterestMsg{}
 
 // StakeInMsg - voter deposit
 type StakeInMsg struct {
@@ -41,11 +44,31 @@ type DelegatorWithdrawMsg struct {
 	Amount    types.LNO        `json:"amount"`
 }
 
+// RedelegateMsg - delegator moves delegation from one voter to another
+type RedelegateMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	SrcVoter  types.AccountKey `json:"src_voter"`
+	DstVoter  types.AccountKey `json:"dst_voter"`
+	Amount    types.LNO        `json:"amount"`
+}
+
 // ClaimInterestMsg - claim interest generated from lino power
 type ClaimInterestMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// SetCommissionMsg - voter sets commission rate taken from interest of delegations
+type SetCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ClaimDelegationInterestMsg - delegator claims interest generated by delegation to a voter
+type ClaimDelegationInterestMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Voter     types.AccountKey `json:"voter"`
+}
+
 // NewStakeInMsg - return a StakeInMsg
 func NewStakeInMsg(username string, deposit types.LNO) StakeInMsg {
 	return StakeInMsg{
@@ -274,6 +297,71 @@ func (msg DelegatorWithdrawMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// NewRedelegateMsg - return a RedelegateMsg
+func NewRedelegateMsg(delegator, srcVoter, dstVoter string, amount types.LNO) RedelegateMsg {
+	return RedelegateMsg{
+		Delegator: types.AccountKey(delegator),
+		SrcVoter:  types.AccountKey(srcVoter),
+		DstVoter:  types.AccountKey(dstVoter),
+		Amount:    amount,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg RedelegateMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RedelegateMsg) Type() string { return "RedelegateMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RedelegateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.SrcVoter) < types.MinimumUsernameLength ||
+		len(msg.SrcVoter) > types.MaximumUsernameLength ||
+		len(msg.DstVoter) < types.MinimumUsernameLength ||
+		len(msg.DstVoter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.SrcVoter == msg.DstVoter {
+		return ErrRedelegateToSameVoter()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg RedelegateMsg) String() string {
+	return fmt.Sprintf("RedelegateMsg{Delegator:%v, SrcVoter:%v, DstVoter:%v, Amount:%v}",
+		msg.Delegator, msg.SrcVoter, msg.DstVoter, msg.Amount)
+}
+
+// GetPermission - implements types.Msg
+func (msg RedelegateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RedelegateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RedelegateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg RedelegateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // NewClaimInterestMsg - return a ClaimInterestMsg
 func NewClaimInterestMsg(username string) ClaimInterestMsg {
 	return ClaimInterestMsg{
@@ -323,3 +411,114 @@ func (msg ClaimInterestMsg) GetSigners() []sdk.AccAddress {
 func (msg ClaimInterestMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewSetCommissionMsg - return a SetCommissionMsg
+func NewSetCommissionMsg(username, commissionRate string) SetCommissionMsg {
+	return SetCommissionMsg{
+		Username:       types.AccountKey(username),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetCommissionMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetCommissionMsg) Type() string { return "SetCommissionMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetCommissionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	rate, err := sdk.NewDecFromStr(msg.CommissionRate)
+	if err != nil {
+		return ErrInvalidCommissionRate()
+	}
+	if rate.LT(sdk.ZeroDec()) || rate.GT(sdk.OneDec()) {
+		return ErrInvalidCommissionRate()
+	}
+	return nil
+}
+
+func (msg SetCommissionMsg) String() string {
+	return fmt.Sprintf("SetCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implements types.Msg
+func (msg SetCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetCommissionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewClaimDelegationInterestMsg - return a ClaimDelegationInterestMsg
+func NewClaimDelegationInterestMsg(delegator, voter string) ClaimDelegationInterestMsg {
+	return ClaimDelegationInterestMsg{
+		Delegator: types.AccountKey(delegator),
+		Voter:     types.AccountKey(voter),
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ClaimDelegationInterestMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ClaimDelegationInterestMsg) Type() string { return "ClaimDelegationInterestMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ClaimDelegationInterestMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.Voter) < types.MinimumUsernameLength ||
+		len(msg.Voter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ClaimDelegationInterestMsg) String() string {
+	return fmt.Sprintf("ClaimDelegationInterestMsg{Delegator:%v, Voter:%v}", msg.Delegator, msg.Voter)
+}
+
+// GetPermission - implements types.Msg
+func (msg ClaimDelegationInterestMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ClaimDelegationInterestMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ClaimDelegationInterestMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ClaimDelegationInterestMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -177,6 +177,119 @@ func TestDelegatorWithdrawMsg(t *testing.T) {
 	}
 }
 
+func TestRedelegateMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		redelegateMsg RedelegateMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid delegator",
+			redelegateMsg: NewRedelegateMsg("", "user2", "user3", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid destination voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "redelegate to same voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user2", "1"),
+			expectedError: ErrRedelegateToSameVoter(),
+		},
+		{
+			testName:      "invalid redelegate amount",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "-1"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.redelegateMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestSetCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		setCommissionMsg SetCommissionMsg
+		expectedError    sdk.Error
+	}{
+		{
+			testName:         "normal case",
+			setCommissionMsg: NewSetCommissionMsg("user1", "0.1"),
+			expectedError:    nil,
+		},
+		{
+			testName:         "invalid username",
+			setCommissionMsg: NewSetCommissionMsg("", "0.1"),
+			expectedError:    ErrInvalidUsername(),
+		},
+		{
+			testName:         "invalid commission rate",
+			setCommissionMsg: NewSetCommissionMsg("user1", "abc"),
+			expectedError:    ErrInvalidCommissionRate(),
+		},
+		{
+			testName:         "negative commission rate",
+			setCommissionMsg: NewSetCommissionMsg("user1", "-0.1"),
+			expectedError:    ErrInvalidCommissionRate(),
+		},
+		{
+			testName:         "commission rate larger than one",
+			setCommissionMsg: NewSetCommissionMsg("user1", "1.1"),
+			expectedError:    ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.setCommissionMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestClaimDelegationInterestMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ClaimDelegationInterestMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewClaimDelegationInterestMsg("user1", "user2"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid delegator",
+			msg:           NewClaimDelegationInterestMsg("", "user2"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid voter",
+			msg:           NewClaimDelegationInterestMsg("user1", ""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -203,6 +316,16 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "set commission",
+			msg:                NewSetCommissionMsg("voter", "0.1"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "claim delegation interest",
+			msg:                NewClaimDelegationInterestMsg("delegator", "voter"),
+			expectedPermission: types.AppPermission,
+		},
 	}
 
 	for _, tc := range testCases {
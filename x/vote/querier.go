@@ -1,6 +1,8 @@
 package vote
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -22,6 +24,13 @@ const (
 	QueryVote          = "vote"
 	QueryReferenceList = "refList"
 	QueryDelegatee     = "delegatee"
+	QueryDelegateeList = "delegateeList"
+	QueryVoteList      = "voteList"
+	QueryVoteHistory   = "voteHistory"
+
+	// default and maximum number of items returned by one paginated query
+	defaultPageLimit = 20
+	maxPageLimit     = 100
 )
 
 // creates a querier for vote REST endpoints
@@ -40,6 +49,12 @@ func NewQuerier(vm VoteManager) sdk.Querier {
 			return queryReferenceList(ctx, cdc, path[1:], req, vm)
 		case QueryDelegatee:
 			return queryDelegatee(ctx, cdc, path[1:], req, vm)
+		case QueryDelegateeList:
+			return queryDelegateeList(ctx, cdc, path[1:], req, vm)
+		case QueryVoteList:
+			return queryVoteList(ctx, cdc, path[1:], req, vm)
+		case QueryVoteHistory:
+			return queryVoteHistory(ctx, cdc, path[1:], req, vm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown vote query endpoint")
 		}
@@ -117,3 +132,83 @@ func queryDelegatee(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.Re
 	}
 	return res, nil
 }
+
+// queryDelegateeList - path: delegator[/page[/limit]], page starts from 1
+func queryDelegateeList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	page, limit, err := parsePagination(path[1:])
+	if err != nil {
+		return nil, err
+	}
+	delegatees, err := vm.GetDelegateeInfos(ctx, types.AccountKey(path[0]), page, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(delegatees)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryVoteList - path: proposalID[/page[/limit]], page starts from 1
+func queryVoteList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	page, limit, err := parsePagination(path[1:])
+	if err != nil {
+		return nil, err
+	}
+	votes, err := vm.GetVotes(ctx, types.ProposalKey(path[0]), page, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(votes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryVoteHistory - path: voter[/page[/limit]], page starts from 1
+func queryVoteHistory(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	page, limit, err := parsePagination(path[1:])
+	if err != nil {
+		return nil, err
+	}
+	records, err := vm.GetVoteHistory(ctx, types.AccountKey(path[0]), page, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(records)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// parsePagination - path: [page[/limit]], default to first page with default limit
+func parsePagination(path []string) (int64, int64, sdk.Error) {
+	page, limit := int64(1), int64(defaultPageLimit)
+	if len(path) > 0 {
+		p, convertErr := strconv.ParseInt(path[0], 10, 64)
+		if convertErr != nil || p < 1 {
+			return 0, 0, ErrQueryFailed()
+		}
+		page = p
+	}
+	if len(path) > 1 {
+		l, convertErr := strconv.ParseInt(path[1], 10, 64)
+		if convertErr != nil || l < 1 || l > maxPageLimit {
+			return 0, 0, ErrQueryFailed()
+		}
+		limit = l
+	}
+	return page, limit, nil
+}